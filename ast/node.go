@@ -14,6 +14,7 @@ import (
 	"bytes"
 	"math/rand"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -80,13 +81,18 @@ type Node struct {
 	// 任务列表项 [ ]、[x] 或者 [X]
 
 	TaskListItemChecked bool `json:",omitempty"` // 是否勾选
+	TaskListItemState   byte `json:",omitempty"` // 扩展状态字符，比如 -、>，为 0 时根据 TaskListItemChecked 判断
 
 	// 表
 
-	TableAligns              []int `json:",omitempty"` // 从左到右每个表格节点的对齐方式，0：默认对齐，1：左对齐，2：居中对齐，3：右对齐
-	TableCellAlign           int   `json:",omitempty"` // 表的单元格对齐方式
-	TableCellContentWidth    int   `json:",omitempty"` // 表的单元格内容宽度（字节数）
-	TableCellContentMaxWidth int   `json:",omitempty"` // 表的单元格内容最大宽度
+	TableAligns              []int  `json:",omitempty"` // 从左到右每个表格节点的对齐方式，0：默认对齐，1：左对齐，2：居中对齐，3：右对齐
+	TableCellAlign           int    `json:",omitempty"` // 表的单元格对齐方式
+	TableCellContentWidth    int    `json:",omitempty"` // 表的单元格内容宽度（等宽字体下的显示宽度，多行内容取最宽的一行）
+	TableCellContentMaxWidth int    `json:",omitempty"` // 表的单元格内容最大宽度
+	TableCellColspan         int    `json:",omitempty"` // 扩展表格单元格跨列数，0 或者 1 表示不跨列
+	TableCellRowspan         int    `json:",omitempty"` // 扩展表格单元格跨行数，0 或者 1 表示不跨行
+	TableCellMerged          int    `json:",omitempty"` // 扩展表格中被合并的单元格，0：未合并，1：被左侧单元格合并（||），2：被上方单元格合并（^^）
+	TableCaption             string `json:",omitempty"` // 扩展表格标题 [Caption]
 
 	// 链接
 
@@ -108,6 +114,7 @@ type Node struct {
 	FootnotesRefLabel []byte  `json:",omitempty"` // 脚注引用 label，[^label]
 	FootnotesRefId    string  `json:",omitempty"` // 脚注 id
 	FootnotesRefs     []*Node `json:",omitempty"` // 脚注引用
+	FootnotesInline   bool    `json:",omitempty"` // 是否是行级脚注 ^[text]，用于脚注引用和匿名脚注定义
 
 	// HTML 实体
 
@@ -139,6 +146,74 @@ type Node struct {
 
 	CustomBlockFenceOffset int    `json:",omitempty"` // 自定义块标记符起始偏移量
 	CustomBlockInfo        string `json:",omitempty"` // 自定义块信息
+
+	// 提示块 > [!NOTE]
+
+	CalloutType  string `json:",omitempty"` // 提示块类型，比如 NOTE、tip
+	CalloutTitle string `json:",omitempty"` // 提示块标题的 Markdown 原文，渲染时作为行级内容解析，为空时使用类型作为标题
+	CalloutFold  string `json:",omitempty"` // 折叠标记，为空时不可折叠，+ 表示可折叠并默认展开，- 表示可折叠并默认折叠
+
+	// 围栏 div ::: {.warning #id}
+
+	FencedDivFenceLen int `json:",omitempty"` // 围栏 div 开始标记符 : 的长度，闭合标记符会闭合最内层长度不大于它的围栏 div
+
+	// Wiki 链接 [[target#heading|alias]]
+
+	WikiLinkTarget  string `json:",omitempty"` // 目标页面，为空时表示当前页面
+	WikiLinkHeading string `json:",omitempty"` // 目标标题
+	WikiLinkBlock   string `json:",omitempty"` // 目标块标识
+	WikiLinkAlias   string `json:",omitempty"` // 别名，为空时使用目标作为锚文本
+
+	// 交叉引用 {#fig:arch}、@fig:arch
+
+	CrossRefLabel string `json:",omitempty"` // 交叉引用标签，比如 fig:arch、tbl:results、eq:loss
+	CrossRefNum   int    `json:",omitempty"` // 交叉引用编号，由 Tree.NumberCrossRefs 按类型分别计数，为 0 时表示未编号或者引用未解析
+
+	// 文献引用 [see @smith2020, p. 33; @doe2019]、@smith2020
+
+	Citations      []*Citation `json:",omitempty"` // 引用项列表
+	CitationInText bool        `json:",omitempty"` // 是否是正文中的引用 @smith2020，渲染为 Smith (2020)
+
+	// 源码位置
+
+	SourcePos *SourcePos `json:"-"` // 节点在 Markdown 原文中的位置，仅在打开解析选项 SourcePos 时记录
+}
+
+// Citation 描述了文献引用中的一个引用项。
+type Citation struct {
+	Key            string // 引用键，对应文献库中的条目 id
+	Prefix         string // 前缀，比如 see
+	Locator        string // 定位，比如 p. 33
+	SuppressAuthor bool   // 是否不显示作者 [-@smith2020]
+}
+
+// SourcePos 用于记录节点在 Markdown 原文中的位置。
+//
+// 行号和列号从 1 开始，列号按字节计算，结束列号指向节点最后一个字节；偏移量从 0 开始，结束偏移量不包含在节点内。
+// 原文中的 \r\n 换行会被计入偏移量，\u0000 替换为 \uFFFD 后列号以替换后的内容为准。
+type SourcePos struct {
+	StartLine   int // 起始行号
+	StartColumn int // 起始列号
+	StartOffset int // 起始字节偏移量
+	EndLine     int // 结束行号
+	EndColumn   int // 结束列号
+	EndOffset   int // 结束字节偏移量
+}
+
+// String 返回 cmark --sourcepos 格式的位置字符串，比如 1:1-2:5。
+func (pos *SourcePos) String() string {
+	return strconv.Itoa(pos.StartLine) + ":" + strconv.Itoa(pos.StartColumn) + "-" + strconv.Itoa(pos.EndLine) + ":" + strconv.Itoa(pos.EndColumn)
+}
+
+// TaskState 返回任务列表项标记节点的状态字符，没有记录扩展状态字符时勾选返回 x，否则返回空格。
+func (n *Node) TaskState() byte {
+	if 0 != n.TaskListItemState {
+		return n.TaskListItemState
+	}
+	if n.TaskListItemChecked {
+		return 'x'
+	}
+	return ' '
 }
 
 // ListData 用于记录列表或列表项节点的附加信息。
@@ -151,6 +226,7 @@ type ListData struct {
 	Padding      int    `json:",omitempty"` // 列表内部缩进空格数（包含标识符长度，即规范中的 W+N）
 	MarkerOffset int    `json:",omitempty"` // 标识符（* - + 或者 1 2 3）相对缩进空格数
 	Checked      bool   `json:",omitempty"` // 任务列表项是否勾选
+	TaskState    byte   `json:",omitempty"` // 任务列表项扩展状态字符，比如 -、>
 	Marker       []byte `json:",omitempty"` // 列表标识符
 	Num          int    `json:",omitempty"` // 有序列表项修正过的序号
 }
@@ -506,13 +582,13 @@ func (n *Node) Stat() (runeCnt, wordCnt, linkCnt, imgCnt, refCnt int) {
 	return
 }
 
-// TokenLen 返回 n 及其子节点 tokens 累计长度。
+// TokenLen 返回 n 及其子节点 tokens 累计的显示宽度。
 func (n *Node) TokenLen() (ret int) {
 	Walk(n, func(n *Node, entering bool) WalkStatus {
 		if !entering {
 			return WalkContinue
 		}
-		ret += lex.BytesShowLength(n.Tokens)
+		ret += util.DisplayWidth(util.BytesToStr(n.Tokens))
 		return WalkContinue
 	})
 	return
@@ -691,19 +767,22 @@ func (n *Node) IsBlock() bool {
 	case NodeDocument, NodeParagraph, NodeHeading, NodeThematicBreak, NodeBlockquote, NodeList, NodeListItem, NodeHTMLBlock,
 		NodeCodeBlock, NodeTable, NodeMathBlock, NodeFootnotesDefBlock, NodeFootnotesDef, NodeToC, NodeYamlFrontMatter,
 		NodeBlockQueryEmbed, NodeKramdownBlockIAL, NodeSuperBlock, NodeGitConflict, NodeAudio, NodeVideo, NodeIFrame, NodeWidget,
-		NodeAttributeView, NodeCustomBlock:
+		NodeAttributeView, NodeCustomBlock, NodeCallout, NodeDefinitionList, NodeDefinitionTerm, NodeDefinitionDesc, NodeFencedDiv:
 		return true
 	}
-	return false
+	_, ok := extBlockType(n.Type)
+	return ok
 }
 
 // IsContainerBlock 判断 n 是否为容器块。
 func (n *Node) IsContainerBlock() bool {
 	switch n.Type {
-	case NodeDocument, NodeBlockquote, NodeList, NodeListItem, NodeFootnotesDefBlock, NodeFootnotesDef, NodeSuperBlock:
+	case NodeDocument, NodeBlockquote, NodeList, NodeListItem, NodeFootnotesDefBlock, NodeFootnotesDef, NodeSuperBlock, NodeCallout,
+		NodeDefinitionList, NodeDefinitionDesc, NodeFencedDiv:
 		return true
 	}
-	return false
+	container, _ := extBlockType(n.Type)
+	return container
 }
 
 // IsMarker 判断 n 是否为节点标记符。
@@ -728,7 +807,8 @@ func (n *Node) AcceptLines() bool {
 		NodeGitConflict, NodeIFrame, NodeWidget, NodeVideo, NodeAudio, NodeAttributeView, NodeCustomBlock:
 		return true
 	}
-	return false
+	container, ok := extBlockType(n.Type)
+	return ok && !container
 }
 
 // CanContain 判断是否能够包含 NodeType 指定类型的节点。 比如列表节点（块级容器）只能包含列表项节点，
@@ -736,10 +816,14 @@ func (n *Node) AcceptLines() bool {
 func (n *Node) CanContain(nodeType NodeType) bool {
 	switch n.Type {
 	case NodeCodeBlock, NodeHTMLBlock, NodeParagraph, NodeThematicBreak, NodeTable, NodeMathBlock, NodeYamlFrontMatter,
-		NodeGitConflict, NodeIFrame, NodeWidget, NodeVideo, NodeAudio, NodeAttributeView, NodeCustomBlock:
+		NodeGitConflict, NodeIFrame, NodeWidget, NodeVideo, NodeAudio, NodeAttributeView, NodeCustomBlock, NodeDefinitionTerm:
 		return false
 	case NodeList:
 		return NodeListItem == nodeType
+	case NodeDefinitionList:
+		return NodeDefinitionTerm == nodeType || NodeDefinitionDesc == nodeType
+	case NodeDefinitionDesc:
+		return NodeListItem != nodeType && NodeDefinitionTerm != nodeType && NodeDefinitionDesc != nodeType
 	case NodeFootnotesDefBlock:
 		return NodeFootnotesDef == nodeType
 	case NodeFootnotesDef:
@@ -751,9 +835,34 @@ func (n *Node) CanContain(nodeType NodeType) bool {
 		}
 		return true
 	}
+	if container, ok := extBlockType(n.Type); ok && !container {
+		return false
+	}
 	return NodeListItem != nodeType
 }
 
+var extBlockTypes = map[NodeType]bool{}
+var extBlockTypesLock = sync.RWMutex{}
+
+// RegisterBlockType 注册扩展块级节点类型 nodeType，container 为 true 时表示容器块（可以包含其他块），否则表示接受文本行的叶子块。
+// 扩展节点类型需要大于 NodeTypeMaxVal，以免和内置节点类型冲突。
+func RegisterBlockType(nodeType NodeType, container bool) {
+	extBlockTypesLock.Lock()
+	defer extBlockTypesLock.Unlock()
+	extBlockTypes[nodeType] = container
+}
+
+// extBlockType 返回通过 RegisterBlockType 注册的扩展块级节点类型是否为容器块，ok 为 false 时说明 nodeType 不是扩展块级节点类型。
+func extBlockType(nodeType NodeType) (container, ok bool) {
+	if NodeTypeMaxVal >= nodeType {
+		return
+	}
+	extBlockTypesLock.RLock()
+	defer extBlockTypesLock.RUnlock()
+	container, ok = extBlockTypes[nodeType]
+	return
+}
+
 //go:generate stringer -type=NodeType
 type NodeType int
 
@@ -991,6 +1100,37 @@ const (
 	// 自定义块 https://github.com/siyuan-note/siyuan/issues/8418 ;;;info
 
 	NodeCustomBlock NodeType = 560 // 自定义块
+
+	// 提示块 https://docs.github.com/en/get-started/writing-on-github/getting-started-with-writing-and-formatting-on-github/basic-writing-and-formatting-syntax#alerts > [!NOTE]
+
+	NodeCallout NodeType = 570 // 提示块
+
+	// 定义列表 https://pandoc.org/MANUAL.html#definition-lists Term\n: Definition
+
+	NodeDefinitionList NodeType = 580 // 定义列表
+	NodeDefinitionTerm NodeType = 581 // 定义术语
+	NodeDefinitionDesc NodeType = 582 // 定义描述
+
+	// Wiki 链接 [[target]]、[[target#heading]]、[[target^block]]、[[target|alias]]
+
+	NodeWikiLink NodeType = 590 // Wiki 链接
+
+	// 交叉引用 @fig:arch、@tbl:results、@eq:loss
+
+	NodeCrossRef NodeType = 591 // 交叉引用
+
+	// Pandoc 通用属性 [text]{#id .class key=val}
+
+	NodeBracketedSpan NodeType = 592 // 带属性的行级文本
+
+	// Pandoc 围栏 div ::: {.warning}
+
+	NodeFencedDiv NodeType = 593 // 围栏 div
+
+	// 文献引用 [@smith2020, p. 33]、@smith2020
+
+	NodeCitation NodeType = 594 // 文献引用
+
 	// Ficus 自定义节点
 
 	NodeMDlink NodeType = 600 // 图片
@@ -0,0 +1,323 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+// Package diff 实现了两个版本语法树之间的块级结构差异比较。
+//
+// 比较的单位是叶子块（段落、标题、代码块、表格等），列表、引述等容器块会被展开。两个版本的块按以下顺序匹配：
+//   - kramdown IAL 中指定了相同 id 的块
+//   - 类型和内容都相同的块
+//   - 类型相同并且内容相似的块
+//
+// 没有匹配的旧块是删除，没有匹配的新块是插入，匹配的块如果相对顺序发生了变化则是移动，内容发生了变化则是更新。
+package diff
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/parse"
+)
+
+// OpKind 描述了差异操作的类型。
+type OpKind int
+
+const (
+	OpEqual  OpKind = iota // 未变化
+	OpInsert               // 插入
+	OpDelete               // 删除
+	OpMove                 // 移动，内容也发生变化时 Edits 不为空
+	OpUpdate               // 更新
+)
+
+func (k OpKind) String() string {
+	switch k {
+	case OpInsert:
+		return "insert"
+	case OpDelete:
+		return "delete"
+	case OpMove:
+		return "move"
+	case OpUpdate:
+		return "update"
+	}
+	return "equal"
+}
+
+// Op 描述了一个块级差异操作。
+type Op struct {
+	Kind  OpKind    // 类型
+	A     *ast.Node // 旧版本中的块，插入时为 nil
+	B     *ast.Node // 新版本中的块，删除时为 nil
+	Edits []*Edit   // 块文本的行级差异，仅在内容发生变化的更新和移动时有值
+
+	movedFrom bool // 移动前的位置，仅用于渲染
+}
+
+// similarity 是两个块被认为是同一个块修改而来的最小相似度。
+const similarity = 0.5
+
+// Trees 比较旧版本语法树 a 和新版本语法树 b，按新版本的文档顺序返回差异操作，删除的块排在它在旧版本中的后一个块之前。
+// 未变化的块也会以 OpEqual 返回，方便调用方按文档顺序展示。
+func Trees(a, b *parse.Tree) (ret []*Op) {
+	for _, op := range diff(a, b) {
+		if !op.movedFrom {
+			ret = append(ret, op)
+		}
+	}
+	return
+}
+
+// unit 描述了参与比较的叶子块。
+type unit struct {
+	node  *ast.Node
+	id    string   // kramdown IAL 中指定的 id
+	sig   string   // 块的结构签名，相同则认为块没有变化
+	words []string // 块文本内容的分词结果
+	match int      // 匹配的另一个版本中的块的下标，没有匹配时为 -1
+}
+
+func diff(a, b *parse.Tree) (ret []*Op) {
+	as, bs := units(a), units(b)
+	matchByID(as, bs)
+	matchBySig(as, bs)
+	matchBySimilarity(as, bs)
+
+	inPlace := stablePairs(bs)
+	ai := 0
+	deletes := func(end int) {
+		for ; ai < end; ai++ {
+			if -1 == as[ai].match {
+				ret = append(ret, &Op{Kind: OpDelete, A: as[ai].node})
+			} else if !inPlace[as[ai].match] {
+				ret = append(ret, &Op{Kind: OpMove, A: as[ai].node, B: bs[as[ai].match].node, movedFrom: true})
+			}
+		}
+	}
+	for j, u := range bs {
+		if -1 == u.match {
+			ret = append(ret, &Op{Kind: OpInsert, B: u.node})
+			continue
+		}
+
+		old := as[u.match]
+		op := &Op{Kind: OpMove, A: old.node, B: u.node}
+		if inPlace[j] {
+			deletes(u.match)
+			ai = u.match + 1
+			op.Kind = OpEqual
+		}
+		if old.sig != u.sig {
+			if OpEqual == op.Kind {
+				op.Kind = OpUpdate
+			}
+			op.Edits = diffWords(old.words, u.words)
+		}
+		ret = append(ret, op)
+	}
+	deletes(len(as))
+	return
+}
+
+// units 按文档顺序返回语法树 tree 中的叶子块。
+func units(tree *parse.Tree) (ret []*unit) {
+	ast.Walk(tree.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if !entering || ast.NodeKramdownBlockIAL == n.Type || !n.IsBlock() {
+			return ast.WalkContinue
+		}
+		if n.IsContainerBlock() {
+			return ast.WalkContinue
+		}
+
+		content := strings.TrimRight(n.Content(), "\n")
+		if ast.NodeTable == n.Type {
+			content = tableContent(n)
+		}
+		ret = append(ret, &unit{node: n, id: n.IALAttr("id"), sig: signature(n), words: words(content), match: -1})
+		return ast.WalkSkipChildren
+	})
+	return
+}
+
+// tableContent 返回表格 table 的文本内容，单元格间使用 " | " 分隔，行间使用换行符分隔，避免相邻单元格的文本连成一个词。
+func tableContent(table *ast.Node) string {
+	var rows []string
+	ast.Walk(table, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if !entering || ast.NodeTableRow != n.Type {
+			return ast.WalkContinue
+		}
+		var cells []string
+		for cell := n.FirstChild; nil != cell; cell = cell.Next {
+			if ast.NodeTableCell == cell.Type {
+				cells = append(cells, strings.TrimSpace(cell.Content()))
+			}
+		}
+		rows = append(rows, strings.Join(cells, " | "))
+		return ast.WalkSkipChildren
+	})
+	return strings.Join(rows, "\n")
+}
+
+// signature 返回块 n 的结构签名，包括子节点的类型和 Tokens，行级格式的变化也会改变签名。
+func signature(n *ast.Node) string {
+	buf := &strings.Builder{}
+	if ast.NodeHeading == n.Type {
+		buf.WriteString("h" + strconv.Itoa(n.HeadingLevel))
+	}
+	ast.Walk(n, func(c *ast.Node, entering bool) ast.WalkStatus {
+		if entering {
+			buf.WriteString(strconv.Itoa(int(c.Type)))
+			buf.WriteByte(':')
+			buf.Write(c.Tokens)
+			buf.WriteByte(';')
+		}
+		return ast.WalkContinue
+	})
+	return buf.String()
+}
+
+func matchByID(as, bs []*unit) {
+	ids := map[string]int{}
+	for i, u := range as {
+		if "" != u.id {
+			ids[u.id] = i
+		}
+	}
+	for j, u := range bs {
+		if "" == u.id {
+			continue
+		}
+		if i, ok := ids[u.id]; ok && -1 == as[i].match {
+			as[i].match, u.match = j, i
+		}
+	}
+}
+
+func matchBySig(as, bs []*unit) {
+	sigs := map[string][]int{}
+	for i, u := range as {
+		if -1 == u.match {
+			sigs[u.sig] = append(sigs[u.sig], i)
+		}
+	}
+	for j, u := range bs {
+		if -1 != u.match {
+			continue
+		}
+		if candidates := sigs[u.sig]; 0 < len(candidates) {
+			i := candidates[0]
+			sigs[u.sig] = candidates[1:]
+			as[i].match, u.match = j, i
+		}
+	}
+}
+
+func matchBySimilarity(as, bs []*unit) {
+	type pair struct {
+		i, j  int
+		score float64
+	}
+	var pairs []pair
+	for i, ua := range as {
+		if -1 != ua.match {
+			continue
+		}
+		for j, ub := range bs {
+			if -1 != ub.match || ua.node.Type != ub.node.Type {
+				continue
+			}
+			if score := dice(ua.words, ub.words); similarity <= score {
+				pairs = append(pairs, pair{i, j, score})
+			}
+		}
+	}
+
+	// 相似度相同时优先匹配位置接近的块
+	sort.SliceStable(pairs, func(x, y int) bool {
+		if pairs[x].score != pairs[y].score {
+			return pairs[x].score > pairs[y].score
+		}
+		return abs(pairs[x].i-pairs[x].j) < abs(pairs[y].i-pairs[y].j)
+	})
+	for _, p := range pairs {
+		if -1 == as[p.i].match && -1 == bs[p.j].match {
+			as[p.i].match, bs[p.j].match = p.j, p.i
+		}
+	}
+}
+
+// dice 返回两个分词结果的 Dice 相似度，忽略空白。
+func dice(a, b []string) float64 {
+	counts := map[string]int{}
+	total := 0
+	for _, w := range a {
+		if "" != strings.TrimSpace(w) {
+			counts[w]++
+			total++
+		}
+	}
+	common := 0
+	for _, w := range b {
+		if "" == strings.TrimSpace(w) {
+			continue
+		}
+		total++
+		if 0 < counts[w] {
+			counts[w]--
+			common++
+		}
+	}
+	if 0 == total {
+		return 1
+	}
+	return float64(2*common) / float64(total)
+}
+
+// stablePairs 返回新版本中相对顺序没有变化的匹配块，使用最长递增子序列计算，其余匹配块视为移动。
+func stablePairs(bs []*unit) (ret map[int]bool) {
+	var seq []int // 新版本中匹配块的下标
+	for j, u := range bs {
+		if -1 != u.match {
+			seq = append(seq, j)
+		}
+	}
+
+	// tails[k] 是长度为 k+1 的递增子序列的最后一个元素在 seq 中的下标
+	var tails []int
+	prev := make([]int, len(seq))
+	for k, j := range seq {
+		pos := sort.Search(len(tails), func(x int) bool { return bs[seq[tails[x]]].match >= bs[j].match })
+		prev[k] = -1
+		if 0 < pos {
+			prev[k] = tails[pos-1]
+		}
+		if pos == len(tails) {
+			tails = append(tails, k)
+		} else {
+			tails[pos] = k
+		}
+	}
+
+	ret = map[int]bool{}
+	if 0 < len(tails) {
+		for k := tails[len(tails)-1]; -1 != k; k = prev[k] {
+			ret[seq[k]] = true
+		}
+	}
+	return
+}
+
+func abs(x int) int {
+	if 0 > x {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,147 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package diff
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/html"
+	"github.com/Dofingert/lute-for-ficus/parse"
+	"github.com/Dofingert/lute-for-ficus/render"
+)
+
+// RenderHTML 比较旧版本语法树 a 和新版本语法树 b，将差异渲染为 HTML。
+//
+// 渲染基于新版本的文档结构：插入的块使用 <ins class="diff-block"> 包裹，删除的块使用 <del class="diff-block"> 包裹并渲染在它在旧版本中的后一个块之前，
+// 移动的块在新位置使用 <ins class="diff-move">、在旧位置使用 <del class="diff-move"> 包裹，
+// 更新的块渲染为 <div class="diff-update">，块内文本的差异使用 <ins> 和 <del> 标记，更新的表格按新版本渲染。
+func RenderHTML(a, b *parse.Tree, options *render.Options) []byte {
+	rendererA := render.NewHtmlRenderer(a, options)
+	rendererB := render.NewHtmlRenderer(b, options)
+
+	// 删除的块和移动前的块在新版本中没有对应的节点，挂在后面第一个有对应节点的块之前
+	before := map[*ast.Node]string{}
+	changed := map[*ast.Node]*Op{}
+	pending := &bytes.Buffer{}
+	for _, op := range diff(a, b) {
+		if OpDelete == op.Kind || op.movedFrom {
+			class := "diff-block"
+			if op.movedFrom {
+				class = "diff-move"
+			}
+			pending.WriteString(wrapHTML("del", class, rendererA.RenderNode(op.A)))
+			continue
+		}
+
+		if 0 < pending.Len() {
+			// 挂在以该块开头的最外层块上，避免渲染到列表项等容器块内部
+			anchor := op.B
+			for nil != anchor.Parent && ast.NodeDocument != anchor.Parent.Type && anchor == anchor.Parent.FirstChild {
+				anchor = anchor.Parent
+			}
+			before[anchor] += pending.String()
+			pending.Reset()
+		}
+		if OpEqual != op.Kind {
+			changed[op.B] = op
+		}
+	}
+
+	nested := false
+	ext := func(n *ast.Node, entering bool) (string, ast.WalkStatus) {
+		op := changed[n]
+		if nested || (nil == op && !entering) {
+			return "", rendererB.RendererFuncs[n.Type](n, entering)
+		}
+		if !entering {
+			return "", ast.WalkContinue
+		}
+
+		if deleted, ok := before[n]; ok {
+			rendererB.WriteString(deleted)
+		}
+		if nil == op {
+			return "", rendererB.RendererFuncs[n.Type](n, entering)
+		}
+
+		switch op.Kind {
+		case OpInsert:
+			nested = true
+			rendererB.WriteString(wrapHTML("ins", "diff-block", rendererB.RenderNode(n)))
+			nested = false
+		case OpUpdate:
+			if ast.NodeTable == n.Type {
+				// 表格的文本差异无法对应到单元格，按新版本渲染以保留表格结构
+				nested = true
+				rendererB.WriteString(wrapHTML("div", "diff-update", rendererB.RenderNode(n)))
+				nested = false
+			} else {
+				rendererB.WriteString(updateHTML(n, op.Edits))
+			}
+		case OpMove:
+			if nil != op.Edits && ast.NodeTable != n.Type {
+				rendererB.WriteString(wrapHTML("ins", "diff-move", []byte(updateHTML(n, op.Edits))))
+			} else {
+				nested = true
+				rendererB.WriteString(wrapHTML("ins", "diff-move", rendererB.RenderNode(n)))
+				nested = false
+			}
+		}
+		return "", ast.WalkSkipChildren
+	}
+	for n := range before {
+		rendererB.ExtRendererFuncs[n.Type] = ext
+	}
+	for n := range changed {
+		rendererB.ExtRendererFuncs[n.Type] = ext
+	}
+
+	ret := rendererB.BaseRenderer.Render()
+	ret = append(ret, pending.Bytes()...)
+	ret = append(ret, rendererB.RenderBibliography()...)
+	ret = append(ret, rendererB.RenderFootnotes()...)
+	return ret
+}
+
+func wrapHTML(tag, class string, content []byte) string {
+	return "<" + tag + " class=\"" + class + "\">\n" + string(content) + "</" + tag + ">\n"
+}
+
+// updateHTML 使用块 n 对应的标签渲染文本差异 edits。
+func updateHTML(n *ast.Node, edits []*Edit) string {
+	open, close := "<p>", "</p>"
+	switch n.Type {
+	case ast.NodeHeading:
+		tag := "h" + strconv.Itoa(n.HeadingLevel)
+		open, close = "<"+tag+">", "</"+tag+">"
+	case ast.NodeCodeBlock, ast.NodeMathBlock, ast.NodeHTMLBlock, ast.NodeYamlFrontMatter:
+		open, close = "<pre><code>", "</code></pre>"
+	}
+
+	buf := &strings.Builder{}
+	buf.WriteString("<div class=\"diff-update\">" + open)
+	for _, edit := range edits {
+		text := html.EscapeHTMLStr(edit.Text)
+		switch edit.Kind {
+		case EditInsert:
+			buf.WriteString("<ins>" + text + "</ins>")
+		case EditDelete:
+			buf.WriteString("<del>" + text + "</del>")
+		default:
+			buf.WriteString(text)
+		}
+	}
+	buf.WriteString(close + "</div>\n")
+	return buf.String()
+}
@@ -0,0 +1,133 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package diff
+
+import (
+	"strings"
+	"unicode"
+)
+
+// EditKind 描述了文本差异片段的类型。
+type EditKind int
+
+const (
+	EditEqual  EditKind = iota // 未变化
+	EditInsert                 // 插入
+	EditDelete                 // 删除
+)
+
+// Edit 描述了一个文本差异片段。
+type Edit struct {
+	Kind EditKind // 类型
+	Text string   // 文本
+}
+
+// maxLCSCells 是计算最长公共子序列时允许的最大表格大小，超过时将整段文本视为删除后插入。
+const maxLCSCells = 1 << 22
+
+// Text 比较文本 a 和 b，返回按词划分的差异片段。中日韩文字按字划分。
+func Text(a, b string) []*Edit {
+	return diffWords(words(a), words(b))
+}
+
+// words 将文本 s 划分为词、连续空白和单个标点或者中日韩文字。
+func words(s string) (ret []string) {
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		j := i + 1
+		switch {
+		case unicode.IsSpace(r):
+			for ; j < len(runes) && unicode.IsSpace(runes[j]); j++ {
+			}
+		case isWordRune(r):
+			for ; j < len(runes) && isWordRune(runes[j]); j++ {
+			}
+		}
+		ret = append(ret, string(runes[i:j]))
+		i = j
+	}
+	return
+}
+
+// isWordRune 判断 r 是否是可以组成词的字符，中日韩文字单独成词。
+func isWordRune(r rune) bool {
+	if unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r) {
+		return false
+	}
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || '_' == r
+}
+
+// diffWords 使用最长公共子序列比较分词结果 a 和 b，相邻的同类片段会被合并。
+func diffWords(a, b []string) (ret []*Edit) {
+	// 去掉相同的前缀和后缀以减小表格
+	prefix := 0
+	for ; prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix]; prefix++ {
+	}
+	suffix := 0
+	for ; suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix]; suffix++ {
+	}
+
+	add := func(kind EditKind, text string) {
+		if "" == text {
+			return
+		}
+		if last := len(ret) - 1; 0 <= last && kind == ret[last].Kind {
+			ret[last].Text += text
+			return
+		}
+		ret = append(ret, &Edit{Kind: kind, Text: text})
+	}
+
+	add(EditEqual, strings.Join(a[:prefix], ""))
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if len(ma)*len(mb) > maxLCSCells {
+		add(EditDelete, strings.Join(ma, ""))
+		add(EditInsert, strings.Join(mb, ""))
+	} else {
+		// lcs[i][j] 是 ma[i:] 和 mb[j:] 的最长公共子序列长度
+		lcs := make([][]int, len(ma)+1)
+		for i := range lcs {
+			lcs[i] = make([]int, len(mb)+1)
+		}
+		for i := len(ma) - 1; 0 <= i; i-- {
+			for j := len(mb) - 1; 0 <= j; j-- {
+				if ma[i] == mb[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else if lcs[i+1][j] >= lcs[i][j+1] {
+					lcs[i][j] = lcs[i+1][j]
+				} else {
+					lcs[i][j] = lcs[i][j+1]
+				}
+			}
+		}
+
+		i, j := 0, 0
+		for i < len(ma) && j < len(mb) {
+			switch {
+			case ma[i] == mb[j]:
+				add(EditEqual, ma[i])
+				i++
+				j++
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				add(EditDelete, ma[i])
+				i++
+			default:
+				add(EditInsert, mb[j])
+				j++
+			}
+		}
+		add(EditDelete, strings.Join(ma[i:], ""))
+		add(EditInsert, strings.Join(mb[j:], ""))
+	}
+	add(EditEqual, strings.Join(a[len(a)-suffix:], ""))
+	return
+}
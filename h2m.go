@@ -12,6 +12,7 @@ package lute
 
 import (
 	"bytes"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -229,6 +230,51 @@ func (lute *Lute) genASTByDOM(n *html.Node, tree *parse.Tree) {
 		tree.Context.Tip.AppendChild(node)
 		tree.Context.Tip = node
 		defer tree.Context.ParentTip()
+	case atom.Dl:
+		node.Type = ast.NodeDefinitionList
+		node.ListData = &ast.ListData{Tight: true}
+		tree.Context.Tip.AppendChild(node)
+		tree.Context.Tip = node
+		defer tree.Context.ParentTip()
+	case atom.Dt:
+		if ast.NodeDefinitionList != tree.Context.Tip.Type {
+			break
+		}
+
+		node.Type = ast.NodeDefinitionTerm
+		tree.Context.Tip.AppendChild(node)
+		tree.Context.Tip = node
+		defer tree.Context.ParentTip()
+	case atom.Dd:
+		if ast.NodeDefinitionList != tree.Context.Tip.Type {
+			break
+		}
+		if nil == tree.Context.Tip.ChildByType(ast.NodeDefinitionTerm) {
+			// 前面没有术语的描述如果使用 : 输出的话会成为前一个段落的描述，所以作为定义列表前的普通段落
+			dl := tree.Context.Tip
+			node.Type = ast.NodeParagraph
+			dl.InsertBefore(node)
+			tree.Context.Tip = node
+			defer func() { tree.Context.Tip = dl }()
+			break
+		}
+
+		paras := 0
+		for c := n.FirstChild; nil != c; c = c.NextSibling {
+			if atom.P == c.DataAtom {
+				paras++
+			}
+		}
+		if 1 < paras {
+			// 多个段落的描述需要使用松散模式，否则段落会被合并
+			tree.Context.Tip.ListData.Tight = false
+		}
+
+		node.Type = ast.NodeDefinitionDesc
+		node.ListData = &ast.ListData{Marker: []byte{lex.ItemColon}}
+		tree.Context.Tip.AppendChild(node)
+		tree.Context.Tip = node
+		defer tree.Context.ParentTip()
 	case atom.Pre:
 		if firstc := n.FirstChild; nil != firstc {
 			if html.TextNode == firstc.Type || atom.Span == firstc.DataAtom || atom.Code == firstc.DataAtom {
@@ -437,6 +483,7 @@ func (lute *Lute) genASTByDOM(n *html.Node, tree *parse.Tree) {
 	case atom.Input:
 		node.Type = ast.NodeTaskListItemMarker
 		node.TaskListItemChecked = lute.hasAttr(n, "checked")
+		node.TaskListItemState = lute.taskListItemState(n)
 		tree.Context.Tip.AppendChild(node)
 		if nil != node.Parent.Parent {
 			if nil == node.Parent.Parent.ListData {
@@ -473,9 +520,16 @@ func (lute *Lute) genASTByDOM(n *html.Node, tree *parse.Tree) {
 		defer tree.Context.ParentTip()
 	case atom.Table:
 		node.Type = ast.NodeTable
+		section := n.FirstChild
+		if nil != section && atom.Caption == section.DataAtom {
+			if lute.extendedTable() {
+				node.TableCaption = strings.TrimSpace(util.DomText(section))
+			}
+			section = section.NextSibling
+		}
 		var tableAligns []int
-		if nil != n.FirstChild && nil != n.FirstChild.FirstChild && nil != n.FirstChild.FirstChild.FirstChild {
-			for th := n.FirstChild.FirstChild.FirstChild; nil != th; th = th.NextSibling {
+		if nil != section && nil != section.FirstChild && nil != section.FirstChild.FirstChild {
+			for th := section.FirstChild.FirstChild; nil != th; th = th.NextSibling {
 				align := util.DomAttrValue(th, "align")
 				switch align {
 				case "left":
@@ -493,6 +547,13 @@ func (lute *Lute) genASTByDOM(n *html.Node, tree *parse.Tree) {
 		tree.Context.Tip.AppendChild(node)
 		tree.Context.Tip = node
 		defer tree.Context.ParentTip()
+		if lute.extendedTable() {
+			defer fillTableSpans(node)
+		}
+	case atom.Caption:
+		if lute.extendedTable() {
+			return
+		}
 	case atom.Thead:
 		node.Type = ast.NodeTableHead
 		tree.Context.Tip.AppendChild(node)
@@ -504,8 +565,12 @@ func (lute *Lute) genASTByDOM(n *html.Node, tree *parse.Tree) {
 			break
 		}
 		table := n.Parent.Parent
+		firstSection := table.FirstChild
+		if atom.Caption == firstSection.DataAtom && nil != firstSection.NextSibling {
+			firstSection = firstSection.NextSibling
+		}
 		node.Type = ast.NodeTableRow
-		if atom.Thead != table.FirstChild.DataAtom && n == n.Parent.FirstChild {
+		if atom.Thead != firstSection.DataAtom && n == n.Parent.FirstChild {
 			// 补全 thread 节点
 			thead := &ast.Node{Type: ast.NodeTableHead}
 			tree.Context.Tip.AppendChild(thead)
@@ -530,6 +595,10 @@ func (lute *Lute) genASTByDOM(n *html.Node, tree *parse.Tree) {
 			tableAlign = 0
 		}
 		node.TableCellAlign = tableAlign
+		if lute.extendedTable() {
+			node.TableCellColspan, _ = strconv.Atoi(util.DomAttrValue(n, "colspan"))
+			node.TableCellRowspan, _ = strconv.Atoi(util.DomAttrValue(n, "rowspan"))
+		}
 		tree.Context.Tip.AppendChild(node)
 		tree.Context.Tip = node
 		defer tree.Context.ParentTip()
@@ -677,3 +746,81 @@ func appendSpace(n *html.Node, tree *parse.Tree, lute *Lute) {
 		}
 	}
 }
+
+// extendedTable 判断 HTML 表格是否转换为扩展表格语法，Protyle 使用 IAL 记录合并单元格。
+func (lute *Lute) extendedTable() bool {
+	return lute.ParseOptions.ExtendedTable && !lute.ParseOptions.ProtyleWYSIWYG
+}
+
+// fillTableSpans 根据表格 table 中单元格的 colspan 和 rowspan 补全被合并的单元格，以便格式化时输出 || 和 ^^。
+func fillTableSpans(table *ast.Node) {
+	type rowspan struct {
+		rows    int // 剩余跨行数
+		colspan int
+	}
+
+	var pending []rowspan // 每列上方单元格剩余的跨行
+	fill := func(row, before *ast.Node, col int) int {
+		for col < len(pending) && 0 < pending[col].rows {
+			span := pending[col]
+			pending[col].rows--
+			cell := &ast.Node{Type: ast.NodeTableCell, TableCellMerged: 2, TableCellColspan: span.colspan}
+			insertTableCell(row, before, cell)
+			for i := 1; i < span.colspan; i++ {
+				insertTableCell(row, before, &ast.Node{Type: ast.NodeTableCell, TableCellMerged: 1})
+			}
+			col++
+			if 1 < span.colspan {
+				col += span.colspan - 1
+			}
+		}
+		return col
+	}
+
+	for section := table.FirstChild; nil != section; section = section.Next {
+		rows := []*ast.Node{section}
+		if ast.NodeTableHead == section.Type {
+			rows = nil
+			for tr := section.FirstChild; nil != tr; tr = tr.Next {
+				rows = append(rows, tr)
+			}
+		}
+
+		for _, tr := range rows {
+			col := 0
+			var cells []*ast.Node
+			for cell := tr.FirstChild; nil != cell; cell = cell.Next {
+				cells = append(cells, cell)
+			}
+			for _, cell := range cells {
+				col = fill(tr, cell, col)
+				colspan := 1
+				for ; colspan < cell.TableCellColspan; colspan++ {
+					cell.InsertAfter(&ast.Node{Type: ast.NodeTableCell, TableCellMerged: 1})
+				}
+				if 1 < cell.TableCellRowspan {
+					for len(pending) < col+1 {
+						pending = append(pending, rowspan{})
+					}
+					pending[col] = rowspan{rows: cell.TableCellRowspan - 1, colspan: cell.TableCellColspan}
+				}
+				col += colspan
+			}
+			fill(tr, nil, col)
+		}
+
+		if ast.NodeTableHead == section.Type {
+			// 表头和表体之间不能合并
+			pending = nil
+		}
+	}
+}
+
+// insertTableCell 将单元格 cell 插入到表格行 row 的单元格 before 之前，before 为 nil 时插入到最后。
+func insertTableCell(row, before, cell *ast.Node) {
+	if nil == before {
+		row.AppendChild(cell)
+	} else {
+		before.InsertBefore(cell)
+	}
+}
@@ -10,7 +10,11 @@
 
 package lex
 
-import "unicode/utf8"
+import (
+	"bufio"
+	"io"
+	"unicode/utf8"
+)
 
 // Lexer 描述了词法分析器结构。
 type Lexer struct {
@@ -18,6 +22,13 @@ type Lexer struct {
 	length int    // 输入的文本字节数组的长度
 	offset int    // 当前读取字节位置
 	width  int    // 最新一个字符的长度（字节数）
+
+	line       int // 最新一行的行号，从 1 开始
+	lineOffset int // 最新一行在原始输入中的起始偏移量
+	nextOffset int // 下一行在原始输入中的起始偏移量
+
+	reader *bufio.Reader // 流式输入，不为 nil 时 input 仅保存当前读取的一行
+	err    error         // 读取流式输入时遇到的错误
 }
 
 // NewLexer 创建一个词法分析器。
@@ -31,14 +42,21 @@ func NewLexer(input []byte) (ret *Lexer) {
 	return
 }
 
+// NewReaderLexer 创建一个从 reader 中逐行读取输入的词法分析器。
+func NewReaderLexer(reader io.Reader) (ret *Lexer) {
+	ret = &Lexer{reader: bufio.NewReader(reader)}
+	return
+}
+
 // NextLine 返回下一行。
 func (l *Lexer) NextLine() (ret []byte) {
-	if l.offset >= l.length {
+	if l.offset >= l.length && !l.fill() {
 		return
 	}
 
 	var b, nb byte
 	i := l.offset
+	delta := 0 // 原始输入和预处理后输入的长度差
 	for ; i < l.length; i += l.width {
 		b = l.input[i]
 		if ItemNewline == b {
@@ -50,6 +68,7 @@ func (l *Lexer) NextLine() (ret []byte) {
 				if ItemNewline == nb { // \r\n
 					l.input = append(l.input[:i], l.input[i+1:]...) // 移除 \r，依靠下一个的 \n 切行
 					l.length--                                      // 重新计算总长
+					delta++
 				} else { // \rX
 					l.input[i] = ItemNewline // 将 \r 替换为 \n
 				}
@@ -65,6 +84,7 @@ func (l *Lexer) NextLine() (ret []byte) {
 			// \uFFFD 的 UTF-8 编码为 \xEF\xBF\xBD 共三个字节
 			l.input[i], l.input[i+1], l.input[i+2] = '\xEF', '\xBF', '\xBD'
 			l.length += 2 // 重新计算总长
+			delta -= 2
 			l.width = 3
 			continue
 		}
@@ -77,5 +97,49 @@ func (l *Lexer) NextLine() (ret []byte) {
 	}
 	ret = l.input[l.offset:i]
 	l.offset = i
+	l.line++
+	l.lineOffset = l.nextOffset
+	l.nextOffset += len(ret) + delta
 	return
 }
+
+// fill 从流式输入中读取新的一行，没有更多输入时返回 false。
+func (l *Lexer) fill() bool {
+	if nil == l.reader || nil != l.err {
+		return false
+	}
+
+	input, err := l.reader.ReadBytes(ItemNewline)
+	if nil != err {
+		if io.EOF != err {
+			l.err = err
+			return false
+		}
+		l.err = err
+		if 1 > len(input) {
+			return false
+		}
+		// 以 \n 结尾预处理
+		input = append(input, ItemNewline)
+	}
+	l.input, l.length, l.offset = input, len(input), 0
+	return true
+}
+
+// Err 返回读取流式输入时遇到的错误，正常读取到结尾时返回 nil。
+func (l *Lexer) Err() error {
+	if io.EOF == l.err {
+		return nil
+	}
+	return l.err
+}
+
+// Line 返回最新一行的行号，从 1 开始。
+func (l *Lexer) Line() int {
+	return l.line
+}
+
+// LineOffset 返回最新一行在原始输入中的起始字节偏移量。
+func (l *Lexer) LineOffset() int {
+	return l.lineOffset
+}
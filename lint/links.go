@@ -0,0 +1,266 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package lint
+
+import (
+	"io/fs"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/parse"
+	"github.com/Dofingert/lute-for-ficus/render"
+	"github.com/Dofingert/lute-for-ficus/util"
+)
+
+// LinkKind 描述了链接的类型。
+type LinkKind int
+
+const (
+	LinkKindLink         LinkKind = iota // 链接 [foo](/bar) 和自动链接
+	LinkKindImage                        // 图片 ![foo](/bar.png)
+	LinkKindLinkRefDef                   // 链接引用定义 [foo]: /bar
+	LinkKindFootnotesRef                 // 脚注引用 [^foo]
+	LinkKindBlockRef                     // 内容块引用 ((id "text"))
+)
+
+// Link 描述了文档中的一个链接。
+type Link struct {
+	Kind LinkKind    // 类型
+	Tree *parse.Tree // 所在文档
+	Node *ast.Node   // 链接节点
+	Dest string      // 链接地址，脚注引用时为脚注 label，内容块引用时为被引用的内容块 ID
+}
+
+// CollectLinks 按文档顺序收集文档 trees 中的链接、图片、链接引用定义、脚注引用和内容块引用。
+//
+// 通过链接引用定义 [foo] 引用的链接和图片的地址来自定义，所以只收集定义，不收集引用。
+func CollectLinks(trees ...*parse.Tree) (ret []*Link) {
+	for _, tree := range trees {
+		ast.Walk(tree.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+			if !entering {
+				return ast.WalkContinue
+			}
+
+			switch n.Type {
+			case ast.NodeLinkRefDef:
+				if link := n.FirstChild; nil != link {
+					ret = append(ret, &Link{Kind: LinkKindLinkRefDef, Tree: tree, Node: n, Dest: linkDest(link)})
+				}
+				return ast.WalkSkipChildren
+			case ast.NodeLink, ast.NodeImage:
+				if 3 == n.LinkType {
+					return ast.WalkContinue
+				}
+				kind := LinkKindLink
+				if ast.NodeImage == n.Type {
+					kind = LinkKindImage
+				}
+				ret = append(ret, &Link{Kind: kind, Tree: tree, Node: n, Dest: linkDest(n)})
+			case ast.NodeFootnotesRef:
+				ret = append(ret, &Link{Kind: LinkKindFootnotesRef, Tree: tree, Node: n, Dest: util.BytesToStr(n.FootnotesRefLabel)})
+			case ast.NodeBlockRef:
+				if id := n.ChildByType(ast.NodeBlockRefID); nil != id {
+					ret = append(ret, &Link{Kind: LinkKindBlockRef, Tree: tree, Node: n, Dest: util.BytesToStr(id.Tokens)})
+				}
+				return ast.WalkSkipChildren
+			}
+			return ast.WalkContinue
+		})
+	}
+	return
+}
+
+func linkDest(link *ast.Node) string {
+	if dest := link.ChildByType(ast.NodeLinkDest); nil != dest {
+		return util.BytesToStr(dest.Tokens)
+	}
+	return ""
+}
+
+// LinkChecker 描述了一组文档的本地链接检查器。
+type LinkChecker struct {
+	FS       fs.FS                  // 文档和资源文件所在的根目录，文档路径相对于该目录，为 nil 时不检查文件是否存在
+	Slugger  render.Slugger         // 标题锚点 id 生成器，为 nil 时和 render.HeadingID 一致
+	ValidURL func(dest string) bool // 带有 :// 的外部链接的语法检查，比如 Lute.IsValidLinkDest，为 nil 时不检查外部链接
+}
+
+// Check 检查文档 trees 中的链接，返回按文档路径和位置排序的诊断结果。文档路径使用 parse.Tree.Path，为空时使用 parse.Tree.Name。
+//
+// 报告的问题包括不存在的文件、不存在的标题锚点、不存在的内容块、语法错误的外部链接、没有被引用的链接引用定义和没有被引用的脚注。
+// 锚点只在被链接的文档也在 trees 中时检查。
+func (c *LinkChecker) Check(trees ...*parse.Tree) (ret []*Diagnostic) {
+	anchors := map[string]map[string]bool{}
+	blockIDs := map[string]bool{}
+	for _, tree := range trees {
+		anchors[treePath(tree)] = c.anchors(tree)
+		ast.Walk(tree.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+			if entering && "" != n.ID {
+				blockIDs[n.ID] = true
+			}
+			return ast.WalkContinue
+		})
+	}
+
+	report := func(link *Link, ruleID string, severity Severity, message string) {
+		ret = append(ret, newDiagnostic(link.Tree, link.Node, ruleID, severity, message))
+	}
+	for _, link := range CollectLinks(trees...) {
+		switch link.Kind {
+		case LinkKindFootnotesRef:
+			continue
+		case LinkKindBlockRef:
+			if !blockIDs[link.Dest] {
+				report(link, "missing-block-ref", SeverityError, "Block "+link.Dest+" not found")
+			}
+			continue
+		}
+
+		dest := link.Dest
+		if "" == dest {
+			// 空链接由 no-empty-links 规则检查
+			continue
+		}
+		if isExternalLink(dest) {
+			// mailto: 等没有主机部分的链接不检查语法
+			if nil != c.ValidURL && strings.Contains(dest, "://") && !c.ValidURL(dest) {
+				report(link, "invalid-url", SeverityWarning, "Invalid URL "+dest)
+			}
+			continue
+		}
+
+		file, fragment := dest, ""
+		if i := strings.IndexByte(file, '#'); 0 <= i {
+			file, fragment = file[:i], file[i+1:]
+		}
+		if i := strings.IndexByte(file, '?'); 0 <= i {
+			file = file[:i]
+		}
+		if unescaped, err := url.PathUnescape(file); nil == err {
+			file = unescaped
+		}
+		if unescaped, err := url.PathUnescape(fragment); nil == err {
+			fragment = unescaped
+		}
+
+		target := treePath(link.Tree)
+		if "" != file {
+			if strings.HasPrefix(file, "/") {
+				target = path.Clean(strings.TrimLeft(file, "/"))
+			} else {
+				target = path.Join(path.Dir(target), file)
+			}
+			if _, ok := anchors[target]; !ok && nil != c.FS {
+				if _, err := fs.Stat(c.FS, target); nil != err {
+					report(link, "missing-file", SeverityError, "File "+target+" not found")
+					continue
+				}
+			}
+		}
+		if "" == fragment {
+			continue
+		}
+		if ids, ok := anchors[target]; ok && !ids[fragment] {
+			report(link, "missing-anchor", SeverityError, "Anchor #"+fragment+" not found in "+target)
+		}
+	}
+
+	for _, tree := range trees {
+		ret = append(ret, unusedDefs(tree)...)
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].Path != ret[j].Path {
+			return ret[i].Path < ret[j].Path
+		}
+		if ret[i].Line != ret[j].Line {
+			return ret[i].Line < ret[j].Line
+		}
+		return ret[i].Column < ret[j].Column
+	})
+	return
+}
+
+// anchors 返回文档 tree 中可以作为链接锚点的 id，包括标题锚点 id 和通过属性指定的 id。
+func (c *LinkChecker) anchors(tree *parse.Tree) map[string]bool {
+	ret := map[string]bool{}
+	for _, id := range render.HeadingSlugs(tree.Root, c.Slugger) {
+		ret[id] = true
+	}
+	ast.Walk(tree.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if !entering {
+			return ast.WalkContinue
+		}
+		if id := n.IALAttr("id"); "" != id {
+			ret[id] = true
+		}
+		return ast.WalkContinue
+	})
+	return ret
+}
+
+// unusedDefs 返回文档 tree 中没有被引用的链接引用定义和脚注定义。
+func unusedDefs(tree *parse.Tree) (ret []*Diagnostic) {
+	used := map[*ast.Node]bool{}
+	ast.Walk(tree.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if entering && (ast.NodeLink == n.Type || ast.NodeImage == n.Type) && 3 == n.LinkType {
+			if link := tree.FindLinkRefDefLink(n.LinkRefLabel); nil != link {
+				used[link.Parent] = true
+			}
+		}
+		return ast.WalkContinue
+	})
+
+	ast.Walk(tree.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if !entering {
+			return ast.WalkContinue
+		}
+
+		switch n.Type {
+		case ast.NodeLinkRefDef:
+			if !used[n] {
+				ret = append(ret, newDiagnostic(tree, n, "unused-link-ref-def", SeverityWarning, "Link reference definition ["+util.BytesToStr(n.Tokens)+"] is unused"))
+			}
+			return ast.WalkSkipChildren
+		case ast.NodeFootnotesDef:
+			if !n.FootnotesInline && 1 > len(n.FootnotesRefs) {
+				ret = append(ret, newDiagnostic(tree, n, "unused-footnote", SeverityWarning, "Footnote ["+util.BytesToStr(n.Tokens)+"] is unused"))
+			}
+		}
+		return ast.WalkContinue
+	})
+	return
+}
+
+func newDiagnostic(tree *parse.Tree, node *ast.Node, ruleID string, severity Severity, message string) *Diagnostic {
+	line, column := nodePos(node)
+	return &Diagnostic{RuleID: ruleID, Severity: severity, Message: message, Path: treePath(tree), Node: node, Line: line, Column: column}
+}
+
+// treePath 返回文档 tree 的路径。
+func treePath(tree *parse.Tree) string {
+	if "" != tree.Path {
+		return tree.Path
+	}
+	return tree.Name
+}
+
+// isExternalLink 判断链接地址 dest 是否带有协议，比如 https://b3log.org 和 mailto:foo@bar.com。
+func isExternalLink(dest string) bool {
+	if strings.HasPrefix(dest, "//") {
+		return true
+	}
+	u, err := url.Parse(dest)
+	// 单个字母的协议是 Windows 盘符，比如 C:/foo.png
+	return nil == err && 1 < len(u.Scheme)
+}
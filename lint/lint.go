@@ -0,0 +1,204 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+// Package lint 实现了基于语法树的 Markdown 检查，检查规则可以自定义注册，可修复的问题通过修改语法树后由格式化渲染器输出来修复。
+package lint
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/parse"
+	"github.com/Dofingert/lute-for-ficus/render"
+)
+
+// Severity 描述了诊断的严重程度。
+type Severity int
+
+const (
+	SeverityInfo    Severity = iota // 提示
+	SeverityWarning                 // 警告
+	SeverityError                   // 错误
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	}
+	return "info"
+}
+
+// Diagnostic 描述了一条检查结果。
+type Diagnostic struct {
+	RuleID   string    // 规则 ID
+	Severity Severity  // 严重程度
+	Message  string    // 描述
+	Path     string    // 文档路径，仅在检查多个文档时使用
+	Node     *ast.Node // 相关节点，比如行尾空格这类没有对应节点的问题为 nil
+	Line     int       // 行号，从 1 开始，语法树没有记录位置时为 0
+	Column   int       // 列号，从 1 开始，按字节计算
+	Fix      func()    // 修复操作，通过修改语法树修复问题，为 nil 时表示不能自动修复
+}
+
+// String 返回形如 3:1 warning heading-increment: message 的描述，有文档路径时以 path: 开头。
+func (d *Diagnostic) String() string {
+	ret := ""
+	if "" != d.Path {
+		ret = d.Path + ":"
+	}
+	return ret + strconv.Itoa(d.Line) + ":" + strconv.Itoa(d.Column) + " " + d.Severity.String() + " " + d.RuleID + ": " + d.Message
+}
+
+// Rule 描述了检查规则。
+type Rule struct {
+	ID          string             // 规则 ID，比如 heading-increment
+	Description string             // 规则说明
+	Severity    Severity           // 默认严重程度
+	Check       func(ctx *Context) // 检查语法树并通过 ctx.Report 报告问题
+}
+
+var registry = map[string]*Rule{}
+
+// Register 注册检查规则 rule，ID 相同的规则会被覆盖。
+func Register(rule *Rule) {
+	registry[rule.ID] = rule
+}
+
+// Rules 返回所有已注册的规则，按 ID 排序。
+func Rules() (ret []*Rule) {
+	for _, rule := range registry {
+		ret = append(ret, rule)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].ID < ret[j].ID })
+	return
+}
+
+// Context 描述了规则检查时的上下文。
+type Context struct {
+	Tree    *parse.Tree    // 语法树
+	Source  []byte         // Markdown 原文，语法树没有保留原文或者已经被修改时为 nil
+	Slugger render.Slugger // 标题锚点 id 生成器，为 nil 时和 render.HeadingID 一致
+
+	linter      *Linter
+	rule        *Rule
+	diagnostics []*Diagnostic
+}
+
+// Report 报告节点 node 上的问题，fix 为修复该问题的语法树修改操作，不能自动修复时传入 nil。
+func (ctx *Context) Report(node *ast.Node, message string, fix func()) {
+	line, column := nodePos(node)
+	ctx.report(node, line, column, message, fix)
+}
+
+// ReportAt 报告原文第 line 行第 column 列上的问题。
+func (ctx *Context) ReportAt(line, column int, message string, fix func()) {
+	ctx.report(nil, line, column, message, fix)
+}
+
+func (ctx *Context) report(node *ast.Node, line, column int, message string, fix func()) {
+	severity := ctx.rule.Severity
+	if s, ok := ctx.linter.Severities[ctx.rule.ID]; ok {
+		severity = s
+	}
+	ctx.diagnostics = append(ctx.diagnostics, &Diagnostic{
+		RuleID: ctx.rule.ID, Severity: severity, Message: message, Node: node, Line: line, Column: column, Fix: fix,
+	})
+}
+
+// nodePos 返回节点 node 的起始位置，node 没有记录位置时使用最近的有位置的祖先节点。
+func nodePos(node *ast.Node) (line, column int) {
+	for n := node; nil != n; n = n.Parent {
+		if nil != n.SourcePos {
+			return n.SourcePos.StartLine, n.SourcePos.StartColumn
+		}
+	}
+	return
+}
+
+// Linter 描述了检查器。
+type Linter struct {
+	Rules      []*Rule             // 启用的规则
+	Severities map[string]Severity // 按规则 ID 覆盖规则的默认严重程度
+	Slugger    render.Slugger      // 检查重复标题 id 时使用的锚点 id 生成器，为 nil 时和 render.HeadingID 一致
+}
+
+// New 创建一个启用 ruleIDs 对应规则的检查器，ruleIDs 为空时启用所有已注册的规则。
+func New(ruleIDs ...string) *Linter {
+	ret := &Linter{Severities: map[string]Severity{}}
+	if 1 > len(ruleIDs) {
+		ret.Rules = Rules()
+		return ret
+	}
+	for _, id := range ruleIDs {
+		if rule := registry[id]; nil != rule {
+			ret.Rules = append(ret.Rules, rule)
+		}
+	}
+	return ret
+}
+
+// HasRule 判断检查器是否启用了 ID 为 id 的规则。
+func (l *Linter) HasRule(id string) bool {
+	for _, rule := range l.Rules {
+		if id == rule.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// Lint 检查语法树 tree，返回按位置排序的诊断结果。
+//
+// 节点位置和行尾空格等基于原文的检查依赖解析选项 SourcePos。
+func (l *Linter) Lint(tree *parse.Tree) []*Diagnostic {
+	return l.lint(tree, tree.Source())
+}
+
+// Fix 修复语法树 tree 中可以自动修复的问题，返回修复后仍然存在的问题。修复后的语法树需要使用 FormatRenderer 输出。
+//
+// 一个修复可能引起新的问题（比如标题层级调整后），所以会重复检查直到没有可以修复的问题。
+func (l *Linter) Fix(tree *parse.Tree) (ret []*Diagnostic) {
+	source := tree.Source()
+	for i := 0; i < 8; i++ {
+		ret = l.lint(tree, source)
+		fixed := false
+		for _, diagnostic := range ret {
+			if nil != diagnostic.Fix {
+				diagnostic.Fix()
+				fixed = true
+			}
+		}
+		if !fixed {
+			return
+		}
+		// 语法树已经被修改，原文不再对应语法树
+		source = nil
+	}
+	return l.lint(tree, nil)
+}
+
+func (l *Linter) lint(tree *parse.Tree, source []byte) (ret []*Diagnostic) {
+	for _, rule := range l.Rules {
+		ctx := &Context{Tree: tree, Source: source, Slugger: l.Slugger, linter: l, rule: rule}
+		rule.Check(ctx)
+		ret = append(ret, ctx.diagnostics...)
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].Line != ret[j].Line {
+			return ret[i].Line < ret[j].Line
+		}
+		return ret[i].Column < ret[j].Column
+	})
+	return
+}
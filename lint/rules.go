@@ -0,0 +1,297 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package lint
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/render"
+	"github.com/Dofingert/lute-for-ficus/util"
+)
+
+// 内置规则的 ID 和 markdownlint 的规则别名保持一致，方便对照。
+func init() {
+	Register(&Rule{ID: "heading-increment", Description: "Heading levels should only increment by one level at a time", Severity: SeverityWarning, Check: checkHeadingIncrement})
+	Register(&Rule{ID: "no-duplicate-heading-id", Description: "Heading ids should be unique", Severity: SeverityWarning, Check: checkDuplicateHeadingID})
+	Register(&Rule{ID: "no-empty-links", Description: "Links should have a destination", Severity: SeverityWarning, Check: checkEmptyLinks})
+	Register(&Rule{ID: "no-alt-text", Description: "Images should have alternate text", Severity: SeverityWarning, Check: checkAltText})
+	Register(&Rule{ID: "no-bare-urls", Description: "Bare URLs should be written as links", Severity: SeverityWarning, Check: checkBareURLs})
+	Register(&Rule{ID: "ul-style", Description: "Unordered lists should use a consistent marker", Severity: SeverityWarning, Check: checkULStyle})
+	Register(&Rule{ID: "no-trailing-spaces", Description: "Lines should not end with spaces, except a two-space hard break", Severity: SeverityInfo, Check: checkTrailingSpaces})
+	Register(&Rule{ID: "no-unclosed-git-conflict", Description: "Git conflict markers should be resolved", Severity: SeverityError, Check: checkUnclosedGitConflict})
+}
+
+// walk 按文档顺序遍历语法树中类型为 typ 的节点。
+func walk(ctx *Context, typ ast.NodeType, f func(n *ast.Node)) {
+	ast.Walk(ctx.Tree.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if entering && typ == n.Type {
+			f(n)
+		}
+		return ast.WalkContinue
+	})
+}
+
+func checkHeadingIncrement(ctx *Context) {
+	prev := 0
+	walk(ctx, ast.NodeHeading, func(heading *ast.Node) {
+		if 0 < prev && heading.HeadingLevel > prev+1 {
+			level := prev + 1
+			ctx.Report(heading, "Expected h"+strconv.Itoa(level)+", got h"+strconv.Itoa(heading.HeadingLevel), func() {
+				heading.HeadingLevel = level
+			})
+		}
+		prev = heading.HeadingLevel
+	})
+}
+
+func checkDuplicateHeadingID(ctx *Context) {
+	var headings []*ast.Node
+	ids := map[string]bool{}
+	walk(ctx, ast.NodeHeading, func(heading *ast.Node) {
+		headings = append(headings, heading)
+		ids[render.HeadingBaseSlug(heading, ctx.Slugger)] = true
+	})
+
+	seen := map[string]bool{}
+	for _, heading := range headings {
+		id := render.HeadingBaseSlug(heading, ctx.Slugger)
+		if !seen[id] {
+			seen[id] = true
+			continue
+		}
+
+		var fix func()
+		if headingID := heading.ChildByType(ast.NodeHeadingID); nil != headingID {
+			// 显式指定的 id 可以修改为不重复的 id，自动生成的 id 在渲染时会添加数字后缀
+			unique := id
+			for i := 1; ids[unique]; i++ {
+				unique = id + "-" + strconv.Itoa(i)
+			}
+			ids[unique] = true
+			fix = func() {
+				headingID.Tokens = []byte("#" + unique)
+			}
+		}
+		ctx.Report(heading, "Duplicate heading id \""+id+"\"", fix)
+	}
+}
+
+func checkEmptyLinks(ctx *Context) {
+	walk(ctx, ast.NodeLink, func(link *ast.Node) {
+		if 0 != link.LinkType {
+			return
+		}
+		if dest := link.ChildByType(ast.NodeLinkDest); nil == dest || 0 == len(dest.Tokens) || "#" == util.BytesToStr(dest.Tokens) {
+			ctx.Report(link, "Link has no destination", nil)
+		}
+	})
+}
+
+func checkAltText(ctx *Context) {
+	walk(ctx, ast.NodeImage, func(image *ast.Node) {
+		if text := image.ChildByType(ast.NodeLinkText); nil == text || 0 == len(bytes.TrimSpace(text.Tokens)) {
+			ctx.Report(image, "Image has no alternate text", nil)
+		}
+	})
+}
+
+func checkBareURLs(ctx *Context) {
+	walk(ctx, ast.NodeText, func(text *ast.Node) {
+		for p := text.Parent; nil != p; p = p.Parent {
+			if ast.NodeLink == p.Type || ast.NodeImage == p.Type {
+				return
+			}
+		}
+
+		line, column := nodePos(text)
+		for _, span := range bareURLs(text.Tokens) {
+			url := append([]byte{}, text.Tokens[span[0]:span[1]]...)
+			ln, col := line, column
+			if nil != text.SourcePos {
+				if newlines := bytes.Count(text.Tokens[:span[0]], []byte("\n")); 0 < newlines {
+					// 跨行的文本节点中后续行的缩进已经被剔除，只能在原文对应行中查找列号
+					ln += newlines
+					col = 0
+					if lines := bytes.Split(ctx.Source, []byte("\n")); nil != ctx.Source && ln <= len(lines) {
+						col = bytes.Index(lines[ln-1], url) + 1
+					}
+				} else {
+					col += span[0]
+				}
+			}
+			ctx.report(text, ln, col, "Bare URL "+string(url), func() {
+				linkBareURL(text, url)
+			})
+		}
+	})
+}
+
+// bareURLs 返回 tokens 中裸链接的下标区间。
+func bareURLs(tokens []byte) (ret [][2]int) {
+	for i := 0; i < len(tokens); i++ {
+		if 0 < i && !bytes.ContainsAny(tokens[i-1:i], " \t(") {
+			continue
+		}
+		rest := tokens[i:]
+		prefix := 0
+		for _, p := range []string{"http://", "https://", "ftp://", "www."} {
+			if bytes.HasPrefix(rest, []byte(p)) {
+				prefix = len(p)
+				break
+			}
+		}
+		if 0 == prefix {
+			continue
+		}
+
+		end := i + prefix
+		for ; end < len(tokens) && !bytes.ContainsAny(tokens[end:end+1], " \t<>\"'"); end++ {
+		}
+		// 结尾的标点不属于链接，结尾的 ) 只有在链接中 ( 和 ) 不配对时才不属于链接
+		for ; i+prefix < end; end-- {
+			c := tokens[end-1]
+			if ')' == c && bytes.Count(tokens[i:end], []byte("(")) >= bytes.Count(tokens[i:end], []byte(")")) {
+				break
+			}
+			if !bytes.ContainsAny(tokens[end-1:end], ".,:;!?)") {
+				break
+			}
+		}
+		if i+prefix < end {
+			ret = append(ret, [2]int{i, end})
+		}
+		i = end
+	}
+	return
+}
+
+// linkBareURL 将文本节点 text 及其后续兄弟文本节点中第一次出现的 url 转换为自动链接节点。
+func linkBareURL(text *ast.Node, url []byte) {
+	for n := text; nil != n; n = n.Next {
+		if ast.NodeText != n.Type {
+			continue
+		}
+		i := bytes.Index(n.Tokens, url)
+		if 0 > i {
+			continue
+		}
+
+		dest := url
+		if bytes.HasPrefix(url, []byte("www.")) {
+			dest = append([]byte("http://"), url...)
+		}
+		link := &ast.Node{Type: ast.NodeLink, LinkType: 2}
+		link.AppendChild(&ast.Node{Type: ast.NodeOpenBracket})
+		link.AppendChild(&ast.Node{Type: ast.NodeLinkText, Tokens: url})
+		link.AppendChild(&ast.Node{Type: ast.NodeCloseBracket})
+		link.AppendChild(&ast.Node{Type: ast.NodeOpenParen})
+		link.AppendChild(&ast.Node{Type: ast.NodeLinkDest, Tokens: dest})
+		link.AppendChild(&ast.Node{Type: ast.NodeCloseParen})
+
+		if after := n.Tokens[i+len(url):]; 0 < len(after) {
+			n.InsertAfter(&ast.Node{Type: ast.NodeText, Tokens: after})
+		}
+		n.InsertAfter(link)
+		if n.Tokens = n.Tokens[:i]; 0 == len(n.Tokens) {
+			n.Unlink()
+		}
+		return
+	}
+}
+
+// bulletList 判断节点 n 是否是无序列表。
+func bulletList(n *ast.Node) bool {
+	return nil != n && ast.NodeList == n.Type && nil != n.ListData && 0 != n.ListData.BulletChar
+}
+
+func checkULStyle(ctx *Context) {
+	var bullet byte
+	walk(ctx, ast.NodeList, func(list *ast.Node) {
+		if !bulletList(list) {
+			return
+		}
+		if 0 == bullet {
+			bullet = list.ListData.BulletChar
+			return
+		}
+		if bullet == list.ListData.BulletChar {
+			return
+		}
+
+		var fix func()
+		// 相邻的无序列表使用相同的标记符会被合并为一个列表，所以只在没有相邻无序列表时修复
+		if !bulletList(list.Previous) && !bulletList(list.Next) {
+			marker := bullet
+			fix = func() {
+				list.ListData.BulletChar, list.ListData.Marker = marker, []byte{marker}
+				for li := list.FirstChild; nil != li; li = li.Next {
+					if nil != li.ListData {
+						li.ListData.BulletChar, li.ListData.Marker = marker, []byte{marker}
+					}
+				}
+			}
+		}
+		ctx.Report(list, "Expected list marker "+string(bullet)+", got "+string(list.ListData.BulletChar), fix)
+	})
+}
+
+// verbatimLines 返回原文需要原样保留的行，比如代码块和 HTML 块中的行。
+func verbatimLines(ctx *Context) map[int]bool {
+	ret := map[int]bool{}
+	ast.Walk(ctx.Tree.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if !entering {
+			return ast.WalkContinue
+		}
+		switch n.Type {
+		case ast.NodeCodeBlock, ast.NodeMathBlock, ast.NodeHTMLBlock, ast.NodeYamlFrontMatter, ast.NodeGitConflict:
+			if nil != n.SourcePos {
+				for line := n.SourcePos.StartLine; line <= n.SourcePos.EndLine; line++ {
+					ret[line] = true
+				}
+			}
+			return ast.WalkSkipChildren
+		}
+		return ast.WalkContinue
+	})
+	return ret
+}
+
+func checkTrailingSpaces(ctx *Context) {
+	if nil == ctx.Source {
+		return
+	}
+
+	verbatim := verbatimLines(ctx)
+	lines := bytes.Split(ctx.Source, []byte("\n"))
+	for i, line := range lines {
+		line = bytes.TrimSuffix(line, []byte("\r"))
+		trimmed := bytes.TrimRight(line, " \t")
+		if len(trimmed) == len(line) || verbatim[i+1] {
+			continue
+		}
+		// 非空行后两个空格是硬换行
+		if 0 < len(trimmed) && "  " == string(line[len(trimmed):]) && i+1 < len(lines) && 0 < len(bytes.TrimSpace(lines[i+1])) {
+			continue
+		}
+		// 格式化输出时不会保留行尾空格，所以修复操作不需要修改语法树
+		ctx.ReportAt(i+1, len(trimmed)+1, "Expected no trailing spaces, got "+strconv.Itoa(len(line)-len(trimmed)), func() {})
+	}
+}
+
+func checkUnclosedGitConflict(ctx *Context) {
+	walk(ctx, ast.NodeGitConflict, func(conflict *ast.Node) {
+		if closeMarker := conflict.ChildByType(ast.NodeGitConflictCloseMarker); nil == closeMarker || !bytes.HasPrefix(closeMarker.Tokens, []byte(">>>>>>>")) {
+			ctx.Report(conflict, "Unclosed git conflict marker", nil)
+		}
+	})
+}
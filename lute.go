@@ -14,11 +14,14 @@ package lute
 import (
 	"bytes"
 	"errors"
+	"io"
+	"io/fs"
 	"strings"
 	"sync"
 
 	"github.com/Dofingert/lute-for-ficus/ast"
 	"github.com/Dofingert/lute-for-ficus/lex"
+	"github.com/Dofingert/lute-for-ficus/lint"
 	"github.com/Dofingert/lute-for-ficus/parse"
 	"github.com/Dofingert/lute-for-ficus/render"
 	"github.com/Dofingert/lute-for-ficus/util"
@@ -96,6 +99,17 @@ func (lute *Lute) MarkdownStr(name, markdown string) (html string) {
 	return
 }
 
+// MarkdownTo 从 reader 中流式读取 markdown，并将渲染得到的 html 逐块写入 writer，内存占用和单个顶层块的大小相关，适合转换大文档。
+// 链接引用定义和脚注的延迟解析策略参见 parse.Stream。
+func (lute *Lute) MarkdownTo(writer io.Writer, reader io.Reader) error {
+	stream := parse.NewStream("", reader, lute.ParseOptions)
+	renderer := render.NewHtmlRenderer(stream.Tree, lute.RenderOptions)
+	for nodeType, rendererFunc := range lute.Md2HTMLRendererFuncs {
+		renderer.ExtRendererFuncs[nodeType] = rendererFunc
+	}
+	return renderer.RenderStream(writer, stream)
+}
+
 // Format 将 markdown 文本字节数组进行格式化。
 func (lute *Lute) Format(name string, markdown []byte) (formatted []byte) {
 	tree := parse.Parse(name, markdown, lute.ParseOptions)
@@ -111,6 +125,67 @@ func (lute *Lute) FormatStr(name, markdown string) (formatted string) {
 	return
 }
 
+// UnresolvedCrossRefs 返回 markdown 中没有找到对应图片、表格或者数学公式块标签的交叉引用标签，比如 fig:missing。
+func (lute *Lute) UnresolvedCrossRefs(name, markdown string) []string {
+	tree := parse.Parse(name, []byte(markdown), lute.ParseOptions)
+	return tree.NumberCrossRefs()
+}
+
+// Tasks 返回 markdown 文本中所有的任务列表项，包括任务状态和任务文本。
+func (lute *Lute) Tasks(name, markdown string) []*parse.Task {
+	tree := parse.Parse(name, []byte(markdown), lute.ParseOptions)
+	return tree.Tasks()
+}
+
+// Lint 使用 ruleIDs 对应的规则检查 markdown 文本，ruleIDs 为空时使用所有已注册的规则，返回按位置排序的诊断结果。
+func (lute *Lute) Lint(name, markdown string, ruleIDs ...string) []*lint.Diagnostic {
+	linter := lint.New(ruleIDs...)
+	linter.Slugger = lute.RenderOptions.Slugger
+	return linter.Lint(lute.lintTree(name, markdown, linter))
+}
+
+// LintFix 使用 ruleIDs 对应的规则检查并修复 markdown 文本，返回格式化后的文本和无法自动修复的诊断结果。
+func (lute *Lute) LintFix(name, markdown string, ruleIDs ...string) (fixed string, diagnostics []*lint.Diagnostic) {
+	linter := lint.New(ruleIDs...)
+	linter.Slugger = lute.RenderOptions.Slugger
+	tree := lute.lintTree(name, markdown, linter)
+	diagnostics = linter.Fix(tree)
+	renderer := render.NewFormatRenderer(tree, lute.RenderOptions)
+	fixed = util.BytesToStr(renderer.Render())
+	return
+}
+
+// CheckLinks 读取并解析 fsys 中路径为 paths 的 Markdown 文档，检查文档中的本地链接和资源文件是否存在、锚点和内容块是否存在，
+// 外部链接使用 IsValidLinkDest 检查语法。返回按文档路径和位置排序的诊断结果。
+func (lute *Lute) CheckLinks(fsys fs.FS, paths ...string) (diagnostics []*lint.Diagnostic, err error) {
+	var trees []*parse.Tree
+	for _, p := range paths {
+		var markdown []byte
+		if markdown, err = fs.ReadFile(fsys, p); nil != err {
+			return
+		}
+		tree := lute.lintTree(p, util.BytesToStr(markdown), nil)
+		tree.Path = p
+		trees = append(trees, tree)
+	}
+
+	checker := &lint.LinkChecker{FS: fsys, Slugger: lute.RenderOptions.Slugger, ValidURL: lute.IsValidLinkDest}
+	diagnostics = checker.Check(trees...)
+	return
+}
+
+// lintTree 解析用于检查的语法树：记录节点位置，关闭 GFM 自动链接以便检查裸链接。
+// 检查器 linter 启用了冲突标记规则时打开 Git 冲突标记解析，其他情况下保持原有选项，避免修复后的格式化输出改变冲突标记之外的内容。
+func (lute *Lute) lintTree(name, markdown string, linter *lint.Linter) *parse.Tree {
+	options := *lute.ParseOptions
+	options.SourcePos = true
+	options.GFMAutoLink = false
+	if nil != linter && linter.HasRule("no-unclosed-git-conflict") {
+		options.GitConflict = true
+	}
+	return parse.Parse(name, []byte(markdown), &options)
+}
+
 // TextBundle 将 markdown 文本字节数组进行 TextBundle 处理。
 func (lute *Lute) TextBundle(name string, markdown []byte, linkPrefixes []string) (textbundle []byte, originalLinks []string) {
 	tree := parse.Parse(name, markdown, lute.ParseOptions)
@@ -193,6 +268,21 @@ func (lute *Lute) PutEmojis(emojiMap map[string]string) {
 	}
 }
 
+// SearchEmojis 按别名和关键词搜索 Emoji 数据集，最多返回 limit 个结果，limit 小于 1 时不限制，排序规则参见 parse.SearchEmojis。
+func (lute *Lute) SearchEmojis(query string, limit int) []*parse.Emoji {
+	return parse.SearchEmojis(query, limit)
+}
+
+// GetEmojiCategories 返回 Emoji 分类列表。
+func (lute *Lute) GetEmojiCategories() []string {
+	return parse.EmojiCategories
+}
+
+// GetEmojisByCategory 返回 Emoji 数据集中分类 category 下的 Emoji。
+func (lute *Lute) GetEmojisByCategory(category string) []*parse.Emoji {
+	return parse.GetEmojisByCategory(category)
+}
+
 // RemoveEmoji 用于删除 str 中的 Emoji Unicode。
 func (lute *Lute) RemoveEmoji(str string) string {
 	parse.EmojiLock.Lock()
@@ -311,6 +401,10 @@ func (lute *Lute) SetGFMTaskListItem(b bool) {
 	lute.ParseOptions.GFMTaskListItem = b
 }
 
+func (lute *Lute) SetTaskListItemStates(states string) {
+	lute.ParseOptions.TaskListItemStates = states
+}
+
 func (lute *Lute) SetGFMTaskListItemClass(class string) {
 	lute.RenderOptions.GFMTaskListItemClass = class
 }
@@ -361,6 +455,26 @@ func (lute *Lute) SetHeadingID(b bool) {
 	lute.RenderOptions.HeadingID = b
 }
 
+func (lute *Lute) SetSourcePos(b bool) {
+	lute.ParseOptions.SourcePos = b
+	lute.RenderOptions.SourcePos = b
+}
+
+// RegisterBlockSyntax 注册扩展块级语法，扩展节点的渲染函数可以通过 Md2HTMLRendererFuncs 等设置。
+func (lute *Lute) RegisterBlockSyntax(syntax *parse.BlockSyntax) error {
+	return lute.ParseOptions.RegisterBlockSyntax(syntax)
+}
+
+// RegisterInlineSyntax 注册由触发字节开始的扩展行级语法。
+func (lute *Lute) RegisterInlineSyntax(syntax *parse.InlineSyntax) error {
+	return lute.ParseOptions.RegisterInlineSyntax(syntax)
+}
+
+// RegisterDelimiterSyntax 注册成对出现的扩展分隔符语法，扩展节点及其标记符节点的渲染函数可以通过 Md2HTMLRendererFuncs 等设置。
+func (lute *Lute) RegisterDelimiterSyntax(syntax *parse.DelimiterSyntax) error {
+	return lute.ParseOptions.RegisterDelimiterSyntax(syntax)
+}
+
 func (lute *Lute) SetAutoSpace(b bool) {
 	lute.RenderOptions.AutoSpace = b
 }
@@ -369,6 +483,32 @@ func (lute *Lute) SetFixTermTypo(b bool) {
 	lute.RenderOptions.FixTermTypo = b
 }
 
+// SetProseWrap 设置格式化时段落的折行方式，支持 always、never 和 sentence，为空时保留原有换行。
+//
+// 注意：New 默认开启了 SoftBreak2HardBreak，此时段落中的每个换行都会被渲染为 <br />，折行会改变渲染结果，
+// 所以该选项不生效，需要同时调用 SetSoftBreak2HardBreak(false)。
+func (lute *Lute) SetProseWrap(proseWrap string) {
+	lute.RenderOptions.ProseWrap = proseWrap
+}
+
+func (lute *Lute) SetProseWrapWidth(width int) {
+	lute.RenderOptions.ProseWrapWidth = width
+}
+
+// SetFormatStyle 设置格式化输出的 Markdown 风格，可以使用 render.FormatPresets 中的预设，比如 prettier 和 markdownlint。
+func (lute *Lute) SetFormatStyle(style *render.FormatOptions) {
+	lute.RenderOptions.FormatStyle = style
+}
+
+func (lute *Lute) SetSmartPunct(b bool) {
+	lute.RenderOptions.SmartPunct = b
+}
+
+// SetSmartPunctLang 设置智能标点替换使用的引号语言，比如 en、de、fr、zh。
+func (lute *Lute) SetSmartPunctLang(lang string) {
+	lute.RenderOptions.SmartPunctLang = lang
+}
+
 func (lute *Lute) SetEmoji(b bool) {
 	lute.ParseOptions.Emoji = b
 }
@@ -377,6 +517,10 @@ func (lute *Lute) SetEmojis(emojis map[string]string) {
 	lute.ParseOptions.AliasEmoji = emojis
 }
 
+func (lute *Lute) SetEmojiUnicode2Alias(b bool) {
+	lute.RenderOptions.EmojiUnicode2Alias = b
+}
+
 func (lute *Lute) SetEmojiSite(emojiSite string) {
 	lute.ParseOptions.EmojiSite = emojiSite
 }
@@ -521,6 +665,87 @@ func (lute *Lute) SetGitConflict(b bool) {
 	lute.ParseOptions.GitConflict = b
 }
 
+func (lute *Lute) SetCallout(b bool) {
+	lute.ParseOptions.Callout = b
+}
+
+func (lute *Lute) SetDefinitionList(b bool) {
+	lute.ParseOptions.DefinitionList = b
+}
+
+func (lute *Lute) SetWikiLink(b bool) {
+	lute.ParseOptions.WikiLink = b
+}
+
+func (lute *Lute) SetCrossRef(b bool) {
+	lute.ParseOptions.CrossRef = b
+}
+
+func (lute *Lute) SetCitation(b bool) {
+	lute.ParseOptions.Citation = b
+}
+
+func (lute *Lute) SetFencedDiv(b bool) {
+	lute.ParseOptions.FencedDiv = b
+}
+
+func (lute *Lute) SetPandocAttributes(b bool) {
+	lute.ParseOptions.PandocAttributes = b
+}
+
+// SetFormatPandocAttributes 设置格式化时是否使用 Pandoc 通用属性 {#id .class key=val} 输出属性，否则在 kramdown IAL 能够被解析回来时使用 kramdown IAL 输出。
+func (lute *Lute) SetFormatPandocAttributes(b bool) {
+	lute.RenderOptions.PandocAttributes = b
+}
+
+func (lute *Lute) SetExtendedTable(b bool) {
+	lute.ParseOptions.ExtendedTable = b
+}
+
+func (lute *Lute) SetInlineFootnotes(b bool) {
+	lute.ParseOptions.InlineFootnotes = b
+}
+
+// SetWikiLinkResolver 设置 Wiki 链接地址解析器，为 nil 时直接使用链接目标作为链接地址。
+func (lute *Lute) SetWikiLinkResolver(resolver render.WikiLinkResolver) {
+	lute.RenderOptions.WikiLinkResolver = resolver
+}
+
+// SetSlugger 设置标题锚点 id 生成器，内置了 render.GitHubSlugger、render.GitLabSlugger、render.GoldmarkSlugger 和 render.PinyinSlugger。
+func (lute *Lute) SetSlugger(slugger render.Slugger) {
+	lute.RenderOptions.Slugger = slugger
+}
+
+// SetCrossRefNames 设置交叉引用类型的显示名称，比如 {"fig": "图"}，没有设置的类型使用默认名称。
+func (lute *Lute) SetCrossRefNames(names map[string]string) {
+	lute.RenderOptions.CrossRefNames = names
+}
+
+// SetBibliography 设置文献引用使用的文献库，可以通过 render.LoadBibliography 从本地 CSL-JSON 和 BibTeX 文件加载。
+func (lute *Lute) SetBibliography(bibliography *render.Bibliography) {
+	lute.RenderOptions.Bibliography = bibliography
+}
+
+// LoadBibliography 从本地 CSL-JSON（.json）和 BibTeX（.bib）文件加载文献库并设置为文献引用使用的文献库。
+func (lute *Lute) LoadBibliography(paths ...string) error {
+	bibliography, err := render.LoadBibliography(paths...)
+	if nil != err {
+		return err
+	}
+	lute.RenderOptions.Bibliography = bibliography
+	return nil
+}
+
+// SetCitationStyle 设置文献引用样式，支持 author-date 和 numeric。
+func (lute *Lute) SetCitationStyle(style string) {
+	lute.RenderOptions.CitationStyle = style
+}
+
+// SetBibliographyTitle 设置生成的参考文献列表标题。
+func (lute *Lute) SetBibliographyTitle(title string) {
+	lute.RenderOptions.BibliographyTitle = title
+}
+
 func (lute *Lute) SetLinkRef(b bool) {
 	lute.ParseOptions.LinkRef = b
 }
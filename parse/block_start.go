@@ -15,6 +15,7 @@ import (
 )
 
 // blockStarts 返回定义好的一系列函数，每个函数用于判断某种块节点是否可以开始。
+// 函数按顺序尝试，注册扩展块级语法时内置块的优先级依次为 10、20……，参见 BlockSyntax。
 func blockStarts() []blockStartFunc {
 	return []blockStartFunc{
 		GitConflictStart,
@@ -33,6 +34,8 @@ func blockStarts() []blockStartFunc {
 		IALStart,
 		BlockQueryEmbedStart,
 		SuperBlockStart,
+		DefinitionDescStart,
+		FencedDivStart,
 	}
 }
 
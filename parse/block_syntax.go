@@ -0,0 +1,140 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package parse
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+)
+
+// BlockSyntax 描述了一种扩展的块级语法，通过 Options.RegisterBlockSyntax 注册后参与块级解析，不需要修改解析器代码。
+//
+// 开始函数的返回值和内置块一致：0 为不匹配，1 为匹配到容器块，2 为匹配到叶子块，匹配时需要调用 Context.CloseUnmatchedBlocks
+// 和 Context.AddChild(Type) 添加节点并移动 offset 跳过标记符。继续函数的返回值和内置块一致：0 为可以继续，1 为不能继续，2 为
+// 块已经闭合（需要自行调用 Context.Finalize）并且当前行已经处理完毕。
+type BlockSyntax struct {
+	Type      ast.NodeType                            // 节点类型，需要大于 ast.NodeTypeMaxVal
+	Priority  int                                     // 优先级，数值越小越先尝试开始函数，内置块的优先级依次为 10、20……（参见 blockStarts）
+	Container bool                                    // 是否为容器块，容器块可以包含其他块，否则为接受文本行的叶子块
+	Start     func(t *Tree, container *ast.Node) int  // 判断块是否开始
+	Continue  func(n *ast.Node, context *Context) int // 判断块是否可以继续
+	Finalize  func(n *ast.Node, context *Context)     // 块闭合时的最终化处理，可以为 nil
+}
+
+// RegisterBlockSyntax 注册扩展块级语法 syntax。优先级相同时内置块和先注册的语法先尝试。
+func (options *Options) RegisterBlockSyntax(syntax *BlockSyntax) error {
+	if nil == syntax.Start || nil == syntax.Continue {
+		return errors.New("block syntax requires start and continue functions")
+	}
+	if ast.NodeTypeMaxVal >= syntax.Type {
+		return errors.New("block syntax type [" + strconv.Itoa(int(syntax.Type)) + "] conflicts with built-in node types")
+	}
+	if nil != options.blockSyntax(syntax.Type) {
+		return errors.New("block syntax type [" + strconv.Itoa(int(syntax.Type)) + "] has been registered")
+	}
+
+	ast.RegisterBlockType(syntax.Type, syntax.Container)
+	i := len(options.BlockSyntaxes)
+	for 0 < i && options.BlockSyntaxes[i-1].Priority > syntax.Priority {
+		i--
+	}
+	syntaxes := make([]*BlockSyntax, 0, len(options.BlockSyntaxes)+1)
+	syntaxes = append(syntaxes, options.BlockSyntaxes[:i]...)
+	syntaxes = append(syntaxes, syntax)
+	options.BlockSyntaxes = append(syntaxes, options.BlockSyntaxes[i:]...)
+
+	// 合并内置块和扩展块的开始函数，避免每行解析时重复合并
+	builtins := blockStarts()
+	options.blockStarts = make([]blockStartFunc, 0, len(builtins)+len(options.BlockSyntaxes))
+	j := 0
+	for i, start := range builtins {
+		for ; j < len(options.BlockSyntaxes) && options.BlockSyntaxes[j].Priority < (i+1)*10; j++ {
+			options.blockStarts = append(options.blockStarts, options.BlockSyntaxes[j].Start)
+		}
+		options.blockStarts = append(options.blockStarts, start)
+	}
+	for ; j < len(options.BlockSyntaxes); j++ {
+		options.blockStarts = append(options.blockStarts, options.BlockSyntaxes[j].Start)
+	}
+	return nil
+}
+
+// blockSyntax 返回节点类型 nodeType 对应的扩展块级语法，没有注册时返回 nil。
+func (options *Options) blockSyntax(nodeType ast.NodeType) *BlockSyntax {
+	if ast.NodeTypeMaxVal >= nodeType {
+		return nil
+	}
+	for _, syntax := range options.BlockSyntaxes {
+		if nodeType == syntax.Type {
+			return syntax
+		}
+	}
+	return nil
+}
+
+// 以下方法用于在扩展块级语法的开始函数和继续函数中访问解析上下文。
+
+// CurrentLine 返回当前行。
+func (context *Context) CurrentLine() []byte {
+	return context.currentLine
+}
+
+// Offset 返回当前行解析到的位置。
+func (context *Context) Offset() int {
+	return context.offset
+}
+
+// NextNonspace 返回当前行中下一个非空白字符的位置。
+func (context *Context) NextNonspace() int {
+	return context.nextNonspace
+}
+
+// Indent 返回当前行的缩进空格数。
+func (context *Context) Indent() int {
+	return context.indent
+}
+
+// Indented 判断当前行是否缩进了 4 个及以上的空格。
+func (context *Context) Indented() bool {
+	return context.indented
+}
+
+// Blank 判断当前行是否为空行。
+func (context *Context) Blank() bool {
+	return context.blank
+}
+
+// AdvanceOffset 移动 count 个字符位置，columns 指定了遇到 tab 时是否需要空格进行补偿偏移。
+func (context *Context) AdvanceOffset(count int, columns bool) {
+	context.advanceOffset(count, columns)
+}
+
+// AdvanceNextNonspace 移动到下一个非空白字符。
+func (context *Context) AdvanceNextNonspace() {
+	context.advanceNextNonspace()
+}
+
+// CloseUnmatchedBlocks 最终化所有未匹配的块节点。
+func (context *Context) CloseUnmatchedBlocks() {
+	context.closeUnmatchedBlocks()
+}
+
+// AddChild 构造一个 nodeType 节点并作为子节点添加到末梢节点上，添加完成后该子节点会被设置为新的末梢节点。
+func (context *Context) AddChild(nodeType ast.NodeType) *ast.Node {
+	return context.addChild(nodeType)
+}
+
+// Finalize 最终化块节点 block。
+func (context *Context) Finalize(block *ast.Node) {
+	context.finalize(block)
+}
@@ -36,6 +36,16 @@ func BlockquoteStart(t *Tree, container *ast.Node) int {
 		t.Context.advanceOffset(1, true)
 		markers = append(markers, whitespace)
 	}
+	if t.Context.ParseOption.Callout {
+		if ok, typ, fold, title := t.parseCallout(); ok {
+			t.Context.closeUnmatchedBlocks()
+			callout := t.Context.addChild(ast.NodeCallout)
+			callout.CalloutType, callout.CalloutFold, callout.CalloutTitle = typ, fold, title
+			// 提示块标记所在行的剩余内容都是标题
+			t.Context.advanceOffset(t.Context.currentLineLen-1-t.Context.offset, false)
+			return 1
+		}
+	}
 	t.Context.closeUnmatchedBlocks()
 	t.Context.addChild(ast.NodeBlockquote)
 	t.Context.addChildMarker(ast.NodeBlockquoteMarker, markers)
@@ -54,3 +64,35 @@ func BlockquoteContinue(blockquote *ast.Node, context *Context) int {
 	}
 	return 1
 }
+
+// parseCallout 解析块引用首行的提示块标记，比如 [!NOTE]、[!tip]- Title。
+func (t *Tree) parseCallout() (ok bool, typ, fold, title string) {
+	return ParseCalloutMarker(t.Context.currentLine[t.Context.offset:])
+}
+
+// ParseCalloutMarker 解析块引用首行内容 line 中的提示块标记，比如 [!NOTE]、[!tip]- Title，返回类型、折叠标记和标题。
+func ParseCalloutMarker(line []byte) (ok bool, typ, fold, title string) {
+	if 4 > len(line) || lex.ItemOpenBracket != line[0] || lex.ItemBang != line[1] {
+		return
+	}
+
+	i := 2
+	for ; i < len(line) && (lex.IsASCIILetterNum(line[i]) || lex.ItemHyphen == line[i] || lex.ItemUnderscore == line[i]); i++ {
+	}
+	if 2 == i || i >= len(line) || lex.ItemCloseBracket != line[i] {
+		return
+	}
+	typ = string(line[2:i])
+	i++
+	if i < len(line) && (lex.ItemPlus == line[i] || lex.ItemHyphen == line[i]) {
+		fold = string(line[i])
+		i++
+	}
+	if i < len(line) && !lex.IsWhitespace(line[i]) {
+		// 标记后面需要是空白或者换行
+		return false, "", "", ""
+	}
+	title = string(lex.TrimWhitespace(line[i:]))
+	ok = true
+	return
+}
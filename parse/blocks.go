@@ -22,23 +22,14 @@ import (
 // parseBlocks 解析并生成块级节点。
 func (t *Tree) parseBlocks() {
 	t.Context.Tip = t.Root
+	if t.Context.ParseOption.SourcePos {
+		t.Root.SourcePos = &ast.SourcePos{StartLine: 1, StartColumn: 1}
+	}
 	lines := 0
 	for line := t.lexer.NextLine(); nil != line; line = t.lexer.NextLine() {
-		if t.Context.ParseOption.VditorWYSIWYG || t.Context.ParseOption.VditorIR || t.Context.ParseOption.VditorSV || t.Context.ParseOption.ProtyleWYSIWYG {
-			if !bytes.Equal(line, editor.CaretNewlineTokens) && t.Context.Tip.ParentIs(ast.NodeListItem) && bytes.HasPrefix(line, editor.CaretTokens) {
-				// 插入符在开头的话移动到上一行结尾，处理 https://github.com/Vanessa219/vditor/issues/633 中的一些情况
-				if ast.NodeListItem == t.Context.Tip.Type {
-					t.Context.Tip.AppendChild(&ast.Node{Type: ast.NodeText, Tokens: line})
-					break
-				} else {
-					t.Context.Tip.Tokens = bytes.TrimSuffix(t.Context.Tip.Tokens, []byte("\n"))
-					t.Context.Tip.Tokens = append(t.Context.Tip.Tokens, editor.CaretNewlineTokens...)
-				}
-				line = line[len(editor.CaretTokens):]
-			}
+		if !t.parseBlockLine(line) {
+			break
 		}
-
-		t.incorporateLine(line)
 		lines++
 	}
 	for nil != t.Context.Tip {
@@ -46,6 +37,34 @@ func (t *Tree) parseBlocks() {
 	}
 }
 
+// parseBlockLine 处理 lexer 返回的一行 line，返回 false 时表示后续行不需要再处理。
+func (t *Tree) parseBlockLine(line []byte) bool {
+	return t.parseLine(line, t.lexer.Line(), t.lexer.LineOffset())
+}
+
+// parseLine 处理原文中第 num 行（起始偏移量为 offset）的内容 line，返回 false 时表示后续行不需要再处理。
+func (t *Tree) parseLine(line []byte, num, offset int) bool {
+	if t.Context.ParseOption.SourcePos {
+		t.Context.nextSourceLine(num, offset, line)
+	}
+	if t.Context.ParseOption.VditorWYSIWYG || t.Context.ParseOption.VditorIR || t.Context.ParseOption.VditorSV || t.Context.ParseOption.ProtyleWYSIWYG {
+		if !bytes.Equal(line, editor.CaretNewlineTokens) && t.Context.Tip.ParentIs(ast.NodeListItem) && bytes.HasPrefix(line, editor.CaretTokens) {
+			// 插入符在开头的话移动到上一行结尾，处理 https://github.com/Vanessa219/vditor/issues/633 中的一些情况
+			if ast.NodeListItem == t.Context.Tip.Type {
+				t.Context.Tip.AppendChild(&ast.Node{Type: ast.NodeText, Tokens: line})
+				return false
+			} else {
+				t.Context.Tip.Tokens = bytes.TrimSuffix(t.Context.Tip.Tokens, []byte("\n"))
+				t.Context.Tip.Tokens = append(t.Context.Tip.Tokens, editor.CaretNewlineTokens...)
+			}
+			line = line[len(editor.CaretTokens):]
+		}
+	}
+
+	t.incorporateLine(line)
+	return true
+}
+
 func (t *Tree) BlockCount() (ret int) {
 	ast.Walk(t.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
 		if !entering {
@@ -102,19 +121,25 @@ func (t *Tree) incorporateLine(line []byte) {
 		case 1: // 匹配失败，不能继续处理
 			allMatched = false
 			break
-		case 2: // 匹配围栏代码块闭合，处理下一行
+		case 2: // 匹配围栏代码块或者围栏 div 闭合，处理下一行
 			return
 		case 3: // 匹配超级块闭合，处理下一行
 			t.Context.closeSuperBlockChildren() // 闭合超级块下的子节点
 			if ast.NodeSuperBlock != t.Context.Tip.Type {
 				sb := t.Context.Tip.Parent
 				sb.Close = true
+				if t.Context.ParseOption.SourcePos {
+					t.Context.sourcePosEnd(sb, false)
+				}
 				sb.AppendChild(&ast.Node{Type: ast.NodeSuperBlockCloseMarker})
 				t.Context.Tip = sb.Parent
 				t.Context.lastMatchedContainer = sb
 			} else {
 				t.Context.Tip.AppendChild(&ast.Node{Type: ast.NodeSuperBlockCloseMarker})
 				t.Context.Tip.Close = true
+				if t.Context.ParseOption.SourcePos {
+					t.Context.sourcePosEnd(t.Context.Tip, false)
+				}
 				t.Context.Tip = t.Context.Tip.Parent
 				t.Context.lastMatchedContainer = t.Context.Tip
 			}
@@ -131,7 +156,10 @@ func (t *Tree) incorporateLine(line []byte) {
 	t.Context.lastMatchedContainer = container
 
 	matchedLeaf := container.Type != ast.NodeParagraph && container.AcceptLines()
-	blockParsers := blockStarts()
+	blockParsers := t.Context.ParseOption.blockStarts
+	if nil == blockParsers {
+		blockParsers = blockStarts()
+	}
 	startsLen := len(blockParsers)
 
 	// 除非最后一个匹配到的是代码块，否则的话就起始一个新的块级节点
@@ -141,9 +169,11 @@ func (t *Tree) incorporateLine(line []byte) {
 		// 如果不由潜在的节点标记符开头 ^[#`~*+_=<>0-9-${]，则说明不用继续迭代生成子节点
 		// 这里仅做简单判断的话可以提升一些性能
 		maybeMarker := t.Context.currentLine[t.Context.nextNonspace]
-		if !t.Context.indented && // 缩进代码块
+		if 1 > len(t.Context.ParseOption.BlockSyntaxes) && // 扩展块级语法的标记符未知
+			!t.Context.indented && // 缩进代码块
 			lex.ItemHyphen != maybeMarker && lex.ItemAsterisk != maybeMarker && lex.ItemPlus != maybeMarker && // 无序列表
 			!lex.IsDigit(maybeMarker) && // 有序列表
+			lex.ItemColon != maybeMarker && // 定义列表、围栏 div
 			lex.ItemBacktick != maybeMarker && lex.ItemTilde != maybeMarker && // 代码块
 			lex.ItemSemicolon != maybeMarker && // 定义块
 			lex.ItemCrosshatch != maybeMarker && // ATX 标题
@@ -201,7 +231,7 @@ func (t *Tree) incorporateLine(line []byte) {
 		// 空行判断，主要是为了判断列表是紧凑模式还是松散模式
 		lastLineBlank := t.Context.blank &&
 			!(typ == ast.NodeFootnotesDef ||
-				typ == ast.NodeBlockquote || // 块引用行肯定不会是空行因为至少有一个 >
+				typ == ast.NodeBlockquote || typ == ast.NodeCallout || // 块引用行肯定不会是空行因为至少有一个 >
 				(typ == ast.NodeCodeBlock && isFenced) || // 围栏代码块不计入空行判断
 				(typ == ast.NodeCustomBlock) || // 自定义块不计入空行判断
 				(typ == ast.NodeMathBlock) || // 数学公式块不计入空行判断
@@ -253,17 +283,22 @@ func (t *Tree) addLine() {
 		t.Context.Tip.AppendTokens(bytes.Repeat(util.StrToBytes(" "), charsToTab))
 	}
 
+	start, offset := len(t.Context.Tip.Tokens), t.Context.offset
 	startWithSpace := 1 < t.Context.currentLineLen && (' ' == t.Context.currentLine[0] || '\t' == t.Context.currentLine[0])
 	docChildPara := ast.NodeDocument == t.Context.Tip.Parent.Type
 	if t.Context.ParseOption.ParagraphBeginningSpace && startWithSpace && docChildPara {
 		t.Context.Tip.AppendTokens(t.Context.currentLine)
+		offset = 0
 	} else {
 		t.Context.Tip.AppendTokens(t.Context.currentLine[t.Context.offset:])
 	}
+	if t.Context.ParseOption.SourcePos {
+		t.Context.addSourceLine(start, offset)
+	}
 }
 
 // _continue 判断节点是否可以继续处理，比如块引用需要 >，缩进代码块需要 4 空格，围栏代码块需要 ```。
-// 如果可以继续处理返回 0，如果不能接续处理返回 1，如果返回 2（仅在围栏代码块、超级块或自定义块闭合时）则说明可以继续下一行处理了。
+// 如果可以继续处理返回 0，如果不能接续处理返回 1，如果返回 2（仅在围栏代码块、超级块、自定义块或者围栏 div 闭合时）则说明可以继续下一行处理了。
 func _continue(n *ast.Node, context *Context) int {
 	switch n.Type {
 	case ast.NodeCodeBlock:
@@ -272,9 +307,9 @@ func _continue(n *ast.Node, context *Context) int {
 		return HtmlBlockContinue(n, context)
 	case ast.NodeParagraph:
 		return ParagraphContinue(n, context)
-	case ast.NodeListItem:
+	case ast.NodeListItem, ast.NodeDefinitionDesc:
 		return ListItemContinue(n, context)
-	case ast.NodeBlockquote:
+	case ast.NodeBlockquote, ast.NodeCallout:
 		return BlockquoteContinue(n, context)
 	case ast.NodeMathBlock:
 		return MathBlockContinue(n, context)
@@ -288,9 +323,14 @@ func _continue(n *ast.Node, context *Context) int {
 		return GitConflictContinue(n, context)
 	case ast.NodeCustomBlock:
 		return CustomBlockContinue(n, context)
-	case ast.NodeHeading, ast.NodeThematicBreak, ast.NodeKramdownBlockIAL, ast.NodeLinkRefDefBlock, ast.NodeBlockQueryEmbed,
+	case ast.NodeFencedDiv:
+		return FencedDivContinue(n, context)
+	case ast.NodeHeading, ast.NodeDefinitionTerm, ast.NodeThematicBreak, ast.NodeKramdownBlockIAL, ast.NodeLinkRefDefBlock, ast.NodeBlockQueryEmbed,
 		ast.NodeIFrame, ast.NodeVideo, ast.NodeAudio, ast.NodeWidget, ast.NodeAttributeView:
 		return 1
 	}
+	if syntax := context.ParseOption.blockSyntax(n.Type); nil != syntax {
+		return syntax.Continue(n, context)
+	}
 	return 0
 }
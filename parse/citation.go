@@ -0,0 +1,136 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package parse
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/lex"
+	"github.com/Dofingert/lute-for-ficus/util"
+)
+
+// parseBracketedCitation 解析文献引用 [see @smith2020, p. 33; -@doe2019]，不匹配时返回 nil 并且不移动解析位置。
+//
+// 多个引用项使用 ; 分隔，每个引用项可以有前缀和定位，@ 前的 - 表示不显示作者。后面紧跟 ( 或者 [ 时作为链接处理。
+func (t *Tree) parseBracketedCitation(ctx *InlineContext) *ast.Node {
+	if !t.Context.ParseOption.Citation {
+		return nil
+	}
+
+	tokens := ctx.tokens[ctx.pos:]
+	end := bytes.IndexByte(tokens, lex.ItemCloseBracket)
+	if 2 > end || 0 <= bytes.IndexByte(tokens[1:end], lex.ItemOpenBracket) {
+		return nil
+	}
+	if end+1 < len(tokens) && (lex.ItemOpenParen == tokens[end+1] || lex.ItemOpenBracket == tokens[end+1]) {
+		return nil
+	}
+
+	var citations []*ast.Citation
+	for _, item := range bytes.Split(tokens[1:end], []byte{lex.ItemSemicolon}) {
+		citation := parseCitationItem(item)
+		if nil == citation {
+			return nil
+		}
+		citations = append(citations, citation)
+	}
+
+	ctx.pos += end + 1
+	return &ast.Node{Type: ast.NodeCitation, Citations: citations, Tokens: tokens[:end+1]}
+}
+
+// parseCitationItem 解析引用项 see -@smith2020, p. 33，不合法时返回 nil。
+func parseCitationItem(item []byte) *ast.Citation {
+	item = lex.TrimWhitespace(item)
+	at := -1
+	for i, token := range item {
+		if lex.ItemAt != token {
+			continue
+		}
+		if 0 == i || lex.IsWhitespace(item[i-1]) || (lex.ItemHyphen == item[i-1] && (1 == i || lex.IsWhitespace(item[i-2]))) {
+			at = i
+			break
+		}
+	}
+	if 0 > at {
+		return nil
+	}
+
+	keyLen := citationKeyLen(item[at+1:])
+	if 1 > keyLen {
+		return nil
+	}
+	suffix := item[at+1+keyLen:]
+	if 0 < len(suffix) && ',' != suffix[0] && !lex.IsWhitespace(suffix[0]) {
+		return nil
+	}
+	if 0 < len(suffix) && ',' == suffix[0] {
+		suffix = suffix[1:]
+	}
+
+	ret := &ast.Citation{Key: string(item[at+1 : at+1+keyLen])}
+	prefix := item[:at]
+	if 0 < len(prefix) && lex.ItemHyphen == prefix[len(prefix)-1] {
+		ret.SuppressAuthor = true
+		prefix = prefix[:len(prefix)-1]
+	}
+	ret.Prefix = normalizeCitationText(prefix)
+	ret.Locator = normalizeCitationText(suffix)
+	return ret
+}
+
+// normalizeCitationText 去掉 text 首尾空白并将其中的换行替换为空格。
+func normalizeCitationText(text []byte) string {
+	return strings.Join(strings.Fields(util.BytesToStr(text)), " ")
+}
+
+// parseInTextCitation 解析正文中的文献引用 @smith2020，不匹配时返回 nil 并且不移动解析位置。
+func (t *Tree) parseInTextCitation(ctx *InlineContext) *ast.Node {
+	if !t.Context.ParseOption.Citation {
+		return nil
+	}
+	if 0 < ctx.pos && lex.IsASCIILetterNum(ctx.tokens[ctx.pos-1]) {
+		// 避免和 foo@example.com 这样的邮件地址冲突
+		return nil
+	}
+
+	keyLen := citationKeyLen(ctx.tokens[ctx.pos+1:])
+	if 1 > keyLen {
+		return nil
+	}
+	tokens := ctx.tokens[ctx.pos : ctx.pos+1+keyLen]
+	ctx.pos += 1 + keyLen
+	return &ast.Node{Type: ast.NodeCitation, CitationInText: true, Citations: []*ast.Citation{{Key: string(tokens[1:])}}, Tokens: tokens}
+}
+
+// citationKeyLen 返回 tokens 开头的引用键长度。引用键以字母、数字或者 _ 开头，由字母、数字、_ 组成，中间可以使用 :.#$%&-+?<>~/ 分隔。
+func citationKeyLen(tokens []byte) (ret int) {
+	if 1 > len(tokens) || !isCitationKeyToken(tokens[0]) {
+		return 0
+	}
+	for ret = 1; ret < len(tokens); ret++ {
+		token := tokens[ret]
+		if isCitationKeyToken(token) {
+			continue
+		}
+		if 0 <= strings.IndexByte(":.#$%&-+?<>~/", token) && ret+1 < len(tokens) && isCitationKeyToken(tokens[ret+1]) {
+			continue
+		}
+		break
+	}
+	return
+}
+
+func isCitationKeyToken(token byte) bool {
+	return lex.IsASCIILetterNum(token) || lex.ItemUnderscore == token
+}
@@ -145,7 +145,8 @@ func (t *Tree) parseFencedCode() (ok bool, fenceChar byte, fenceLen int, fenceOf
 	}
 	info := lex.TrimWhitespace(infoTokens)
 	info = html.UnescapeBytes(info)
-	if idx := bytes.IndexByte(info, ' '); 0 <= idx {
+	if idx := bytes.IndexByte(info, ' '); 0 <= idx && (!t.Context.ParseOption.PandocAttributes || !bytes.HasSuffix(info, closeCurlyBrace)) {
+		// 可能带有 Pandoc 通用属性时保留完整信息，由 parseCodeBlockPandocAttributes 解析
 		info = info[:idx]
 	}
 	return true, fenceChar, fenceLen, t.Context.indent, openFence, info
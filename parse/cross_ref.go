@@ -0,0 +1,228 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package parse
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/lex"
+	"github.com/Dofingert/lute-for-ficus/util"
+)
+
+// CrossRefKinds 定义了支持编号的交叉引用类型：图片 fig、表格 tbl 和数学公式块 eq。
+var CrossRefKinds = []string{"fig", "tbl", "eq"}
+
+// crossRefNodeKinds 定义了节点类型对应的交叉引用类型。
+var crossRefNodeKinds = map[ast.NodeType]string{ast.NodeImage: "fig", ast.NodeTable: "tbl", ast.NodeMathBlock: "eq"}
+
+// CrossRefKind 返回交叉引用标签 label 的类型，比如 fig:arch 的类型为 fig，label 不合法时返回空字符串。
+func CrossRefKind(label string) string {
+	i := strings.IndexByte(label, ':')
+	if 0 > i || !isCrossRefName([]byte(label[i+1:])) {
+		return ""
+	}
+	kind := label[:i]
+	for _, k := range CrossRefKinds {
+		if k == kind {
+			return kind
+		}
+	}
+	return ""
+}
+
+// isCrossRefName 判断 name 是否是合法的交叉引用名称，名称由字母、数字、-、_ 组成，中间可以使用 . 和 : 分隔。
+func isCrossRefName(name []byte) bool {
+	if 1 > len(name) {
+		return false
+	}
+	for i, token := range name {
+		if isCrossRefNameToken(token) {
+			continue
+		}
+		if (lex.ItemDot == token || lex.ItemColon == token) && 0 < i && i < len(name)-1 && isCrossRefNameToken(name[i+1]) {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+func isCrossRefNameToken(token byte) bool {
+	return lex.IsASCIILetterNum(token) || lex.ItemHyphen == token || lex.ItemUnderscore == token
+}
+
+// crossRefLabel 判断 tokens 是否是交叉引用标签 {#fig:arch}，是的话返回标签 fig:arch。
+func crossRefLabel(tokens []byte) []byte {
+	tokens = lex.TrimWhitespace(tokens)
+	length := len(tokens)
+	if 4 > length || lex.ItemOpenBrace != tokens[0] || lex.ItemCrosshatch != tokens[1] || lex.ItemCloseBrace != tokens[length-1] {
+		return nil
+	}
+	label := tokens[2 : length-1]
+	if "" == CrossRefKind(util.BytesToStr(label)) {
+		return nil
+	}
+	return label
+}
+
+// parseImageCrossRefLabel 解析紧跟在图片后面的交叉引用标签 ![alt](src) {#fig:arch}，不匹配时返回 nil 并且不移动解析位置。
+//
+// 标签复用自定义标题 ID 节点 NodeHeadingID 保存，以便格式化时原样输出，标签会记录到图片节点上。
+func (t *Tree) parseImageCrossRefLabel(block *ast.Node, ctx *InlineContext) *ast.Node {
+	if !t.Context.ParseOption.CrossRef {
+		return nil
+	}
+
+	image := block.LastChild
+	if nil != image && ast.NodeText == image.Type && lex.IsBlank(image.Tokens) && !bytes.Contains(image.Tokens, []byte("\n")) {
+		image = image.Previous
+	}
+	if nil == image || ast.NodeImage != image.Type || "" != image.CrossRefLabel {
+		return nil
+	}
+
+	tokens := ctx.tokens[ctx.pos:]
+	end := bytes.IndexByte(tokens, lex.ItemCloseBrace)
+	if 0 > end {
+		return nil
+	}
+	label := crossRefLabel(tokens[:end+1])
+	if nil == label {
+		return nil
+	}
+
+	image.CrossRefLabel = string(label)
+	if image != block.LastChild {
+		block.LastChild.Tokens = nil
+	}
+	ctx.pos += end + 1
+	return &ast.Node{Type: ast.NodeHeadingID, Tokens: tokens[1:end]}
+}
+
+// parseCrossRef 解析交叉引用 @fig:arch，不匹配时返回 nil 并且不移动解析位置。
+func (t *Tree) parseCrossRef(ctx *InlineContext) *ast.Node {
+	if !t.Context.ParseOption.CrossRef {
+		return nil
+	}
+	if 0 < ctx.pos && (lex.IsASCIILetterNum(ctx.tokens[ctx.pos-1]) || lex.ItemSlash == ctx.tokens[ctx.pos-1]) {
+		// 避免和 foo@example.com 这样的邮件地址以及 http://x/@fig:a 这样的链接冲突
+		return nil
+	}
+
+	tokens := ctx.tokens[ctx.pos+1:]
+	i := bytes.IndexByte(tokens, lex.ItemColon)
+	if 0 > i {
+		return nil
+	}
+	end := i + 1
+	for ; end < len(tokens); end++ {
+		token := tokens[end]
+		if isCrossRefNameToken(token) {
+			continue
+		}
+		if (lex.ItemDot == token || lex.ItemColon == token) && end+1 < len(tokens) && isCrossRefNameToken(tokens[end+1]) {
+			continue
+		}
+		break
+	}
+	label := tokens[:end]
+	if "" == CrossRefKind(util.BytesToStr(label)) {
+		return nil
+	}
+
+	ctx.pos += 1 + end
+	return &ast.Node{Type: ast.NodeCrossRef, CrossRefLabel: string(label), Tokens: ctx.tokens[ctx.pos-1-end : ctx.pos]}
+}
+
+// NumberCrossRefs 按文档顺序为带有交叉引用标签的图片、表格和数学公式块分别编号，然后解析所有交叉引用 @fig:arch 的编号。
+//
+// 除了 {#fig:arch} 这样的标签，kramdown IAL 中形如 fig:arch 的 id 属性也会作为标签。重复的标签只有第一个有效。
+// 只有单独成段的图片才会作为图编号，行内的图片没有标题所以不编号。链接文本中的交叉引用会还原为文本，避免渲染出嵌套的链接。
+// 返回没有找到对应标签的交叉引用标签列表（按首次出现的顺序去重），打开解析选项 CrossRef 时解析完成后会自动调用。
+func (t *Tree) NumberCrossRefs() (unresolved []string) {
+	counters := map[string]int{}
+	nums := map[string]int{}
+	var refs []*ast.Node
+	ast.Walk(t.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if !entering {
+			return ast.WalkContinue
+		}
+
+		switch n.Type {
+		case ast.NodeCrossRef:
+			if n.ParentIs(ast.NodeLink) {
+				n.Type = ast.NodeText
+				return ast.WalkContinue
+			}
+			refs = append(refs, n)
+		case ast.NodeImage, ast.NodeTable, ast.NodeMathBlock:
+			label := n.CrossRefLabel
+			if "" == label {
+				if id := n.IALAttr("id"); "" != CrossRefKind(id) {
+					label = id
+				}
+			}
+			n.CrossRefNum = 0
+			if "" == label || (ast.NodeImage == n.Type && !StandaloneImage(n)) {
+				return ast.WalkContinue
+			}
+			kind := CrossRefKind(label)
+			if crossRefNodeKinds[n.Type] != kind {
+				// 标签类型和节点类型不一致时不编号
+				return ast.WalkContinue
+			}
+			if _, ok := nums[label]; ok {
+				return ast.WalkContinue
+			}
+			counters[kind]++
+			n.CrossRefLabel = label
+			n.CrossRefNum = counters[kind]
+			nums[label] = n.CrossRefNum
+		}
+		return ast.WalkContinue
+	})
+
+	reported := map[string]bool{}
+	for _, ref := range refs {
+		ref.CrossRefNum = nums[ref.CrossRefLabel]
+		if 0 == ref.CrossRefNum && !reported[ref.CrossRefLabel] {
+			reported[ref.CrossRefLabel] = true
+			unresolved = append(unresolved, ref.CrossRefLabel)
+		}
+	}
+	return
+}
+
+// StandaloneImage 判断图片 image 是否单独成段，单独成段的已编号图片会被渲染为带有标题的 figure。
+func StandaloneImage(image *ast.Node) bool {
+	paragraph := image.Parent
+	if nil == paragraph || ast.NodeParagraph != paragraph.Type {
+		return false
+	}
+	for c := paragraph.FirstChild; nil != c; c = c.Next {
+		switch c.Type {
+		case ast.NodeImage:
+			if c != image {
+				return false
+			}
+		case ast.NodeHeadingID, ast.NodeKramdownSpanIAL:
+		case ast.NodeText:
+			if !lex.IsBlank(c.Tokens) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
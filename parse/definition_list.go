@@ -0,0 +1,175 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package parse
+
+import (
+	"bytes"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/lex"
+)
+
+// DefinitionDescStart 判断定义描述（: Definition）是否开始。
+//
+// 定义描述前面的段落每一行都会转换为一个定义术语，术语和描述之间有空行时定义列表为松散模式。前面是定义列表的话则合并到该定义列表中。
+func DefinitionDescStart(t *Tree, container *ast.Node) int {
+	if !t.Context.ParseOption.DefinitionList || t.Context.indented {
+		return 0
+	}
+
+	ln := t.Context.currentLine
+	if lex.ItemColon != lex.Peek(ln, t.Context.nextNonspace) {
+		return 0
+	}
+	if token := lex.Peek(ln, t.Context.nextNonspace+1); lex.ItemSpace != token && lex.ItemTab != token {
+		return 0
+	}
+	if 1 > len(lex.TrimWhitespace(ln[t.Context.nextNonspace+1:])) {
+		return 0
+	}
+
+	var para, dl *ast.Node
+	loose := false
+	switch container.Type {
+	case ast.NodeParagraph: // 紧跟在术语后面
+		para = container
+	case ast.NodeDefinitionList: // 同一组术语的另一个描述
+		dl = container
+	default:
+		if last := container.LastChild; nil != last && last.Close && ast.NodeParagraph == last.Type && 0 < len(last.Tokens) {
+			// 术语和描述之间有空行
+			para = last
+			loose = true
+		}
+	}
+	if nil == para && nil == dl {
+		return 0
+	}
+
+	if nil != para {
+		if !para.Close {
+			// 解析链接引用定义
+			for tokens := para.Tokens; 0 < len(tokens) && lex.ItemOpenBracket == tokens[0]; tokens = para.Tokens {
+				if remains := t.Context.parseLinkRefDef(tokens); nil != remains {
+					para.Tokens = remains
+				} else {
+					break
+				}
+			}
+		}
+		terms := t.Context.definitionTerms(para)
+		if 1 > len(terms) {
+			return 0
+		}
+
+		t.Context.closeUnmatchedBlocks()
+		if prev := para.Previous; nil != prev && ast.NodeDefinitionList == prev.Type {
+			dl = prev
+			dl.Close = false
+		} else {
+			dl = &ast.Node{Type: ast.NodeDefinitionList, ListData: &ast.ListData{Tight: true}}
+			para.InsertBefore(dl)
+			if t.Context.ParseOption.SourcePos {
+				if nil != terms[0].SourcePos {
+					dl.SourcePos = &ast.SourcePos{StartLine: terms[0].SourcePos.StartLine, StartColumn: terms[0].SourcePos.StartColumn, StartOffset: terms[0].SourcePos.StartOffset}
+				} else {
+					t.Context.sourcePosStart(dl)
+				}
+			}
+		}
+		for _, term := range terms {
+			dl.AppendChild(term)
+		}
+		para.Unlink()
+		delete(t.Context.sourceMaps, para)
+		if loose {
+			dl.ListData.Tight = false
+		}
+		t.Context.Tip = dl
+	} else {
+		t.Context.closeUnmatchedBlocks()
+	}
+
+	desc := t.Context.addChild(ast.NodeDefinitionDesc)
+	desc.ListData = &ast.ListData{Marker: []byte{lex.ItemColon}, MarkerOffset: t.Context.indent}
+
+	// 和列表项一样计算描述内容的缩进
+	t.Context.advanceNextNonspace()
+	t.Context.advanceOffset(1, true)
+	spacesStartCol := t.Context.column
+	spacesStartOffset := t.Context.offset
+	for {
+		t.Context.advanceOffset(1, true)
+		token := lex.Peek(ln, t.Context.offset)
+		if t.Context.column-spacesStartCol >= 5 || 0 == token || (lex.ItemSpace != token && lex.ItemTab != token) {
+			break
+		}
+	}
+	if spacesAfterMarker := t.Context.column - spacesStartCol; spacesAfterMarker >= 5 {
+		desc.ListData.Padding = 2
+		t.Context.column = spacesStartCol
+		t.Context.offset = spacesStartOffset
+		if token := lex.Peek(ln, t.Context.offset); lex.ItemSpace == token || lex.ItemTab == token {
+			t.Context.advanceOffset(1, true)
+		}
+	} else {
+		desc.ListData.Padding = 1 + spacesAfterMarker
+	}
+	return 1
+}
+
+// definitionTerms 将段落 p 的每一行转换为一个定义术语。
+func (context *Context) definitionTerms(p *ast.Node) (ret []*ast.Node) {
+	sm := context.sourceMaps[p]
+	tokens := p.Tokens
+	for start := 0; start < len(tokens); {
+		// 不使用 bytes.Split，以便术语 Tokens 仍然是段落 Tokens 的子切片，用于计算位置
+		end := start + bytes.IndexByte(tokens[start:], lex.ItemNewline)
+		if end < start {
+			end = len(tokens)
+		}
+		line := lex.TrimWhitespace(tokens[start:end])
+		start = end + 1
+		if 1 > len(line) {
+			continue
+		}
+
+		term := &ast.Node{Type: ast.NodeDefinitionTerm, Tokens: line, Close: true}
+		if nil != sm {
+			if i := sm.index(line); 0 <= i {
+				term.SourcePos = sm.newSourcePos(i, i+len(line))
+				context.sourceMaps[term] = sm
+			}
+		}
+		ret = append(ret, term)
+	}
+	return
+}
+
+// definitionListFinalize 检查定义描述之间以及定义描述的子块之间是否包含空行，包含的话说明该定义列表是松散的。
+func (context *Context) definitionListFinalize(dl *ast.Node) {
+	for desc := dl.FirstChild; nil != desc && dl.ListData.Tight; desc = desc.Next {
+		if ast.NodeDefinitionDesc != desc.Type {
+			continue
+		}
+
+		if endsWithBlankLine(desc) && nil != desc.Next && ast.NodeDefinitionDesc == desc.Next.Type {
+			dl.ListData.Tight = false
+			break
+		}
+		for child := desc.FirstChild; nil != child; child = child.Next {
+			if endsWithBlankLine(child) && nil != child.Next {
+				dl.ListData.Tight = false
+				break
+			}
+		}
+	}
+}
@@ -87,6 +87,9 @@ func (t *Tree) processEmphasis(stackBottom *delimiter, ctx *InlineContext) {
 	openersBottom[lex.ItemEqual] = stackBottom
 	openersBottom[lex.ItemCrosshatch] = stackBottom
 	openersBottom[lex.ItemCaret] = stackBottom
+	for _, syntax := range t.Context.ParseOption.DelimiterSyntaxes {
+		openersBottom[syntax.Marker] = stackBottom
+	}
 
 	// find first closer above stack_bottom:
 	closer = ctx.delimiters
@@ -128,6 +131,12 @@ func (t *Tree) processEmphasis(stackBottom *delimiter, ctx *InlineContext) {
 			openerInl = opener.node
 			closerInl = closer.node
 
+			// 扩展分隔符在 scanDelims 中已经保证了开始和结束分隔符的字节数相同
+			syntax := t.Context.ParseOption.delimiterSyntax(closercc)
+			if nil != syntax {
+				useDelims = syntax.Num
+			}
+
 			if t.Context.ParseOption.GFMStrikethrough || t.Context.ParseOption.Sub {
 				if lex.ItemTilde == closercc && opener.num != closer.num {
 					break
@@ -244,6 +253,12 @@ func (t *Tree) processEmphasis(stackBottom *delimiter, ctx *InlineContext) {
 				}
 			}
 
+			if nil != syntax {
+				emStrongDelMark.Type = syntax.Type
+				openMarker.Type = syntax.OpenMarkerType
+				closeMarker.Type = syntax.CloseMarkerType
+			}
+
 			tmp := openerInl.Next
 			for nil != tmp && tmp != closerInl {
 				next := tmp.Next
@@ -370,6 +385,9 @@ func (t *Tree) scanDelims(ctx *InlineContext) *delimiter {
 		} else if t.Context.ParseOption.Sup && lex.ItemCaret == token && 1 != delimitersCount { // ^Sup^ 标记使用一个 ^
 			canOpen = false
 			canClose = false
+		} else if syntax := t.Context.ParseOption.delimiterSyntax(token); nil != syntax && syntax.Num != delimitersCount { // 扩展分隔符需要使用指定个数的字节
+			canOpen = false
+			canClose = false
 		} else if t.Context.ParseOption.Sub && lex.ItemTilde == token {
 			if t.Context.ParseOption.GFMStrikethrough && 3 == delimitersCount { // 单独处理 ~~~foo~~~ 的情况，即下标嵌套删除线
 				canOpen = isLeftFlanking
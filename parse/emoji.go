@@ -104,6 +104,13 @@ func (t *Tree) emoji0(node *ast.Node) {
 				emojiUnicodeOrImg.Type = ast.NodeEmojiImg
 				emojiUnicodeOrImg.Tokens = t.EmojiImgTokens(alias, emoji)
 			} else {
+				if tone, end := emojiSkinTone(tokens, pos+1); 0 < tone {
+					// :thumbsup::skin-tone-3: 使用肤色修饰
+					if e := GetEmoji(emoji); nil != e && e.SkinTones {
+						emojiTokens = util.StrToBytes(e.WithSkinTone(tone))
+						pos = end
+					}
+				}
 				emojiUnicodeOrImg.Tokens = emojiTokens
 			}
 
@@ -133,6 +140,19 @@ func (t *Tree) emoji0(node *ast.Node) {
 	}
 }
 
+// emojiSkinTone 判断 tokens[start:] 是否以肤色别名 :skin-tone-2: 到 :skin-tone-6: 开头，是的话返回肤色和结尾 : 的位置。
+func emojiSkinTone(tokens []byte, start int) (tone, end int) {
+	if start >= len(tokens) || lex.ItemColon != tokens[start] {
+		return
+	}
+	end = bytes.IndexByte(tokens[start+1:], lex.ItemColon)
+	if 0 > end {
+		return 0, 0
+	}
+	end += start + 1
+	return emojiSkinToneAlias(util.BytesToStr(tokens[start+1 : end])), end
+}
+
 func (t *Tree) EmojiImgTokens(alias, src string) []byte {
 	return util.StrToBytes("<img alt=\"" + alias + "\" class=\"emoji\" src=\"" + src + "\" title=\"" + alias + "\" />")
 }
@@ -0,0 +1,354 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package parse
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// EmojiCategories 定义了 Emoji 分类，顺序和 Unicode emoji-test.txt 中的分组一致。
+var EmojiCategories = []string{"smileys-emotion", "people-body", "animals-nature", "food-drink", "travel-places", "activities", "objects", "symbols", "flags"}
+
+// EmojiSkinTones 定义了肤色修饰符，依次对应 :skin-tone-2: 到 :skin-tone-6:（浅色到深色）。
+var EmojiSkinTones = []string{"\U0001F3FB", "\U0001F3FC", "\U0001F3FD", "\U0001F3FE", "\U0001F3FF"}
+
+// Emoji 描述了数据集中的一个 Emoji。
+type Emoji struct {
+	Unicode     string   // 完全限定的 Unicode 字符序列，包括 ZWJ 序列
+	Name        string   // Unicode CLDR 名称，比如 thumbs up
+	Aliases     []string // 别名，第一个是格式化时使用的别名，比如 +1、thumbsup
+	Category    string   // 分类，比如 people-body
+	Subcategory string   // 子分类，比如 hand-fingers-closed
+	Keywords    []string // 关键词，由名称和子分类中的单词组成
+	SkinTones   bool     // 是否支持肤色修饰，比如 :thumbsup::skin-tone-3:
+
+	light string // 浅肤色变体，其他肤色通过替换肤色修饰符得到
+}
+
+// emojiDatum 是生成的 Emoji 数据，参见 emoji_gen.go。
+type emojiDatum struct {
+	unicode     string
+	name        string
+	category    int
+	subcategory int
+	light       string
+}
+
+// Emojis 是按分类排列的 Emoji 数据集。
+var Emojis []*Emoji
+
+// emojiByKey 存储去掉 U+FE0F 后的 Unicode 字符序列到 Emoji 的映射。
+var emojiByKey map[string]*Emoji
+
+// emojiByLight 存储浅肤色变体到 Emoji 的映射。
+var emojiByLight map[string]*Emoji
+
+// initEmojis 使用生成的数据构造 Emoji 数据集，并为别名表 EmojiAliasUnicode 中没有别名的 Emoji 添加由名称生成的别名，比如 melting_face。
+func initEmojis() {
+	aliases := map[string][]string{}
+	for alias, u := range EmojiAliasUnicode {
+		key := emojiKey(u)
+		aliases[key] = append(aliases[key], alias)
+	}
+
+	Emojis = make([]*Emoji, 0, len(emojiData))
+	emojiByKey = make(map[string]*Emoji, len(emojiData))
+	emojiByLight = map[string]*Emoji{}
+	for _, datum := range emojiData {
+		emoji := &Emoji{Unicode: datum.unicode, Name: datum.name, Category: EmojiCategories[datum.category], Subcategory: emojiSubcategories[datum.subcategory],
+			SkinTones: "" != datum.light, light: datum.light}
+		key := emojiKey(datum.unicode)
+		emoji.Aliases = aliases[key]
+		sortEmojiAliases(emoji.Aliases)
+		if 1 > len(emoji.Aliases) {
+			if alias := emojiNameAlias(datum.name); "" != alias {
+				if _, exists := EmojiAliasUnicode[alias]; !exists {
+					EmojiAliasUnicode[alias] = datum.unicode
+					emoji.Aliases = []string{alias}
+				}
+			}
+		}
+		emoji.Keywords = emojiKeywords(emoji)
+		Emojis = append(Emojis, emoji)
+		emojiByKey[key] = emoji
+		if emoji.SkinTones {
+			emojiByLight[emoji.light] = emoji
+		}
+	}
+}
+
+// sortEmojiAliases 按长度和字典序排列别名，保证同一个 Emoji 总是使用相同的别名格式化。
+func sortEmojiAliases(aliases []string) {
+	sort.Slice(aliases, func(i, j int) bool { return emojiAliasLess(aliases[i], aliases[j]) })
+}
+
+func emojiAliasLess(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
+}
+
+// emojiKey 去掉 u 中的 U+FE0F 变体选择符，用于匹配是否带有变体选择符的同一个 Emoji。
+func emojiKey(u string) string {
+	return strings.ReplaceAll(u, "\uFE0F", "")
+}
+
+// emojiNameAlias 将 Emoji 名称转换为别名，比如 flag: Japan 转换为 flag_japan。
+func emojiNameAlias(name string) string {
+	buf := strings.Builder{}
+	for _, r := range strings.ToLower(name) {
+		if ('a' <= r && 'z' >= r) || ('0' <= r && '9' >= r) {
+			buf.WriteRune(r)
+		} else if '#' == r || '*' == r {
+			buf.WriteRune(r)
+		} else if 0 < buf.Len() && !strings.HasSuffix(buf.String(), "_") {
+			buf.WriteByte('_')
+		}
+	}
+	return strings.TrimSuffix(buf.String(), "_")
+}
+
+// emojiKeywords 返回 Emoji 名称和子分类中的单词，去重后作为关键词。
+func emojiKeywords(emoji *Emoji) (ret []string) {
+	seen := map[string]bool{}
+	words := strings.FieldsFunc(strings.ToLower(emoji.Name+" "+emoji.Subcategory), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if !seen[word] && "other" != word {
+			seen[word] = true
+			ret = append(ret, word)
+		}
+	}
+	return
+}
+
+// GetEmoji 返回 Unicode 字符序列 u 对应的 Emoji，u 可以省略 U+FE0F 变体选择符，找不到时返回 nil。
+func GetEmoji(u string) *Emoji {
+	return emojiByKey[emojiKey(u)]
+}
+
+// WithSkinTone 返回使用肤色 tone（2 到 6，对应 :skin-tone-2: 到 :skin-tone-6:）修饰的 Emoji，不支持肤色修饰或者 tone 不合法时返回原字符。
+func (emoji *Emoji) WithSkinTone(tone int) string {
+	if !emoji.SkinTones || 2 > tone || 6 < tone {
+		return emoji.Unicode
+	}
+	return strings.ReplaceAll(emoji.light, EmojiSkinTones[0], EmojiSkinTones[tone-2])
+}
+
+// EmojiUnicode2Alias 将 text 中的 Emoji 替换为别名 :alias:，带肤色修饰的 Emoji 替换为 :alias::skin-tone-3:。
+//
+// emojiAlias 为 Unicode 到别名的映射，通常使用解析选项中的 EmojiAlias。默认以文本形式显示的字符（比如 ©）需要带有 U+FE0F 才会替换。
+func EmojiUnicode2Alias(text []byte, emojiAlias map[string]string) []byte {
+	EmojiLock.Lock()
+	defer EmojiLock.Unlock()
+
+	var ret []byte
+	runes := []rune(string(text))
+	last := 0 // 已经输出到的 rune 位置
+	for i := 0; i < len(runes); i++ {
+		if 0x80 > runes[i] && (i+1 >= len(runes) || (0xFE0F != runes[i+1] && 0x20E3 != runes[i+1])) {
+			// ASCII 字符只有键帽 #️⃣ 这样的组合才可能是 Emoji
+			continue
+		}
+
+		end := i + emojiMaxRunes
+		if end > len(runes) {
+			end = len(runes)
+		}
+		if zwj := emojiZWJSequenceEnd(runes, i); 0 < zwj && "" == emojiUnicodeAlias(string(runes[i:zwj]), emojiAlias) {
+			// 没有别名的 ZWJ 序列（比如混合肤色的 🧑🏻‍❤️‍💋‍🧑🏼）保持原样，避免拆分后改变显示
+			i = zwj - 1
+			continue
+		}
+		for j := end; j > i; j-- {
+			alias := emojiUnicodeAlias(string(runes[i:j]), emojiAlias)
+			if "" == alias {
+				continue
+			}
+			ret = append(ret, string(runes[last:i])...)
+			ret = append(ret, ":"+alias+":"...)
+			last = j
+			i = j - 1
+			break
+		}
+	}
+	if 0 == last {
+		return text
+	}
+	return append(ret, string(runes[last:])...)
+}
+
+// emojiZWJSequenceEnd 返回从 runes[i] 开始的 ZWJ 序列的结束位置，不是 ZWJ 序列时返回 0。
+func emojiZWJSequenceEnd(runes []rune, i int) (ret int) {
+	for j := i + 1; j < len(runes); j++ {
+		switch {
+		case 0x200D == runes[j] && j+1 < len(runes):
+			ret = j + 2
+			j++
+		case 0xFE0F == runes[j] || 0x20E3 == runes[j] || (0x1F3FB <= runes[j] && 0x1F3FF >= runes[j]):
+			if 0 < ret {
+				ret = j + 1
+			}
+		default:
+			return
+		}
+	}
+	return
+}
+
+// emojiMaxRunes 是 Emoji 字符序列的最大 rune 数，比如带肤色的 ZWJ 序列 🧑🏻‍❤️‍💋‍🧑🏼 有 10 个 rune。
+const emojiMaxRunes = 10
+
+// emojiUnicodeAlias 返回 Emoji 字符序列 u 的别名，带肤色修饰时返回 alias::skin-tone-3，不是 Emoji 时返回空字符串。
+func emojiUnicodeAlias(u string, emojiAlias map[string]string) string {
+	if alias, ok := emojiAlias[u]; ok {
+		return alias
+	}
+
+	tone := 0
+	for i, skinTone := range EmojiSkinTones {
+		if strings.Contains(u, skinTone) {
+			if 0 < tone {
+				// 混合肤色的变体没有对应的别名
+				return ""
+			}
+			tone = i + 2
+		}
+	}
+	if 0 < tone {
+		emoji := emojiByLight[strings.ReplaceAll(u, EmojiSkinTones[tone-2], EmojiSkinTones[0])]
+		if nil == emoji {
+			return ""
+		}
+		alias := emojiUnicodeAlias(emoji.Unicode, emojiAlias)
+		if "" == alias {
+			return ""
+		}
+		return alias + "::skin-tone-" + strconv.Itoa(tone)
+	}
+
+	emoji := GetEmoji(u)
+	if nil == emoji || (strings.Contains(emoji.Unicode, "\uFE0F") && !strings.Contains(u, "\uFE0F")) {
+		return ""
+	}
+	if alias, ok := emojiAlias[emoji.Unicode]; ok {
+		return alias
+	}
+	for _, alias := range emoji.Aliases {
+		// 别名表中的字符可能不是完全限定的，比如 ⚓️
+		if ret, ok := emojiAlias[EmojiAliasUnicode[alias]]; ok {
+			return ret
+		}
+	}
+	return ""
+}
+
+// emojiSkinToneAlias 解析肤色别名 skin-tone-3，返回肤色 2 到 6，不合法时返回 0。
+func emojiSkinToneAlias(alias string) int {
+	if !strings.HasPrefix(alias, "skin-tone-") {
+		return 0
+	}
+	tone, err := strconv.Atoi(alias[len("skin-tone-"):])
+	if nil != err || 2 > tone || 6 < tone {
+		return 0
+	}
+	return tone
+}
+
+// GetEmojisByCategory 返回分类 category 下的 Emoji，顺序和 Unicode 推荐的显示顺序一致。
+func GetEmojisByCategory(category string) (ret []*Emoji) {
+	for _, emoji := range Emojis {
+		if category == emoji.Category {
+			ret = append(ret, emoji)
+		}
+	}
+	return
+}
+
+// SearchEmojis 按别名和关键词搜索 Emoji，最多返回 limit 个结果，limit 小于 1 时不限制。
+//
+// 排序依次为：别名完全匹配、别名前缀匹配、关键词完全匹配、关键词前缀匹配、别名包含、名称包含，同一级别中按数据集顺序排列。
+// 多个单词的查询 thumbs up 要求每个单词都能匹配，排序使用最差的匹配级别。
+func SearchEmojis(query string, limit int) (ret []*Emoji) {
+	words := strings.Fields(strings.ToLower(strings.Trim(strings.TrimSpace(query), ":")))
+	if 1 > len(words) {
+		return
+	}
+
+	type scored struct {
+		emoji *Emoji
+		score int
+	}
+	var results []scored
+	for _, emoji := range Emojis {
+		score := 0
+		for _, word := range words {
+			s := emojiMatchScore(emoji, word)
+			if 0 > s {
+				score = -1
+				break
+			}
+			if s > score {
+				score = s
+			}
+		}
+		if 0 <= score {
+			results = append(results, scored{emoji, score})
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].score < results[j].score })
+
+	for _, result := range results {
+		if 0 < limit && len(ret) >= limit {
+			break
+		}
+		ret = append(ret, result.emoji)
+	}
+	return
+}
+
+// emojiMatchScore 返回 Emoji 和单词 word 的匹配级别，越小越优先，不匹配时返回 -1。
+func emojiMatchScore(emoji *Emoji, word string) (ret int) {
+	ret = -1
+	better := func(score int) {
+		if 0 > ret || score < ret {
+			ret = score
+		}
+	}
+	for _, alias := range emoji.Aliases {
+		alias = strings.ToLower(alias)
+		switch {
+		case word == alias:
+			better(0)
+		case strings.HasPrefix(alias, word):
+			better(1)
+		case strings.Contains(alias, word):
+			better(4)
+		}
+	}
+	for _, keyword := range emoji.Keywords {
+		switch {
+		case word == keyword:
+			better(2)
+		case strings.HasPrefix(keyword, word):
+			better(3)
+		}
+	}
+	if 0 > ret && strings.Contains(strings.ToLower(emoji.Name), word) {
+		ret = 5
+	}
+	return
+}
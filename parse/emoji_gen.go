@@ -0,0 +1,153 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+//go:build ignore
+
+// 使用 Unicode 发布的 emoji-test.txt 生成 emoji_data.go：
+//
+//	go run emoji_gen.go path/to/emoji-test.txt
+//
+// emoji-test.txt 下载地址 https://unicode.org/Public/emoji/latest/emoji-test.txt
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type datum struct {
+	unicode     string
+	name        string
+	category    int
+	subcategory int
+	light       string
+}
+
+// categories 和 parse.EmojiCategories 一一对应，Component 分组（肤色和发型组件）不生成。
+var categories = map[string]int{
+	"Smileys & Emotion": 0,
+	"People & Body":     1,
+	"Animals & Nature":  2,
+	"Food & Drink":      3,
+	"Travel & Places":   4,
+	"Activities":        5,
+	"Objects":           6,
+	"Symbols":           7,
+	"Flags":             8,
+}
+
+func main() {
+	if 2 > len(os.Args) {
+		fmt.Fprintln(os.Stderr, "usage: go run emoji_gen.go path/to/emoji-test.txt")
+		os.Exit(1)
+	}
+	file, err := os.Open(os.Args[1])
+	if nil != err {
+		panic(err)
+	}
+	defer file.Close()
+
+	var data []*datum
+	var subcategories []string
+	var version string
+	bases := map[string]*datum{}
+	category := -1
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "# Version: ") {
+			version = strings.TrimPrefix(line, "# Version: ")
+			continue
+		}
+		if strings.HasPrefix(line, "# group: ") {
+			c, ok := categories[strings.TrimPrefix(line, "# group: ")]
+			if !ok {
+				c = -1
+			}
+			category = c
+			continue
+		}
+		if strings.HasPrefix(line, "# subgroup: ") {
+			subcategories = append(subcategories, strings.TrimPrefix(line, "# subgroup: "))
+			continue
+		}
+		if 0 > category || "" == line || strings.HasPrefix(line, "#") || !strings.Contains(line, "; fully-qualified") {
+			continue
+		}
+
+		fields := strings.SplitN(line, ";", 2)
+		var runes, key []rune
+		tones := map[rune]bool{}
+		for _, cp := range strings.Fields(fields[0]) {
+			r, e := strconv.ParseUint(cp, 16, 32)
+			if nil != e {
+				panic(e)
+			}
+			runes = append(runes, rune(r))
+			if 0x1F3FB <= r && 0x1F3FF >= r {
+				tones[rune(r)] = true
+				continue
+			}
+			if 0xFE0F != r {
+				key = append(key, rune(r))
+			}
+		}
+		name := fields[1][strings.Index(fields[1], "#")+1:]
+		name = strings.TrimSpace(name)
+		name = name[strings.Index(name, " E")+1:] // 去掉 Emoji 字符
+		name = name[strings.Index(name, " ")+1:]  // 去掉版本 E0.6
+
+		if 0 < len(tones) {
+			// 只记录统一使用浅肤色的变体，其他肤色运行时替换得到
+			if base := bases[string(key)]; nil != base && 1 == len(tones) && tones[0x1F3FB] {
+				base.light = string(runes)
+			}
+			continue
+		}
+
+		d := &datum{unicode: string(runes), name: name, category: category, subcategory: len(subcategories) - 1}
+		data = append(data, d)
+		bases[string(key)] = d
+	}
+	if err = scanner.Err(); nil != err {
+		panic(err)
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteString("// Code generated by go run emoji_gen.go emoji-test.txt; DO NOT EDIT.\n\n")
+	buf.WriteString("package parse\n\n")
+	buf.WriteString("// EmojiUnicodeVersion 是 Emoji 数据集对应的 Unicode Emoji 版本。\n")
+	fmt.Fprintf(buf, "const EmojiUnicodeVersion = %q\n\n", version)
+	buf.WriteString("// emojiSubcategories 定义了 Emoji 子分类，和 emoji-test.txt 中的 subgroup 一致。\n")
+	buf.WriteString("var emojiSubcategories = []string{\n")
+	for _, subcategory := range subcategories {
+		fmt.Fprintf(buf, "%q,\n", subcategory)
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("// emojiData 按 emoji-test.txt 中的顺序定义了所有完全限定的 Emoji：字符、名称、分类、子分类和浅肤色变体。\n")
+	buf.WriteString("var emojiData = []emojiDatum{\n")
+	for _, d := range data {
+		fmt.Fprintf(buf, "{%q, %q, %d, %d, %q},\n", d.unicode, d.name, d.category, d.subcategory, d.light)
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if nil != err {
+		panic(err)
+	}
+	if err = os.WriteFile("emoji_data.go", src, 0644); nil != err {
+		panic(err)
+	}
+}
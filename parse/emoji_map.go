@@ -10,11 +10,18 @@
 
 package parse
 
+// EmojiUnicodeAlias 存储 Emoji Unicode 到别名的映射，一个 Emoji 有多个别名时使用最短的别名。
 var EmojiUnicodeAlias map[string]string
 
 func init() {
+	initEmojis()
+
 	EmojiUnicodeAlias = make(map[string]string, len(EmojiAliasUnicode))
 	for k, v := range EmojiAliasUnicode {
+		if alias, ok := EmojiUnicodeAlias[v]; ok && emojiAliasLess(alias, k) {
+			// 多个别名时固定使用最短的别名
+			continue
+		}
 		EmojiUnicodeAlias[v] = k
 	}
 }
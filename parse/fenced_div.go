@@ -0,0 +1,168 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package parse
+
+import (
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/html"
+	"github.com/Dofingert/lute-for-ficus/lex"
+)
+
+// FencedDivStart 判断围栏 div（::: {.warning #id}）是否开始。
+//
+// 开始标记符至少需要 3 个 :，后面必须跟 Pandoc 通用属性或者一个作为 class 的单词，属性后面可以再跟任意个 :。
+// 属性解析后保存在 KramdownIAL 中。围栏 div 不能打断段落。
+func FencedDivStart(t *Tree, container *ast.Node) int {
+	if !t.Context.ParseOption.FencedDiv || t.Context.indented || ast.NodeParagraph == container.Type {
+		return 0
+	}
+
+	if fenceLen, ial := t.parseFencedDiv(); nil != ial {
+		t.Context.closeUnmatchedBlocks()
+		div := t.Context.addChild(ast.NodeFencedDiv)
+		div.FencedDivFenceLen = fenceLen
+		div.KramdownIAL = ial
+		t.Context.offset = t.Context.currentLineLen - 1 // 整行过
+		return 1
+	}
+	return 0
+}
+
+// FencedDivContinue 判断围栏 div 是否可以继续。闭合标记符（至少 3 个 :）会闭合这一行能够到达的最内层开始标记符长度不小于它的围栏 div，
+// 没有的话闭合能够到达的最内层围栏 div。
+func FencedDivContinue(div *ast.Node, context *Context) int {
+	if context.indented || (ast.NodeParagraph != context.Tip.Type && context.Tip.AcceptLines()) {
+		// 围栏代码块等叶子块中的 ::: 是内容
+		return 0
+	}
+
+	fenceLen := context.fencedDivCloseLen(context.currentLine[context.nextNonspace:])
+	if 0 == fenceLen {
+		return 0
+	}
+
+	if div != context.fencedDivCloseTarget(div, fenceLen) {
+		return 0
+	}
+
+	for context.Tip != div {
+		context.finalizePrevious(context.Tip)
+	}
+	context.finalize(div)
+	return 2
+}
+
+// fencedDivCloseTarget 返回长度为 fenceLen 的闭合标记符在 div 及其内部能够闭合的围栏 div。
+//
+// 内部的围栏 div 只有在中间的列表项缩进满足时才能被这一行到达，块引用等其他容器需要自己的标记符，所以会阻断查找。
+func (context *Context) fencedDivCloseTarget(div *ast.Node, fenceLen int) (ret *ast.Node) {
+	var chain []*ast.Node
+	for p := context.Tip; nil != p && div != p; p = p.Parent {
+		chain = append([]*ast.Node{p}, chain...)
+	}
+
+	reachable, indent := []*ast.Node{div}, context.indent
+	for _, n := range chain {
+		if ast.NodeFencedDiv == n.Type && !n.Close {
+			reachable = append(reachable, n)
+		} else if ast.NodeList == n.Type {
+			continue
+		} else if ast.NodeListItem == n.Type && indent >= n.ListData.MarkerOffset+n.ListData.Padding {
+			indent -= n.ListData.MarkerOffset + n.ListData.Padding
+		} else {
+			break
+		}
+	}
+
+	for i := len(reachable) - 1; 0 <= i; i-- {
+		if fenceLen <= reachable[i].FencedDivFenceLen {
+			return reachable[i]
+		}
+	}
+	return reachable[len(reachable)-1]
+}
+
+func (t *Tree) parseFencedDiv() (fenceLen int, ial [][]string) {
+	ln := t.Context.currentLine
+	i := t.Context.nextNonspace
+	for ; i < t.Context.currentLineLen && lex.ItemColon == ln[i]; i++ {
+		fenceLen++
+	}
+	if 3 > fenceLen {
+		return
+	}
+
+	for ; i < t.Context.currentLineLen && (lex.ItemSpace == ln[i] || lex.ItemTab == ln[i]); i++ {
+	}
+	tokens := lex.TrimWhitespace(ln[i:])
+	if 1 > len(tokens) {
+		// 没有属性的话是闭合标记符
+		return
+	}
+
+	var end int
+	if ial, end = parseFencedDivAttributes(tokens); nil == ial {
+		return
+	}
+
+	remains := lex.TrimWhitespace(tokens[end:])
+	for _, token := range remains {
+		if lex.ItemColon != token {
+			return fenceLen, nil
+		}
+	}
+	return
+}
+
+// FencedDivAttributes 解析围栏 div 的属性 warning 或者 {#id .class key=val}，不合法时返回 nil。
+func FencedDivAttributes(attrs string) [][]string {
+	tokens := lex.TrimWhitespace([]byte(attrs))
+	if ial, end := parseFencedDivAttributes(tokens); end == len(tokens) {
+		return ial
+	}
+	return nil
+}
+
+// parseFencedDivAttributes 解析 tokens 开头的围栏 div 属性，返回属性列表和属性结束的位置，不合法时返回的属性列表为 nil。
+func parseFencedDivAttributes(tokens []byte) (ial [][]string, end int) {
+	if 1 > len(tokens) {
+		return
+	}
+
+	if lex.ItemOpenBrace == tokens[0] {
+		if end, ial = parsePandocAttributes(tokens); nil == ial {
+			return nil, 0
+		}
+		return ial, end + 1
+	}
+
+	for ; end < len(tokens) && isCrossRefNameToken(tokens[end]); end++ {
+	}
+	if 0 == end {
+		return
+	}
+	return [][]string{{"class", html.EscapeAttrVal(string(tokens[:end]))}}, end
+}
+
+// fencedDivCloseLen 判断 tokens 是否是围栏 div 闭合标记符，是的话返回标记符长度，否则返回 0。
+func (context *Context) fencedDivCloseLen(tokens []byte) (ret int) {
+	tokens = lex.TrimWhitespace(tokens)
+	for _, token := range tokens {
+		if lex.ItemColon != token {
+			return 0
+		}
+		ret++
+	}
+	if 3 > ret {
+		return 0
+	}
+	return
+}
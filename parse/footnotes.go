@@ -12,6 +12,8 @@ package parse
 
 import (
 	"bytes"
+	"strconv"
+	"strings"
 
 	"github.com/Dofingert/lute-for-ficus/ast"
 	"github.com/Dofingert/lute-for-ficus/editor"
@@ -97,3 +99,310 @@ func (t *Tree) FindFootnotesDef(label []byte) (pos int, def *ast.Node) {
 	})
 	return
 }
+
+// parseInlineFootnote 解析行级脚注 ^[text]，不匹配时返回 nil 并且不移动解析位置。
+//
+// 行级脚注会生成一个匿名脚注定义，放在 block 所在顶层块之后的脚注定义块中，这样脚注的编号和全文中的出现顺序一致。
+func (t *Tree) parseInlineFootnote(block *ast.Node, ctx *InlineContext) *ast.Node {
+	if !t.Context.ParseOption.Footnotes || !t.Context.ParseOption.InlineFootnotes {
+		return nil
+	}
+
+	tokens := ctx.tokens[ctx.pos:]
+	if 4 > len(tokens) || lex.ItemOpenBracket != tokens[1] {
+		return nil
+	}
+	end, depth := -1, 0
+	for i := 2; i < len(tokens) && 0 > end; i++ {
+		switch tokens[i] {
+		case lex.ItemBackslash:
+			i++
+		case lex.ItemBacktick:
+			// 和行级解析一样跳过代码，其中的方括号不参与匹配
+			i = codeSpanEnd(tokens, i)
+		case lex.ItemOpenBracket:
+			depth++
+		case lex.ItemCloseBracket:
+			if 0 == depth {
+				end = i
+			}
+			depth--
+		}
+	}
+	if 0 > end || lex.IsBlank(tokens[2:end]) {
+		return nil
+	}
+
+	top := block
+	for nil != top.Parent && ast.NodeDocument != top.Parent.Type {
+		top = top.Parent
+	}
+	if nil == top.Parent {
+		return nil
+	}
+
+	def := t.newInlineFootnotesDef(top)
+	content := lex.TrimWhitespace(tokens[2:end])
+	paragraph := &ast.Node{Type: ast.NodeParagraph, Tokens: content}
+	def.AppendChild(paragraph)
+	if sm := t.Context.sourceMaps[block]; nil != sm {
+		// 匿名脚注定义的内容是所在块 Tokens 的子切片，可以共用位置映射
+		t.Context.sourceMaps[paragraph] = sm
+		if i := sm.index(content); 0 <= i {
+			paragraph.SourcePos = sm.newSourcePos(i, i+len(content))
+		}
+	}
+
+	ret := &ast.Node{Type: ast.NodeFootnotesRef, Tokens: def.Tokens, FootnotesRefLabel: def.Tokens, FootnotesInline: true}
+	idx, _ := t.FindFootnotesDef(def.Tokens)
+	ret.FootnotesRefId = strconv.Itoa(idx)
+	def.FootnotesRefs = append(def.FootnotesRefs, ret)
+	ctx.pos += end + 1
+	return ret
+}
+
+// codeSpanEnd 返回从 tokens[start] 开始的反引号串对应的代码结束位置（闭合反引号串的最后一个字符），没有闭合时代码不成立，返回开始反引号串的最后一个字符。
+func codeSpanEnd(tokens []byte, start int) int {
+	n := 0
+	for ; start+n < len(tokens) && lex.ItemBacktick == tokens[start+n]; n++ {
+	}
+	for i := start + n; i < len(tokens); {
+		if lex.ItemBacktick != tokens[i] {
+			i++
+			continue
+		}
+		m := 0
+		for ; i+m < len(tokens) && lex.ItemBacktick == tokens[i+m]; m++ {
+		}
+		if m == n {
+			return i + m - 1
+		}
+		i += m
+	}
+	return start + n - 1
+}
+
+// newInlineFootnotesDef 创建一个匿名脚注定义，并将其添加到顶层块 top 之后的脚注定义块中。
+func (t *Tree) newInlineFootnotesDef(top *ast.Node) (ret *ast.Node) {
+	ret = &ast.Node{Type: ast.NodeFootnotesDef, Tokens: t.newInlineFootnotesLabel(), FootnotesInline: true}
+	inlineFootnotesDefBlock(top).AppendChild(ret)
+	return
+}
+
+// newInlineFootnotesLabel 生成一个匿名脚注定义的 label，其中包含空格，不会和脚注定义 [^label]: 冲突。
+func (t *Tree) newInlineFootnotesLabel() []byte {
+	t.Context.inlineFootnotes++
+	return []byte("^ " + strconv.Itoa(t.Context.inlineFootnotes))
+}
+
+// inlineFootnotesDefBlock 返回顶层块 top 之后存放匿名脚注定义的脚注定义块，不存在时创建一个。
+func inlineFootnotesDefBlock(top *ast.Node) (ret *ast.Node) {
+	if ret = top.Next; nil != ret && isInlineFootnotesDefBlock(ret) {
+		return
+	}
+	ret = &ast.Node{Type: ast.NodeFootnotesDefBlock}
+	top.InsertAfter(ret)
+	return
+}
+
+// isInlineFootnotesDefBlock 判断 n 是否是存放行级脚注匿名定义的脚注定义块。
+func isInlineFootnotesDefBlock(n *ast.Node) bool {
+	return ast.NodeFootnotesDefBlock == n.Type && nil != n.FirstChild && n.FirstChild.FootnotesInline
+}
+
+// InlineFootnotesToRefs 将所有行级脚注 ^[text] 转换为引用脚注 [^label]，label 使用尚未被占用的最小数字，
+// 脚注定义移动到文档末尾的脚注定义块中。
+func (t *Tree) InlineFootnotesToRefs() {
+	labels := map[string]bool{}
+	var refs []*ast.Node
+	ast.Walk(t.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if !entering {
+			return ast.WalkContinue
+		}
+		if ast.NodeFootnotesDef == n.Type && !n.FootnotesInline {
+			labels[strings.ToLower(string(n.Tokens))] = true
+		} else if ast.NodeFootnotesRef == n.Type && n.FootnotesInline {
+			refs = append(refs, n)
+		}
+		return ast.WalkContinue
+	})
+
+	num := 0
+	for _, ref := range refs {
+		_, def := t.FindFootnotesDef(ref.Tokens)
+		if nil == def {
+			continue
+		}
+
+		num++
+		label := "^" + strconv.Itoa(num)
+		for labels[label] {
+			num++
+			label = "^" + strconv.Itoa(num)
+		}
+		labels[label] = true
+		ref.Tokens, ref.FootnotesRefLabel, ref.FootnotesInline = []byte(label), []byte(label), false
+		def.Tokens, def.FootnotesInline = []byte(label), false
+		moveFootnotesDef(def, t.trailingFootnotesDefBlock())
+	}
+	t.refreshFootnotesRefs()
+}
+
+// RefFootnotesToInline 将只被引用了一次并且内容只有一个段落的引用脚注 [^label] 转换为行级脚注 ^[text]。
+func (t *Tree) RefFootnotesToInline() {
+	var defs []*ast.Node
+	ast.Walk(t.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if entering && ast.NodeFootnotesDef == n.Type && !n.FootnotesInline {
+			defs = append(defs, n)
+		}
+		return ast.WalkContinue
+	})
+
+	for _, def := range defs {
+		if 1 != len(def.FootnotesRefs) || nil == def.FirstChild || def.FirstChild != def.LastChild || ast.NodeParagraph != def.FirstChild.Type {
+			continue
+		}
+		ref := def.FootnotesRefs[0]
+		top := ref
+		for nil != top.Parent && ast.NodeDocument != top.Parent.Type {
+			if top.Parent == def {
+				// 引用位于自身的定义中
+				top = nil
+				break
+			}
+			top = top.Parent
+		}
+		if nil == top || nil == top.Parent {
+			continue
+		}
+
+		label := t.newInlineFootnotesLabel()
+		ref.Tokens, ref.FootnotesRefLabel, ref.FootnotesInline = label, label, true
+		def.Tokens, def.FootnotesInline = label, true
+		moveFootnotesDef(def, inlineFootnotesDefBlock(top))
+	}
+	t.refreshFootnotesRefs()
+}
+
+// RenumberFootnotes 按照脚注在全文中第一次被引用的顺序将引用脚注的 label 重新编号为 ^1、^2 等，并将它们的定义按照编号顺序
+// 移动到文档末尾的脚注定义块中，没有被引用的脚注定义排在最后。行级脚注没有 label，不参与编号。
+func (t *Tree) RenumberFootnotes() {
+	var defs []*ast.Node
+	seen := map[*ast.Node]bool{}
+	ast.Walk(t.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if !entering || ast.NodeFootnotesRef != n.Type || n.FootnotesInline {
+			return ast.WalkContinue
+		}
+		if _, def := t.FindFootnotesDef(n.Tokens); nil != def && !seen[def] {
+			seen[def] = true
+			defs = append(defs, def)
+		}
+		return ast.WalkContinue
+	})
+	ast.Walk(t.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if entering && ast.NodeFootnotesDef == n.Type && !n.FootnotesInline && !seen[n] {
+			seen[n] = true
+			defs = append(defs, n)
+		}
+		return ast.WalkContinue
+	})
+	if 1 > len(defs) {
+		return
+	}
+
+	// 先收集引用再修改 label，避免新旧 label 冲突
+	refs := map[*ast.Node][]*ast.Node{}
+	for _, def := range defs {
+		refs[def] = t.footnotesRefs(def)
+	}
+	defBlock := &ast.Node{Type: ast.NodeFootnotesDefBlock}
+	for i, def := range defs {
+		label := []byte("^" + strconv.Itoa(i+1))
+		for _, ref := range refs[def] {
+			ref.Tokens, ref.FootnotesRefLabel = label, label
+		}
+		def.Tokens = label
+		moveFootnotesDef(def, defBlock)
+	}
+	t.Root.AppendChild(defBlock)
+	t.refreshFootnotesRefs()
+}
+
+// footnotesRefs 返回全文中引用了脚注定义 def 的所有引用脚注。
+func (t *Tree) footnotesRefs(def *ast.Node) (ret []*ast.Node) {
+	ast.Walk(t.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if entering && ast.NodeFootnotesRef == n.Type && !n.FootnotesInline {
+			if _, d := t.FindFootnotesDef(n.Tokens); d == def {
+				ret = append(ret, n)
+			}
+		}
+		return ast.WalkContinue
+	})
+	return
+}
+
+// trailingFootnotesDefBlock 返回文档末尾用于存放引用脚注定义的脚注定义块，不存在时创建一个。
+func (t *Tree) trailingFootnotesDefBlock() (ret *ast.Node) {
+	if ret = t.Root.LastChild; nil != ret && ast.NodeFootnotesDefBlock == ret.Type && !isInlineFootnotesDefBlock(ret) {
+		return
+	}
+	ret = &ast.Node{Type: ast.NodeFootnotesDefBlock}
+	t.Root.AppendChild(ret)
+	return
+}
+
+// moveFootnotesDef 将脚注定义 def 移动到脚注定义块 defBlock 的末尾，移动后为空的原脚注定义块会被删除。
+func moveFootnotesDef(def, defBlock *ast.Node) {
+	parent := def.Parent
+	def.Unlink()
+	defBlock.AppendChild(def)
+	if nil != parent && ast.NodeFootnotesDefBlock == parent.Type && nil == parent.FirstChild {
+		parent.Unlink()
+	}
+}
+
+// refreshFootnotesRefIds 重新计算 node 中引用脚注的 id。行级脚注的匿名定义是在行级解析过程中插入的，可能排在之前已经解析的引用所指向的定义前面，
+// 所以需要在行级解析结束后根据定义的最终位置重新计算。
+func (t *Tree) refreshFootnotesRefIds(node *ast.Node) {
+	ast.Walk(node, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if !entering || ast.NodeFootnotesRef != n.Type {
+			return ast.WalkContinue
+		}
+		idx, def := t.FindFootnotesDef(n.Tokens)
+		if nil == def {
+			return ast.WalkContinue
+		}
+		n.FootnotesRefId = strconv.Itoa(idx)
+		for i, ref := range def.FootnotesRefs {
+			if ref == n && 0 < i {
+				n.FootnotesRefId += ":" + strconv.Itoa(i+1)
+			}
+		}
+		return ast.WalkContinue
+	})
+}
+
+// refreshFootnotesRefs 按照全文顺序重新计算脚注定义的引用列表以及引用脚注的 id。
+func (t *Tree) refreshFootnotesRefs() {
+	ast.Walk(t.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if entering && ast.NodeFootnotesDef == n.Type {
+			n.FootnotesRefs = nil
+		}
+		return ast.WalkContinue
+	})
+	ast.Walk(t.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if !entering || ast.NodeFootnotesRef != n.Type {
+			return ast.WalkContinue
+		}
+		idx, def := t.FindFootnotesDef(n.Tokens)
+		if nil == def {
+			return ast.WalkContinue
+		}
+		n.FootnotesRefId = strconv.Itoa(idx)
+		if refsLen := len(def.FootnotesRefs); 0 < refsLen {
+			n.FootnotesRefId += ":" + strconv.Itoa(refsLen+1)
+		}
+		def.FootnotesRefs = append(def.FootnotesRefs, n)
+		return ast.WalkContinue
+	})
+}
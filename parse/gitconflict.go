@@ -52,7 +52,11 @@ func (context *Context) gitConflictFinalize(gitConflictBlock *ast.Node) {
 	openMarkerTokens := contentParts[0]
 	content := bytes.Join(contentParts[1:], []byte("\n"))
 	content = bytes.TrimSpace(content)
-	closeMarkerTokens := bytes.TrimSpace(context.currentLine)
+	var closeMarkerTokens []byte
+	if context.isGitConflictClose() {
+		// 未闭合的冲突标记在文档结束时结束，此时的当前行是已经计入内容的最后一行
+		closeMarkerTokens = bytes.TrimSpace(context.currentLine)
+	}
 	gitConflictBlock.Tokens = nil
 	gitConflictBlock.AppendChild(&ast.Node{Type: ast.NodeGitConflictOpenMarker, Tokens: openMarkerTokens})
 	gitConflictBlock.AppendChild(&ast.Node{Type: ast.NodeGitConflictContent, Tokens: content})
@@ -26,6 +26,9 @@ func ATXHeadingStart(t *Tree, container *ast.Node) int {
 	}
 
 	if ok, markers, content, level := t.parseATXHeading(); ok {
+		contentStart := t.Context.nextNonspace + level
+		for ; contentStart < t.Context.currentLineLen && (lex.ItemSpace == t.Context.currentLine[contentStart] || lex.ItemTab == t.Context.currentLine[contentStart]); contentStart++ {
+		}
 		t.Context.advanceNextNonspace()
 		t.Context.advanceOffset(len(content), false)
 		t.Context.closeUnmatchedBlocks()
@@ -34,6 +37,9 @@ func ATXHeadingStart(t *Tree, container *ast.Node) int {
 		heading.Tokens = content
 		crosshatchMarker := &ast.Node{Type: ast.NodeHeadingC8hMarker, Tokens: markers}
 		heading.AppendChild(crosshatchMarker)
+		if t.Context.ParseOption.SourcePos {
+			t.Context.setSourceMap(heading, contentStart+1)
+		}
 		t.Context.advanceOffset(t.Context.currentLineLen-t.Context.offset, false)
 		return 2
 	}
@@ -65,11 +71,16 @@ func SetextHeadingStart(t *Tree, container *ast.Node) int {
 			// 将该段落节点转成表节点
 			container.Type = ast.NodeTable
 			container.TableAligns = table.TableAligns
+			container.TableCaption = table.TableCaption
+			container.CrossRefLabel = table.CrossRefLabel
 			for tr := table.FirstChild; nil != tr; {
 				nextTr := tr.Next
 				container.AppendChild(tr)
 				tr = nextTr
 			}
+			if t.Context.ParseOption.SourcePos {
+				t.Context.paragraphSourcePos(container, false)
+			}
 			container.Tokens = nil
 			return 0
 		}
@@ -90,6 +101,14 @@ func SetextHeadingStart(t *Tree, container *ast.Node) int {
 		child.Tokens = lex.TrimWhitespace(container.Tokens)
 		container.InsertAfter(child)
 		container.Unlink()
+		if t.Context.ParseOption.SourcePos {
+			child.SourcePos = container.SourcePos
+			t.Context.sourcePosEnd(child, false)
+			if sm := t.Context.sourceMaps[container]; nil != sm {
+				t.Context.sourceMaps[child] = sm
+				delete(t.Context.sourceMaps, container)
+			}
+		}
 		t.Context.Tip = child
 		t.Context.advanceOffset(t.Context.currentLineLen-t.Context.offset, false)
 		return 2
@@ -24,57 +24,95 @@ import (
 // parseInline 解析并生成块节点 block 的行级子节点。
 func (t *Tree) parseInline(block *ast.Node, ctx *InlineContext) {
 	for ctx.pos < ctx.tokensLen {
+		start := ctx.pos
 		token := ctx.tokens[ctx.pos]
 		var n *ast.Node
-		switch token {
-		case lex.ItemBackslash:
-			n = t.parseBackslash(block, ctx)
-		case lex.ItemBacktick:
-			n = t.parseCodeSpan(block, ctx)
-		case lex.ItemAsterisk, lex.ItemUnderscore, lex.ItemTilde, lex.ItemEqual, lex.ItemCrosshatch:
-			t.handleDelim(block, ctx)
-		case lex.ItemCaret:
-			if t.Context.ParseOption.Sup {
+		var handled bool // 是否已经由扩展语法处理
+		if 0 < len(t.Context.ParseOption.InlineSyntaxes) || 0 < len(t.Context.ParseOption.DelimiterSyntaxes) {
+			n, handled = t.parseInlineSyntax(block, ctx)
+		}
+		if !handled {
+			switch token {
+			case lex.ItemBackslash:
+				n = t.parseBackslash(block, ctx)
+			case lex.ItemBacktick:
+				n = t.parseCodeSpan(block, ctx)
+			case lex.ItemAsterisk, lex.ItemUnderscore, lex.ItemTilde, lex.ItemEqual, lex.ItemCrosshatch:
 				t.handleDelim(block, ctx)
-			} else {
-				n = t.parseText(ctx)
-			}
-		case lex.ItemHyphen:
-			n = t.parseHyphen(ctx)
-		case lex.ItemNewline:
-			n = t.parseNewline(block, ctx)
-		case lex.ItemLess:
-			if n = t.parseAutolink(ctx); nil == n {
-				if n = t.parseAutoEmailLink(ctx); nil == n {
-					if n = t.parseFileAnnotationRef(ctx); nil == n {
-						n = t.parseInlineHTML(ctx)
-						if t.Context.ParseOption.ProtyleWYSIWYG && nil != n && ast.NodeInlineHTML == n.Type {
-							// Protyle 中不存在内联 HTML，使用文本
-							n.Type = ast.NodeText
+			case lex.ItemCaret:
+				if n = t.parseInlineFootnote(block, ctx); nil != n {
+					break
+				}
+				if t.Context.ParseOption.Sup {
+					t.handleDelim(block, ctx)
+				} else if t.isCaretMarker() {
+					// 不是行级脚注时作为文本
+					ctx.pos++
+					n = &ast.Node{Type: ast.NodeText, Tokens: ctx.tokens[ctx.pos-1 : ctx.pos]}
+				} else {
+					n = t.parseText(ctx)
+				}
+			case lex.ItemHyphen:
+				n = t.parseHyphen(ctx)
+			case lex.ItemNewline:
+				n = t.parseNewline(block, ctx)
+			case lex.ItemLess:
+				if n = t.parseAutolink(ctx); nil == n {
+					if n = t.parseAutoEmailLink(ctx); nil == n {
+						if n = t.parseFileAnnotationRef(ctx); nil == n {
+							n = t.parseInlineHTML(ctx)
+							if t.Context.ParseOption.ProtyleWYSIWYG && nil != n && ast.NodeInlineHTML == n.Type {
+								// Protyle 中不存在内联 HTML，使用文本
+								n.Type = ast.NodeText
+							}
 						}
 					}
 				}
+			case lex.ItemOpenBracket:
+				if n = t.parseWikiLink(ctx); nil == n {
+					if n = t.parseBracketedCitation(ctx); nil == n {
+						n = t.parseOpenBracket(ctx)
+					}
+				}
+			case lex.ItemCloseBracket:
+				n = t.parseCloseBracket(ctx)
+			case lex.ItemAmpersand:
+				n = t.parseEntity(ctx)
+			case lex.ItemBang:
+				n = t.parseBang(ctx)
+			case lex.ItemDollar:
+				n = t.parseInlineMath(ctx)
+			case lex.ItemOpenBrace:
+				if n = t.parseImageCrossRefLabel(block, ctx); nil == n {
+					if n = t.parseHeadingPandocAttributes(block, ctx); nil == n {
+						n = t.parseHeadingID(block, ctx)
+					}
+				}
+			case lex.ItemAt:
+				if !t.Context.ParseOption.CrossRef && !t.Context.ParseOption.Citation {
+					n = t.parseText(ctx)
+				} else if n = t.parseCrossRef(ctx); nil == n {
+					if n = t.parseInTextCitation(ctx); nil == n {
+						// 不是交叉引用和文献引用时作为文本
+						ctx.pos++
+						n = &ast.Node{Type: ast.NodeText, Tokens: ctx.tokens[ctx.pos-1 : ctx.pos]}
+					}
+				}
+			case lex.ItemOpenParen:
+				n = t.parseBlockRef(ctx)
+			default:
+				n = t.parseText(ctx)
 			}
-		case lex.ItemOpenBracket:
-			n = t.parseOpenBracket(ctx)
-		case lex.ItemCloseBracket:
-			n = t.parseCloseBracket(ctx)
-		case lex.ItemAmpersand:
-			n = t.parseEntity(ctx)
-		case lex.ItemBang:
-			n = t.parseBang(ctx)
-		case lex.ItemDollar:
-			n = t.parseInlineMath(ctx)
-		case lex.ItemOpenBrace:
-			n = t.parseHeadingID(block, ctx)
-		case lex.ItemOpenParen:
-			n = t.parseBlockRef(ctx)
-		default:
-			n = t.parseText(ctx)
 		}
 
 		if nil != n {
 			block.AppendChild(n)
+			if t.Context.ParseOption.SourcePos {
+				if nil == ctx.spans {
+					ctx.spans = map[*ast.Node][2]int{}
+				}
+				ctx.spans[n] = [2]int{start, ctx.pos}
+			}
 		}
 	}
 	block.Tokens = nil
@@ -271,7 +309,7 @@ func (t *Tree) parseCloseBracket(ctx *InlineContext) *ast.Node {
 				if idx, footnotesDef := t.FindFootnotesDef(reflabel); nil != footnotesDef {
 					t.removeBracket(ctx)
 
-					if t.Context.ParseOption.Sup && nil != opener.node.Next.Next {
+					if t.isCaretMarker() && nil != opener.node.Next.Next {
 						opener.node.Next.Next.Unlink() // label
 						opener.node.Next.Unlink()      // ^
 					} else {
@@ -286,6 +324,9 @@ func (t *Tree) parseCloseBracket(ctx *InlineContext) *ast.Node {
 					}
 					ref := &ast.Node{Type: ast.NodeFootnotesRef, Tokens: reflabel, FootnotesRefId: refId, FootnotesRefLabel: bytes.ReplaceAll(reflabel, editor.CaretTokens, nil)}
 					footnotesDef.FootnotesRefs = append(footnotesDef.FootnotesRefs, ref)
+					if nil != t.Context.stream {
+						t.Context.stream.footnotesRefs = append(t.Context.stream.footnotesRefs, footnotesDef)
+					}
 					return ref
 				}
 			}
@@ -300,6 +341,10 @@ func (t *Tree) parseCloseBracket(ctx *InlineContext) *ast.Node {
 				matched = true
 				linkType = 3
 			}
+			if !matched && nil != t.Context.stream {
+				// 定义可能出现在后面，由流式解析器延迟解析该块
+				t.Context.stream.unresolved = append(t.Context.stream.unresolved, reflabel)
+			}
 		}
 	}
 
@@ -357,8 +402,24 @@ func (t *Tree) parseCloseBracket(ctx *InlineContext) *ast.Node {
 
 		return node
 	} else { // 没有匹配到
-		t.removeBracket(ctx)
 		ctx.pos = startPos
+		if !isImage && !isMDLink && t.isBracketedSpan(ctx) {
+			// [text]{.class}，后面的属性由 parsePandocSpanAttributes 设置
+			span := &ast.Node{Type: ast.NodeBracketedSpan}
+			var tmp, next *ast.Node
+			tmp = opener.node.Next
+			for nil != tmp {
+				next = tmp.Next
+				tmp.Unlink()
+				span.AppendChild(tmp)
+				tmp = next
+			}
+			t.processEmphasis(opener.previousDelimiter, ctx)
+			t.removeBracket(ctx)
+			opener.node.Unlink()
+			return span
+		}
+		t.removeBracket(ctx)
 		return &ast.Node{Type: ast.NodeText, Tokens: closeBracket}
 	}
 }
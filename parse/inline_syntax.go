@@ -0,0 +1,150 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package parse
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/lex"
+)
+
+// InlineSyntax 描述了一种由触发字节开始的扩展行级语法，比如 @提及。
+//
+// 行级解析遇到触发字节时会按照注册顺序调用 Parse，此时 ctx.Pos() 指向触发字节。匹配时需要通过 ctx.SetPos 跳过匹配的内容并返回
+// 生成的节点；不匹配时返回 nil 并且不移动位置，此时继续尝试后注册的语法和内置语法。
+type InlineSyntax struct {
+	Trigger byte                                                         // 触发字节
+	Parse   func(t *Tree, block *ast.Node, ctx *InlineContext) *ast.Node // 解析函数
+}
+
+// DelimiterSyntax 描述了一种和 ==标记==、^上标^ 一样成对出现的扩展分隔符语法，比如 ++插入++。
+//
+// 分隔符入栈后和强调一起处理，开始和结束分隔符都需要由连续 Num 个 Marker 组成，匹配后生成 Type 节点，其首尾子节点分别为
+// OpenMarkerType 和 CloseMarkerType 标记符节点。
+type DelimiterSyntax struct {
+	Marker          byte         // 分隔符字节，不能是内置的分隔符字节 *_~=#^
+	Num             int          // 分隔符字节数
+	Type            ast.NodeType // 节点类型，需要大于 ast.NodeTypeMaxVal
+	OpenMarkerType  ast.NodeType // 开始标记符节点类型，需要大于 ast.NodeTypeMaxVal
+	CloseMarkerType ast.NodeType // 结束标记符节点类型，需要大于 ast.NodeTypeMaxVal
+}
+
+// RegisterInlineSyntax 注册扩展行级语法 syntax，相同触发字节的语法按照注册顺序尝试，并且先于内置语法。
+func (options *Options) RegisterInlineSyntax(syntax *InlineSyntax) error {
+	if nil == syntax.Parse {
+		return errors.New("inline syntax requires a parse function")
+	}
+	if lex.ItemNewline == syntax.Trigger {
+		return errors.New("inline syntax can not be triggered by newline")
+	}
+
+	options.InlineSyntaxes = append(options.InlineSyntaxes, syntax)
+	return nil
+}
+
+// RegisterDelimiterSyntax 注册扩展分隔符语法 syntax。
+func (options *Options) RegisterDelimiterSyntax(syntax *DelimiterSyntax) error {
+	switch syntax.Marker {
+	case lex.ItemAsterisk, lex.ItemUnderscore, lex.ItemTilde, lex.ItemEqual, lex.ItemCrosshatch, lex.ItemCaret, lex.ItemNewline:
+		return errors.New("delimiter [" + string(syntax.Marker) + "] conflicts with built-in delimiters")
+	}
+	if 1 > syntax.Num {
+		return errors.New("delimiter syntax requires at least one marker")
+	}
+	for _, typ := range []ast.NodeType{syntax.Type, syntax.OpenMarkerType, syntax.CloseMarkerType} {
+		if ast.NodeTypeMaxVal >= typ {
+			return errors.New("delimiter syntax type [" + strconv.Itoa(int(typ)) + "] conflicts with built-in node types")
+		}
+	}
+	if nil != options.delimiterSyntax(syntax.Marker) {
+		return errors.New("delimiter [" + string(syntax.Marker) + "] has been registered")
+	}
+
+	options.DelimiterSyntaxes = append(options.DelimiterSyntaxes, syntax)
+	return nil
+}
+
+// delimiterSyntax 返回分隔符字节 marker 对应的扩展分隔符语法，没有注册时返回 nil。
+func (options *Options) delimiterSyntax(marker byte) *DelimiterSyntax {
+	for _, syntax := range options.DelimiterSyntaxes {
+		if marker == syntax.Marker {
+			return syntax
+		}
+	}
+	return nil
+}
+
+// isInlineTrigger 判断 token 是否是扩展行级语法的触发字节或者扩展分隔符字节。
+func (options *Options) isInlineTrigger(token byte) bool {
+	for _, syntax := range options.InlineSyntaxes {
+		if token == syntax.Trigger {
+			return true
+		}
+	}
+	return nil != options.delimiterSyntax(token)
+}
+
+// parseInlineSyntax 使用注册的扩展行级语法解析 ctx 当前位置，ok 为 false 时说明没有匹配的扩展语法，需要继续使用内置语法解析。
+// 匹配到扩展分隔符时分隔符已经入栈，此时返回的 ret 为 nil。
+func (t *Tree) parseInlineSyntax(block *ast.Node, ctx *InlineContext) (ret *ast.Node, ok bool) {
+	token := ctx.tokens[ctx.pos]
+	if !t.Context.ParseOption.isInlineTrigger(token) {
+		return nil, false
+	}
+	for _, syntax := range t.Context.ParseOption.InlineSyntaxes {
+		if token != syntax.Trigger {
+			continue
+		}
+		start := ctx.pos
+		if ret = syntax.Parse(t, block, ctx); nil != ret && start < ctx.pos {
+			return ret, true
+		}
+		ctx.pos = start
+	}
+
+	if nil != t.Context.ParseOption.delimiterSyntax(token) {
+		t.handleDelim(block, ctx)
+		return nil, true
+	}
+	if !t.isBuiltinMarker(token) {
+		// 内置语法不会处理该触发字节，作为文本，否则 parseText 会因为遇到触发字节而无法前进
+		ctx.pos++
+		return &ast.Node{Type: ast.NodeText, Tokens: ctx.tokens[ctx.pos-1 : ctx.pos]}, true
+	}
+	return nil, false
+}
+
+// Tokens 返回当前解析的 Tokens。
+func (ctx *InlineContext) Tokens() []byte {
+	return ctx.tokens
+}
+
+// Pos 返回当前解析到的位置。
+func (ctx *InlineContext) Pos() int {
+	return ctx.pos
+}
+
+// SetPos 设置当前解析到的位置。
+func (ctx *InlineContext) SetPos(pos int) {
+	ctx.pos = pos
+}
+
+// InDelimiter 判断分隔符栈中是否存在由 marker 组成并且尚未闭合的开始分隔符，比如 *foo @bar 中解析 @bar 时 InDelimiter('*') 为 true。
+func (ctx *InlineContext) InDelimiter(marker byte) bool {
+	for d := ctx.delimiters; nil != d; d = d.previous {
+		if marker == d.typ && d.canOpen {
+			return true
+		}
+	}
+	return false
+}
@@ -17,6 +17,9 @@ import (
 // parseInlines 解析并生成行级节点。
 func (t *Tree) parseInlines() {
 	t.walkParseInline(t.Root)
+	if 0 < t.Context.inlineFootnotes {
+		t.refreshFootnotesRefIds(t.Root)
+	}
 
 	if t.Context.ParseOption.KramdownSpanIAL {
 		t.parseKramdownSpanIAL()
@@ -42,7 +45,7 @@ func (t *Tree) walkParseInline(node *ast.Node) {
 	}
 
 	// 只有如下几种类型的块节点需要生成行级子节点
-	if ast.NodeParagraph == typ || ast.NodeHeading == typ || ast.NodeTableCell == typ {
+	if ast.NodeParagraph == typ || ast.NodeHeading == typ || ast.NodeTableCell == typ || ast.NodeDefinitionTerm == typ {
 		tokens := node.Tokens
 		if ast.NodeParagraph == typ {
 			if nil == tokens {
@@ -59,7 +62,10 @@ func (t *Tree) walkParseInline(node *ast.Node) {
 				if nil != node.Previous {
 					// 解析 kramdown 列表时可能出现列表项下面为空（* \n{id:foo}），此时 IAL 应该用于覆盖前一个 List 的
 					node.Previous.SetIALAttr("id", ial[0][1])
+					next := node.Next
 					node.Unlink()
+					// 和移除空段落一样保持继续迭代后面的兄弟节点
+					node.Next = next
 					return
 				}
 			}
@@ -78,6 +84,10 @@ func (t *Tree) walkParseInline(node *ast.Node) {
 		// 处理该块节点中的强调、加粗和删除线
 		t.processEmphasis(nil, ctx)
 
+		if t.Context.ParseOption.SourcePos {
+			t.inlineSourcePos(node, ctx)
+		}
+
 		// 将连续的文本节点进行合并。
 		// 规范只是定义了从输入的 Markdown 文本到输出的 HTML 的解析渲染规则，并未定义中间语法树的规则。
 		// 也就是说语法树的节点结构没有标准，可以自行发挥。这里进行文本节点合并主要有两个目的：
@@ -85,6 +95,10 @@ func (t *Tree) walkParseInline(node *ast.Node) {
 		// 2. 方便后续功能方面的处理，比如 GFM 自动链接解析
 		t.mergeText(node)
 
+		if t.Context.ParseOption.PandocAttributes {
+			t.parsePandocSpanAttributes(node)
+		}
+
 		if t.Context.ParseOption.GFMAutoLink && !t.Context.ParseOption.VditorWYSIWYG && !t.Context.ParseOption.VditorIR && !t.Context.ParseOption.VditorSV && !t.Context.ParseOption.ProtyleWYSIWYG {
 			t.parseGFMAutoEmailLink(node)
 			t.parseGFMAutoLink(node)
@@ -99,6 +113,10 @@ func (t *Tree) walkParseInline(node *ast.Node) {
 			// 细化围栏代码块子节点
 			openMarker := &ast.Node{Type: ast.NodeCodeBlockFenceOpenMarker, Tokens: node.CodeBlockOpenFence, CodeBlockFenceLen: node.CodeBlockFenceLen}
 			node.PrependChild(openMarker)
+			var attrs *ast.Node
+			if t.Context.ParseOption.PandocAttributes {
+				attrs = t.parseCodeBlockPandocAttributes(node)
+			}
 			info := &ast.Node{Type: ast.NodeCodeBlockFenceInfoMarker, CodeBlockInfo: node.CodeBlockInfo}
 			node.AppendChild(info)
 			code := &ast.Node{Type: ast.NodeCodeBlockCode, Tokens: node.Tokens}
@@ -108,6 +126,9 @@ func (t *Tree) walkParseInline(node *ast.Node) {
 			}
 			closeMarker := &ast.Node{Type: ast.NodeCodeBlockFenceCloseMarker, Tokens: node.CodeBlockCloseFence, CodeBlockFenceLen: node.CodeBlockFenceLen}
 			node.AppendChild(closeMarker)
+			if nil != attrs {
+				node.AppendChild(attrs)
+			}
 		} else {
 			// 细化缩进代码块子节点
 			code := &ast.Node{Type: ast.NodeCodeBlockCode, Tokens: node.Tokens}
@@ -11,6 +11,7 @@
 package parse
 
 import (
+	"bytes"
 	"unicode/utf8"
 
 	"github.com/Dofingert/lute-for-ficus/ast"
@@ -28,6 +29,7 @@ func (context *Context) parseLinkRefDef(tokens []byte) []byte {
 	if 1 > len(tokens) {
 		return nil
 	}
+	start := tokens
 
 	n, remains, label := context.parseLinkLabel(tokens)
 	if 2 > n || 1 > len(label) {
@@ -86,6 +88,11 @@ func (context *Context) parseLinkRefDef(tokens []byte) []byte {
 	link := context.Tree.newLink(ast.NodeLink, label, destination, title, 1)
 	def := &ast.Node{Type: ast.NodeLinkRefDef, Tokens: label}
 	def.AppendChild(link)
+	if sm := context.sourceMaps[context.Tip]; nil != sm {
+		if i := sm.index(start); 0 <= i {
+			def.SourcePos = sm.newSourcePos(i, i+len(bytes.TrimRight(start[:len(start)-len(remains)], "\n")))
+		}
+	}
 	defBlock := context.Tip
 	if ast.NodeLinkRefDefBlock != defBlock.Type {
 		defBlock = &ast.Node{Type: ast.NodeLinkRefDefBlock}
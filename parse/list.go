@@ -236,9 +236,12 @@ func (t *Tree) parseListMarker(container *ast.Node) (data *ast.ListData, ial [][
 		}
 
 		if 3 <= len(tokens) { // 至少需要 [ ] 或者 [x] 3 个字符
-			if lex.ItemOpenBracket == tokens[0] && ('x' == tokens[1] || 'X' == tokens[1] || lex.ItemSpace == tokens[1]) && lex.ItemCloseBracket == tokens[2] {
+			if lex.ItemOpenBracket == tokens[0] && t.Context.ParseOption.isTaskListItemState(tokens[1]) && lex.ItemCloseBracket == tokens[2] {
 				data.Typ = 3
 				data.Checked = 'x' == tokens[1] || 'X' == tokens[1]
+				if IsExtendedTaskState(tokens[1]) {
+					data.TaskState = tokens[1]
+				}
 			}
 		}
 	}
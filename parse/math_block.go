@@ -133,8 +133,15 @@ func (context *Context) isMathBlockClose(tokens []byte) bool {
 		return false
 	}
 	tokens = lex.TrimWhitespace(tokens)
-	for _, token := range tokens {
+	for i, token := range tokens {
 		if token != lex.ItemDollar {
+			if context.ParseOption.CrossRef {
+				// $$ {#eq:label}
+				if label := crossRefLabel(tokens[i:]); nil != label && "eq" == CrossRefKind(util.BytesToStr(label)) {
+					context.Tip.CrossRefLabel = string(label)
+					return true
+				}
+			}
 			return false
 		}
 	}
@@ -0,0 +1,303 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package parse
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/html"
+	"github.com/Dofingert/lute-for-ficus/lex"
+)
+
+// Pandoc 通用属性 {#id .class key=val} 的解析结果和 kramdown IAL 一样保存在节点的 KramdownIAL 中，id 总是第一项，多个 class 合并为一项。
+// 属性原文保存在 NodeKramdownSpanIAL 节点中：行级节点（链接、图片、代码和 [text]{.class}）的属性节点是其后一个兄弟节点，
+// 标题和围栏代码块的属性节点是其最后一个子节点。Protyle 中块的 id 是节点 ID，不是节点 ID 格式的 id 会改为保存在 custom-pandoc-id 中。
+
+// IsPandocAttributes 判断属性节点的原文 tokens 是否是 Pandoc 通用属性 {#id .class key=val}，而不是 kramdown IAL {: key="val"}。
+func IsPandocAttributes(tokens []byte) bool {
+	return 1 < len(tokens) && lex.ItemOpenBrace == tokens[0] && lex.ItemColon != tokens[1]
+}
+
+// parsePandocAttributes 解析以 { 开头的 Pandoc 通用属性 {#id .class key=val key2="val 2"}，返回 } 的位置和属性列表，不合法时返回的属性列表为 nil。
+func parsePandocAttributes(tokens []byte) (pos int, ret [][]string) {
+	if 3 > len(tokens) || lex.ItemOpenBrace != tokens[0] || lex.ItemColon == tokens[1] {
+		return
+	}
+
+	var id string
+	var classes, attrs [][]string
+	i := 1
+	for {
+		for i < len(tokens) && (lex.ItemSpace == tokens[i] || lex.ItemTab == tokens[i] || lex.ItemNewline == tokens[i]) {
+			i++
+		}
+		if i >= len(tokens) {
+			return 0, nil
+		}
+
+		token := tokens[i]
+		if lex.ItemCloseBrace == token {
+			break
+		}
+
+		switch token {
+		case lex.ItemCrosshatch, lex.ItemDot:
+			start := i + 1
+			for i = start; i < len(tokens) && isPandocAttributeNameToken(tokens[i]); i++ {
+			}
+			if start == i {
+				return 0, nil
+			}
+			name := string(tokens[start:i])
+			if lex.ItemCrosshatch == token {
+				id = name
+			} else {
+				classes = append(classes, []string{name})
+			}
+		case lex.ItemHyphen:
+			// {-} 是 {.unnumbered} 的简写
+			i++
+			classes = append(classes, []string{"unnumbered"})
+		default:
+			start := i
+			for ; i < len(tokens) && isPandocAttributeNameToken(tokens[i]) && lex.ItemColon != tokens[i]; i++ {
+			}
+			if start == i || i >= len(tokens) || lex.ItemEqual != tokens[i] {
+				return 0, nil
+			}
+			key := string(tokens[start:i])
+			i++
+			var val []byte
+			if val, i = pandocAttributeValue(tokens, i); 0 > i {
+				return 0, nil
+			}
+			attrs = append(attrs, []string{key, html.EscapeAttrVal(string(val))})
+		}
+		if i < len(tokens) && !lex.IsWhitespace(tokens[i]) && lex.ItemCloseBrace != tokens[i] {
+			return 0, nil
+		}
+	}
+	if "" == id && 1 > len(classes) && 1 > len(attrs) {
+		return 0, nil
+	}
+
+	ret = [][]string{}
+	if "" != id {
+		ret = append(ret, []string{"id", html.EscapeAttrVal(id)})
+	}
+	if 0 < len(classes) {
+		var names []string
+		for _, class := range classes {
+			names = append(names, class[0])
+		}
+		ret = append(ret, []string{"class", html.EscapeAttrVal(strings.Join(names, " "))})
+	}
+	ret = append(ret, attrs...)
+	return i, ret
+}
+
+// pandocAttributeValue 解析从 tokens[i] 开始的属性值，属性值可以使用双引号或者单引号包裹，返回属性值和属性值结束的位置，不合法时位置返回 -1。
+func pandocAttributeValue(tokens []byte, i int) (val []byte, end int) {
+	if i >= len(tokens) {
+		return nil, -1
+	}
+
+	quote := tokens[i]
+	if lex.ItemDoublequote != quote && lex.ItemSinglequote != quote {
+		start := i
+		for ; i < len(tokens) && !lex.IsWhitespace(tokens[i]) && lex.ItemCloseBrace != tokens[i] && lex.ItemDoublequote != tokens[i] && lex.ItemSinglequote != tokens[i]; i++ {
+		}
+		if start == i {
+			return nil, -1
+		}
+		return tokens[start:i], i
+	}
+
+	for i++; i < len(tokens); i++ {
+		token := tokens[i]
+		if lex.ItemBackslash == token && i+1 < len(tokens) && (quote == tokens[i+1] || lex.ItemBackslash == tokens[i+1]) {
+			i++
+			val = append(val, tokens[i])
+			continue
+		}
+		if quote == token {
+			return val, i + 1
+		}
+		if lex.ItemNewline == token {
+			break
+		}
+		val = append(val, token)
+	}
+	return nil, -1
+}
+
+func isPandocAttributeNameToken(token byte) bool {
+	return lex.IsASCIILetterNum(token) || lex.ItemHyphen == token || lex.ItemUnderscore == token || lex.ItemColon == token || lex.ItemDot == token
+}
+
+// IAL2PandocTokens 将属性列表 ial 转换为 Pandoc 通用属性 {#id .class key=val}。
+func IAL2PandocTokens(ial [][]string) []byte {
+	buf := bytes.Buffer{}
+	buf.WriteByte(lex.ItemOpenBrace)
+	for _, kv := range ial {
+		val := html.UnescapeAttrVal(kv[1])
+		switch kv[0] {
+		case "id":
+			buf.WriteString(" #" + val)
+		case "class":
+			for _, class := range strings.Fields(val) {
+				buf.WriteString(" ." + class)
+			}
+		default:
+			buf.WriteString(" " + kv[0] + "=")
+			if "" == val || strings.ContainsAny(val, " \t\n\"'{}") {
+				val = strings.ReplaceAll(val, "\\", "\\\\")
+				val = strings.ReplaceAll(val, "\"", "\\\"")
+				val = "\"" + val + "\""
+			}
+			buf.WriteString(val)
+		}
+	}
+	buf.WriteByte(lex.ItemCloseBrace)
+	ret := buf.Bytes()
+	if 2 < len(ret) {
+		ret = append(ret[:1], ret[2:]...) // 去掉 { 后的空格
+	}
+	return ret
+}
+
+// parseHeadingPandocAttributes 解析标题末尾的 Pandoc 通用属性 # Heading {#id .class}，不匹配时返回 nil 并且不移动解析位置。
+func (t *Tree) parseHeadingPandocAttributes(block *ast.Node, ctx *InlineContext) *ast.Node {
+	if !t.Context.ParseOption.PandocAttributes || ast.NodeHeading != block.Type {
+		return nil
+	}
+
+	tokens := ctx.tokens[ctx.pos:]
+	pos, ial := parsePandocAttributes(tokens)
+	if nil == ial || !lex.IsBlank(tokens[pos+1:]) {
+		return nil
+	}
+
+	block.KramdownIAL = ial
+	if nil != block.LastChild {
+		block.LastChild.Tokens = bytes.TrimRight(block.LastChild.Tokens, " ")
+	}
+	ctx.pos = ctx.tokensLen
+	return &ast.Node{Type: ast.NodeKramdownSpanIAL, Tokens: tokens[:pos+1]}
+}
+
+// isBracketedSpan 判断 [text] 后面是否紧跟 Pandoc 通用属性，是的话 [text] 解析为 NodeBracketedSpan。
+func (t *Tree) isBracketedSpan(ctx *InlineContext) bool {
+	if !t.Context.ParseOption.PandocAttributes || ctx.pos >= ctx.tokensLen || lex.ItemOpenBrace != ctx.tokens[ctx.pos] {
+		return false
+	}
+	_, ial := parsePandocAttributes(ctx.tokens[ctx.pos:])
+	return nil != ial
+}
+
+// parsePandocSpanAttributes 将块节点 block 中紧跟在链接、图片、代码和 [text] 后面的 Pandoc 通用属性应用到对应的节点上。
+func (t *Tree) parsePandocSpanAttributes(block *ast.Node) {
+	ast.Walk(block, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if !entering {
+			return ast.WalkContinue
+		}
+
+		switch n.Type {
+		case ast.NodeLink, ast.NodeImage, ast.NodeCodeSpan, ast.NodeBracketedSpan:
+		default:
+			return ast.WalkContinue
+		}
+
+		if nil == n.Next || ast.NodeText != n.Next.Type {
+			return ast.WalkContinue
+		}
+
+		tokens := n.Next.Tokens
+		if pos, ial := parsePandocAttributes(tokens); nil != ial {
+			n.KramdownIAL = ial
+			n.Next.Tokens = tokens[pos+1:]
+			if 1 > len(n.Next.Tokens) {
+				n.Next.Unlink()
+			}
+			n.InsertAfter(&ast.Node{Type: ast.NodeKramdownSpanIAL, Tokens: tokens[:pos+1]})
+		}
+		return ast.WalkContinue
+	})
+}
+
+// parseCodeBlockPandocAttributes 解析围栏代码块信息中的 Pandoc 通用属性 ```python {#id .class} 或者 ```{.python .class}，
+// 没有指定语言时使用第一个 class 作为语言。解析成功时返回属性节点，需要由调用者挂到代码块的最后。
+func (t *Tree) parseCodeBlockPandocAttributes(codeBlock *ast.Node) (ret *ast.Node) {
+	info := codeBlock.CodeBlockInfo
+	defer func() {
+		if nil == ret {
+			// 不是 Pandoc 通用属性时和普通信息一样只保留第一个单词
+			if idx := bytes.IndexByte(info, lex.ItemSpace); 0 <= idx {
+				codeBlock.CodeBlockInfo = info[:idx]
+			}
+		}
+	}()
+
+	start := bytes.IndexByte(info, lex.ItemOpenBrace)
+	if 0 > start {
+		return nil
+	}
+	tokens := lex.TrimWhitespace(info[start:])
+	pos, ial := parsePandocAttributes(tokens)
+	if nil == ial || pos != len(tokens)-1 {
+		return nil
+	}
+
+	language := lex.TrimWhitespace(info[:start])
+	if bytes.ContainsAny(language, " \t") {
+		return nil
+	}
+	if 1 > len(language) {
+		for i, kv := range ial {
+			if "class" != kv[0] {
+				continue
+			}
+			classes := strings.Fields(kv[1])
+			language = []byte(classes[0])
+			if 1 < len(classes) {
+				ial[i][1] = strings.Join(classes[1:], " ")
+			} else {
+				ial = append(ial[:i], ial[i+1:]...)
+			}
+			break
+		}
+		if 1 > len(ial) {
+			// 只有语言类名时（比如 {.python}）不需要保留空的属性
+			codeBlock.CodeBlockInfo, info = language, language
+			return nil
+		}
+	}
+
+	codeBlock.CodeBlockInfo = language
+	codeBlock.KramdownIAL = ial
+	return &ast.Node{Type: ast.NodeKramdownSpanIAL, Tokens: tokens}
+}
+
+// PandocAttributes 返回节点 node 上使用 Pandoc 通用属性设置的属性节点，没有时返回 nil。
+func PandocAttributes(node *ast.Node) (ret *ast.Node) {
+	switch node.Type {
+	case ast.NodeHeading, ast.NodeCodeBlock:
+		ret = node.LastChild
+	default:
+		ret = node.Next
+	}
+	if nil == ret || ast.NodeKramdownSpanIAL != ret.Type || !IsPandocAttributes(ret.Tokens) {
+		return nil
+	}
+	return
+}
@@ -79,7 +79,7 @@ func paragraphFinalize(p *ast.Node, context *Context) (insertTable bool) {
 						}
 					}
 
-					if (3 == len(tokens) && (bytes.EqualFold(tokens, []byte("[x]")) || bytes.Equal(tokens, []byte("[ ]")))) ||
+					if (3 == len(tokens) && lex.ItemOpenBracket == tokens[0] && context.ParseOption.isTaskListItemState(tokens[1]) && lex.ItemCloseBracket == tokens[2]) ||
 						(3 < len(tokens) && (lex.IsWhitespace(tokens[3]) || editor.CaretTokens[0] == tokens[3] || editor.CaretTokens[0] == tokens[2])) {
 						var caretStartText, caretAfterCloseBracket, caretInBracket bool
 						if context.ParseOption.VditorWYSIWYG || context.ParseOption.VditorIR || context.ParseOption.VditorSV || context.ParseOption.ProtyleWYSIWYG {
@@ -95,7 +95,7 @@ func paragraphFinalize(p *ast.Node, context *Context) (insertTable bool) {
 								caretInBracket = true
 							}
 						}
-						taskListItemMarker := &ast.Node{Type: ast.NodeTaskListItemMarker, Tokens: tokens[:3], TaskListItemChecked: listItem.ListData.Checked}
+						taskListItemMarker := &ast.Node{Type: ast.NodeTaskListItemMarker, Tokens: tokens[:3], TaskListItemChecked: listItem.ListData.Checked, TaskListItemState: listItem.ListData.TaskState}
 						if context.ParseOption.ProtyleWYSIWYG {
 							p.InsertBefore(taskListItemMarker)
 						} else {
@@ -123,6 +123,17 @@ func paragraphFinalize(p *ast.Node, context *Context) (insertTable bool) {
 								}
 								subBlock.ID = p.ID
 								subBlock.KramdownIAL = p.KramdownIAL
+								if nil != p.SourcePos {
+									// 子树中的位置是相对于任务列表项标记之后的内容的，这里仅保留块级位置
+									ast.Walk(subBlock, func(n *ast.Node, entering bool) ast.WalkStatus {
+										n.SourcePos = nil
+										return ast.WalkContinue
+									})
+									pos := *p.SourcePos
+									pos.StartColumn += 3
+									pos.StartOffset += 3
+									subBlock.SourcePos = &pos
+								}
 								p.InsertAfter(subBlock)
 								p.Unlink()
 							}
@@ -146,6 +157,8 @@ func paragraphFinalize(p *ast.Node, context *Context) (insertTable bool) {
 				// 将该段落节点转成表节点
 				p.Type = ast.NodeTable
 				p.TableAligns = table.TableAligns
+				p.TableCaption = table.TableCaption
+				p.CrossRefLabel = table.CrossRefLabel
 				for tr := table.FirstChild; nil != tr; {
 					nextTr := tr.Next
 					p.AppendChild(tr)
@@ -21,24 +21,54 @@ import (
 func Parse(name string, markdown []byte, options *Options) (tree *Tree) {
 	tree = &Tree{Name: name, Context: &Context{ParseOption: options}}
 	tree.Context.Tree = tree
+	if options.SourcePos {
+		// 词法分析时会原地修改输入（比如移除 \r），所以这里需要保留一份原文用于增量解析
+		tree.source = append([]byte{}, markdown...)
+	}
 	tree.lexer = lex.NewLexer(markdown)
 	tree.Root = &ast.Node{Type: ast.NodeDocument}
 	tree.parseBlocks()
 	tree.parseInlines()
 	tree.finalParseBlockIAL()
+	if options.CrossRef {
+		tree.NumberCrossRefs()
+	}
 	tree.lexer = nil
+	tree.Context.sourceMaps = nil
 	return
 }
 
+// Source 返回解析时的 Markdown 原文，仅在打开解析选项 SourcePos 时保留，否则返回 nil。
+func (t *Tree) Source() []byte {
+	return t.source
+}
+
 func (t *Tree) finalParseBlockIAL() {
 	if !t.Context.ParseOption.KramdownBlockIAL {
 		return
 	}
 
+	t.parseBlockIAL(t.Root)
+
+	var docIAL *ast.Node
+	var id string
+	if nil != t.Context.rootIAL {
+		docIAL = t.Context.rootIAL
+	} else {
+		id = ast.NewNodeID()
+		docIAL = &ast.Node{Type: ast.NodeKramdownBlockIAL, Tokens: []byte("{: id=\"" + id + "\" updated=\"" + id[:14] + "\" type=\"doc\"}")}
+		t.Root.ID = id
+		t.ID = id
+	}
+	t.Root.AppendChild(docIAL)
+}
+
+// parseBlockIAL 将 node 及其子块后面紧跟的 kramdown 块级 IAL 应用到对应的块上。
+func (t *Tree) parseBlockIAL(node *ast.Node) {
 	// 补全空段落
 	var appends []*ast.Node
 
-	ast.Walk(t.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+	ast.Walk(node, func(n *ast.Node, entering bool) ast.WalkStatus {
 		if !entering || !n.IsBlock() || ast.NodeKramdownBlockIAL == n.Type {
 			return ast.WalkContinue
 		}
@@ -46,9 +76,26 @@ func (t *Tree) finalParseBlockIAL() {
 		if ast.NodeBlockquote == n.Type && nil != n.FirstChild && nil == n.FirstChild.Next {
 			appends = append(appends, n)
 		}
+		if ast.NodeCallout == n.Type && nil == n.FirstChild {
+			appends = append(appends, n)
+		}
+
+		if ast.NodeFencedDiv == n.Type {
+			// 围栏 div 的 KramdownIAL 保存的是 div 属性，其中的 id 不作为节点 ID
+			if "" == n.ID {
+				n.ID = ast.NewNodeID()
+			}
+			return ast.WalkContinue
+		}
 
 		if "" == n.ID {
 			id := n.IALAttr("id")
+			if t.Context.ParseOption.ProtyleWYSIWYG && "" != id && !ast.IsNodeIDPattern(id) {
+				// Pandoc 通用属性设置的 id 不是节点 ID，改为保存在 custom-pandoc-id 中
+				n.RemoveIALAttr("id")
+				n.SetIALAttr("custom-pandoc-id", id)
+				id = ""
+			}
 			if "" == id {
 				id = ast.NewNodeID()
 			}
@@ -100,18 +147,6 @@ func (t *Tree) finalParseBlockIAL() {
 		p.InsertAfter(&ast.Node{Type: ast.NodeKramdownBlockIAL, Tokens: ialTokens})
 		n.AppendChild(p)
 	}
-
-	var docIAL *ast.Node
-	var id string
-	if nil != t.Context.rootIAL {
-		docIAL = t.Context.rootIAL
-	} else {
-		id = ast.NewNodeID()
-		docIAL = &ast.Node{Type: ast.NodeKramdownBlockIAL, Tokens: []byte("{: id=\"" + id + "\" updated=\"" + id[:14] + "\" type=\"doc\"}")}
-		t.Root.ID = id
-		t.ID = id
-	}
-	t.Root.AppendChild(docIAL)
 }
 
 // Block 会将 markdown 原始文本字节数组解析为一棵语法树，该语法树的第一个块级子节点是段落节点。
@@ -151,6 +186,15 @@ type Context struct {
 	lastMatchedContainer                                     *ast.Node // 最后一个匹配的块节点
 
 	rootIAL *ast.Node // 根节点 kramdown IAL
+
+	stream *Stream // 流式解析器，仅在流式解析时不为 nil，用于记录未找到定义的引用标签
+
+	inlineFootnotes int // 已经生成的匿名脚注定义数量，用于生成行级脚注的 label
+
+	lineNum, lineOffset, lineLen             int                      // 当前行的行号、原文偏移量和长度（不含换行符），用于记录源码位置
+	prevLineNum, prevLineOffset, prevLineLen int                      // 上一行的行号、原文偏移量和长度
+	sourceMaps                               map[*ast.Node]*sourceMap // 块节点 Tokens 到原文位置的映射，用于计算行级节点位置
+	closingPrevious                          bool                     // 是否正在最终化在上一行就已经结束的块
 }
 
 // InlineContext 描述了行级元素解析上下文。
@@ -160,6 +204,8 @@ type InlineContext struct {
 	pos        int        // 当前解析到的 token 位置
 	delimiters *delimiter // 分隔符栈，用于强调解析
 	brackets   *delimiter // 括号栈，用于图片和链接解析
+
+	spans map[*ast.Node][2]int // 解析生成的行级节点在 tokens 中的下标区间，仅在打开解析选项 SourcePos 时记录
 }
 
 // advanceOffset 用于移动 count 个字符位置，columns 指定了遇到 tab 时是否需要空格进行补偿偏移。
@@ -234,7 +280,7 @@ func (context *Context) closeUnmatchedBlocks() {
 	if !context.allClosed {
 		for context.oldtip != context.lastMatchedContainer {
 			parent := context.oldtip.Parent
-			context.finalize(context.oldtip)
+			context.finalizePrevious(context.oldtip)
 			context.oldtip = parent
 		}
 		context.allClosed = true
@@ -244,13 +290,17 @@ func (context *Context) closeUnmatchedBlocks() {
 // closeSuperBlockChildren 最终化超级块下的子节点。
 func (context *Context) closeSuperBlockChildren() {
 	for n := context.Tip; nil != n && ast.NodeSuperBlock != n.Type; n = n.Parent {
-		context.finalize(n)
+		context.finalizePrevious(n)
 	}
 }
 
 // finalize 执行 block 的最终化处理。调用该方法会将 context.Tip 置为 block 的父节点。
 func (context *Context) finalize(block *ast.Node) {
 	parent := block.Parent
+	if context.ParseOption.SourcePos && !block.Close {
+		// 从段落中拆分出来的表格在插入时就已经闭合并设置好了结束位置
+		context.sourcePosEnd(block, context.closingPrevious)
+	}
 	block.Close = true
 
 	// 节点最终化处理。比如围栏代码块提取 info 部分；HTML 代码块剔除结尾空格；段落需要解析链接引用定义等。
@@ -261,6 +311,9 @@ func (context *Context) finalize(block *ast.Node) {
 		context.htmlBlockFinalize(block)
 	case ast.NodeParagraph:
 		insertTable := paragraphFinalize(block, context)
+		if context.ParseOption.SourcePos {
+			context.paragraphSourcePos(block, insertTable)
+		}
 		if insertTable {
 			return
 		}
@@ -270,12 +323,18 @@ func (context *Context) finalize(block *ast.Node) {
 		context.yamlFrontMatterFinalize(block)
 	case ast.NodeList:
 		context.listFinalize(block)
+	case ast.NodeDefinitionList:
+		context.definitionListFinalize(block)
 	case ast.NodeSuperBlock:
 		context.superBlockFinalize(block)
 	case ast.NodeGitConflict:
 		context.gitConflictFinalize(block)
 	case ast.NodeCustomBlock:
 		context.customBlockFinalize(block)
+	default:
+		if syntax := context.ParseOption.blockSyntax(block.Type); nil != syntax && nil != syntax.Finalize {
+			syntax.Finalize(block, context)
+		}
 	}
 
 	context.Tip = parent
@@ -285,6 +344,10 @@ func (context *Context) finalize(block *ast.Node) {
 func (context *Context) addChildMarker(nodeType ast.NodeType, tokens []byte) (ret *ast.Node) {
 	ret = &ast.Node{Type: nodeType, Tokens: tokens, Close: true}
 	context.Tip.AppendChild(ret)
+	if context.ParseOption.SourcePos && 0 < len(tokens) {
+		context.sourcePosStart(ret)
+		ret.SourcePos.EndLine, ret.SourcePos.EndColumn, ret.SourcePos.EndOffset = context.lineNum, ret.SourcePos.StartColumn+len(tokens)-1, ret.SourcePos.StartOffset+len(tokens)
+	}
 	return
 }
 
@@ -292,12 +355,15 @@ func (context *Context) addChildMarker(nodeType ast.NodeType, tokens []byte) (re
 // 节点并向父节点方向尝试，直到找到一个能接受该子节点的节点为止。添加完成后该子节点会被设置为新的末梢节点。
 func (context *Context) addChild(nodeType ast.NodeType) (ret *ast.Node) {
 	for !context.Tip.CanContain(nodeType) {
-		context.finalize(context.Tip) // 注意调用 finalize 会向父节点方向进行迭代
+		context.finalizePrevious(context.Tip) // 注意调用 finalize 会向父节点方向进行迭代
 	}
 
 	ret = &ast.Node{Type: nodeType}
 	context.Tip.AppendChild(ret)
 	context.Tip = ret
+	if context.ParseOption.SourcePos {
+		context.sourcePosStart(ret)
+	}
 	return
 }
 
@@ -314,6 +380,7 @@ type Tree struct {
 	Context       *Context       // 块级解析上下文
 	lexer         *lex.Lexer     // 词法分析器
 	inlineContext *InlineContext // 行级解析上下文
+	source        []byte         // Markdown 原文，仅在打开解析选项 SourcePos 时保留，用于增量解析
 
 	Name    string   // 名称
 	ID      string   // ID
@@ -332,6 +399,8 @@ type Options struct {
 	GFMTable bool
 	// GFMTaskListItem 设置是否打开“GFM 任务列表项”支持。
 	GFMTaskListItem bool
+	// TaskListItemStates 设置任务列表项除 [ ]、[x] 和 [X] 以外允许的状态字符，比如 "-?!>"。
+	TaskListItemStates string
 	// GFMStrikethrough 设置是否打开“GFM 删除线”支持。
 	GFMStrikethrough bool
 	// GFMAutoLink 设置是否打开“GFM 自动链接”支持。
@@ -400,6 +469,33 @@ type Options struct {
 	// 这个开关主要用于兼容 Markdown 输入 API 上 https://github.com/siyuan-note/siyuan/issues/6039
 	// 不用于 Protyle 自旋过程 https://github.com/siyuan-note/siyuan/issues/5877
 	HTMLTag2TextMark bool
+	// Callout 设置是否打开提示块 > [!NOTE] 支持。
+	Callout bool
+	// DefinitionList 设置是否打开定义列表 Term\n: Definition 支持。
+	DefinitionList bool
+	// WikiLink 设置是否打开 Wiki 链接 [[target#heading|alias]] 支持。
+	WikiLink bool
+	// CrossRef 设置是否打开图表公式编号和交叉引用 {#fig:arch}、@fig:arch 支持，流式解析 Stream 不进行编号。
+	CrossRef bool
+	// Citation 设置是否打开文献引用 [@smith2020, p. 33]、@smith2020 支持，同时打开 CrossRef 时 @fig:arch 优先解析为交叉引用。
+	Citation bool
+	// PandocAttributes 设置是否打开 Pandoc 通用属性 {#id .class key=val} 支持，可用于标题、围栏代码块、链接、图片、代码和 [text]{.class}。
+	PandocAttributes bool
+	// FencedDiv 设置是否打开 Pandoc 围栏 div ::: {.warning #id} 支持。
+	FencedDiv bool
+	// InlineFootnotes 设置是否打开行级脚注 ^[text] 支持，需要同时打开 Footnotes。
+	InlineFootnotes bool
+	// ExtendedTable 设置是否打开扩展表格支持，包括 \ 续行、|| 跨列、^^ 跨行和 [Caption] 表格标题。
+	ExtendedTable bool
+	// SourcePos 设置是否记录节点在 Markdown 原文中的位置（行、列和字节偏移量）。
+	SourcePos bool
+	// BlockSyntaxes 按优先级存储通过 RegisterBlockSyntax 注册的扩展块级语法。
+	BlockSyntaxes []*BlockSyntax
+	blockStarts   []blockStartFunc // 合并了内置块和扩展块的开始函数
+	// InlineSyntaxes 按注册顺序存储通过 RegisterInlineSyntax 注册的扩展行级语法。
+	InlineSyntaxes []*InlineSyntax
+	// DelimiterSyntaxes 存储通过 RegisterDelimiterSyntax 注册的扩展分隔符语法。
+	DelimiterSyntaxes []*DelimiterSyntax
 	// Spin 设置是否打开自旋解析支持，该选项仅用于 Spin 内部过程，外部请勿设置或使用。
 	// 该选项的引入主要为了解决 finalParseBlockIAL 过程中是否需要移动 IAL 节点的问题，只有处于自旋过程中才需要移动 IAL 节点
 	// 其他情况（比如 API 输入 markdown https://github.com/siyuan-note/siyuan/issues/6725）无需移动处理
@@ -0,0 +1,373 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package parse
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/lex"
+)
+
+// Edit 描述了对 Markdown 原文的一次编辑：从字节偏移量 Offset 处删除 DeletedLen 个字节，然后插入 Inserted。
+type Edit struct {
+	Offset     int    // 编辑起始字节偏移量
+	DeletedLen int    // 删除的字节数
+	Inserted   []byte // 插入的内容
+}
+
+// Reparse 将 edit 应用到语法树对应的 Markdown 原文上，并增量更新语法树。
+//
+// 语法树必须是在打开解析选项 SourcePos 的情况下通过 Parse 生成的。增量解析的过程如下：
+//  1. 根据节点位置找到受编辑影响的顶层块，并向前多包含一个顶层块（编辑可能让它和后面的内容合并，比如 Setext 标题、列表）
+//  2. 从该顶层块所在行开始重新进行块级解析，每当遇到后续未受影响顶层块的起始行时检查该行是否同样起始了一个新的顶层块，
+//     如果是则说明之前的块都已经闭合（比如围栏代码块、数学公式块、列表），解析状态和原来一致，可以在此处停止；否则继续向后扩展
+//  3. 对比新旧顶层块，内容、位置和行级结构都没有变化的旧节点（连同其 ID）保持不动，仅替换变化的部分并对其进行行级解析
+//  4. 修正其后所有节点的位置
+//
+// 编辑涉及链接引用定义、新增了行级脚注或者语法树中存在脚注定义时，由于它们会影响全文的行级解析结果，此时会退化为全量解析。
+// 返回值 nodes 为替换进语法树的新顶层块。
+func (t *Tree) Reparse(edit Edit) (nodes []*ast.Node, err error) {
+	if nil == t.source {
+		return nil, errors.New("reparse requires a tree parsed with option SourcePos")
+	}
+	editEnd := edit.Offset + edit.DeletedLen
+	if 0 > edit.Offset || 0 > edit.DeletedLen || len(t.source) < editEnd {
+		return nil, errors.New("edit [offset=" + strconv.Itoa(edit.Offset) + ", deletedLen=" + strconv.Itoa(edit.DeletedLen) + "] is out of range")
+	}
+
+	source := make([]byte, 0, len(t.source)-edit.DeletedLen+len(edit.Inserted))
+	source = append(source, t.source[:edit.Offset]...)
+	source = append(source, edit.Inserted...)
+	source = append(source, t.source[editEnd:]...)
+
+	blocks, tail, ok := t.reparseBlocks()
+	if !ok || t.hasFootnotesDef() {
+		return t.reparseAll(source), nil
+	}
+
+	// 受影响的第一个顶层块及其前一个顶层块
+	i := 0
+	for ; i < len(blocks) && blocks[i].SourcePos.EndOffset < edit.Offset; i++ {
+	}
+	start := i - 1
+	if 0 > start {
+		start = 0
+	}
+	for 0 < start && (ast.NodeKramdownBlockIAL == blocks[start].Type || ast.NodeTable == blocks[start].Type) {
+		// IAL 需要挂到前一个块上，表格可能是从前面的段落中拆分出来的
+		start--
+	}
+	regionStart, baseLine := 0, 1
+	if 0 < start {
+		regionStart, baseLine = lineStart(blocks[start]), blocks[start].SourcePos.StartLine
+	}
+
+	delta := len(edit.Inserted) - edit.DeletedLen
+	option := t.Context.ParseOption
+	if 0 < regionStart && option.YamlFrontMatter {
+		// 区间不在文档开头时不能解析出 YAML Front Matter
+		o := *option
+		o.YamlFrontMatter = false
+		option = &o
+	}
+	rt := &Tree{Name: t.Name, Context: &Context{ParseOption: option}}
+	rt.Context.Tree = rt
+	rt.lexer = lex.NewLexer(append([]byte{}, source[regionStart:]...))
+	rt.Root = &ast.Node{Type: ast.NodeDocument, SourcePos: &ast.SourcePos{StartLine: 1, StartColumn: 1}}
+	rt.Context.Tip = rt.Root
+
+	// 逐行解析，直到某个未受影响的顶层块的起始行在新的解析中同样起始了一个顶层块
+	end, lineDelta := len(blocks), 0
+	candidate := start + 1
+	for line := rt.lexer.NextLine(); nil != line; line = rt.lexer.NextLine() {
+		offset := regionStart + rt.lexer.LineOffset()
+		for ; candidate < len(blocks) && (lineStart(blocks[candidate]) < editEnd || lineStart(blocks[candidate])+delta < offset); candidate++ {
+		}
+		if !rt.parseBlockLine(line) {
+			return t.reparseAll(source), nil
+		}
+
+		if candidate >= len(blocks) || lineStart(blocks[candidate])+delta != offset {
+			continue
+		}
+		if typ := blocks[candidate].Type; ast.NodeKramdownBlockIAL != typ && ast.NodeTable != typ {
+			// YAML Front Matter 只能出现在文档开头，新解析出的 Front Matter 说明后续内容的解析结果已经改变
+			if last := rt.Root.LastChild; nil != last && nil != last.SourcePos && rt.lexer.Line() == last.SourcePos.StartLine && ast.NodeYamlFrontMatter != last.Type {
+				last.Unlink()
+				end = candidate
+				lineDelta = baseLine + rt.lexer.Line() - 1 - blocks[candidate].SourcePos.StartLine
+				break
+			}
+		}
+		candidate++
+	}
+	if end == len(blocks) {
+		for nil != rt.Context.Tip {
+			rt.Context.finalize(rt.Context.Tip)
+		}
+	}
+	rt.lexer = nil
+
+	if nil != rt.Context.rootIAL {
+		return t.reparseAll(source), nil
+	}
+	if hasDefs(rt.Root) {
+		return t.reparseAll(source), nil
+	}
+	first := blocks[start]
+	if 0 == start {
+		first = t.Root.FirstChild
+	}
+	for n := first; nil != n && (end == len(blocks) || n != blocks[end]) && n != tail; n = n.Next {
+		if nil == n.SourcePos || hasDefs(n) {
+			// 旧的区间中存在链接引用定义
+			return t.reparseAll(source), nil
+		}
+	}
+
+	rt.finalParseBlockIAL()
+	if docIAL := rt.Root.LastChild; nil != docIAL && ast.NodeKramdownBlockIAL == docIAL.Type && nil == docIAL.SourcePos {
+		docIAL.Unlink()
+	}
+
+	// 将新解析的块的位置换算为全文位置
+	lineShift := baseLine - 1
+	for n := rt.Root.FirstChild; nil != n; n = n.Next {
+		shiftSourcePos(n, lineShift, regionStart)
+	}
+	for _, sm := range rt.Context.sourceMaps {
+		for i := range sm.lines {
+			sm.lines[i].line += lineShift
+			sm.lines[i].offset += regionStart
+		}
+	}
+
+	var newBlocks []*ast.Node
+	for n := rt.Root.FirstChild; nil != n; n = n.Next {
+		if nil == n.SourcePos {
+			return t.reparseAll(source), nil
+		}
+		newBlocks = append(newBlocks, n)
+	}
+	oldBlocks := blocks[start:end]
+
+	if 0 < regionStart && 0 < len(newBlocks) && ast.NodeParagraph == newBlocks[0].Type && 0 < len(t.Context.parseKramdownIALInListItem(newBlocks[0].Tokens)) {
+		// 只包含 IAL 的段落会被应用到前一个块上，而前一个块不在区间内
+		return t.reparseAll(source), nil
+	}
+
+	// 先对新的块进行行级解析，以便和旧块比较行级结构
+	t.Context.sourceMaps = rt.Context.sourceMaps
+	for _, n := range newBlocks {
+		t.walkParseInline(n)
+		if next := n.Next; nil != next && isInlineFootnotesDefBlock(next) {
+			// 新增的行级脚注会影响全文脚注编号
+			t.Context.sourceMaps = nil
+			return t.reparseAll(source), nil
+		}
+	}
+	t.Context.sourceMaps = nil
+	// 行级解析时可能会移除块，比如只包含 IAL 的段落
+	newBlocks = newBlocks[:0]
+	for n := rt.Root.FirstChild; nil != n; n = n.Next {
+		newBlocks = append(newBlocks, n)
+	}
+
+	// 跳过前后内容和位置都没有变化的块
+	prefix := 0
+	for ; prefix < len(oldBlocks) && prefix < len(newBlocks) && t.sameBlock(source, oldBlocks, newBlocks, prefix, prefix, 0, 0, blocks[end:]); prefix++ {
+	}
+	if end == len(blocks) && len(oldBlocks) > prefix && len(newBlocks) > prefix {
+		// 解析到了末尾，使用最后一个块计算行号偏移量
+		lineDelta = newBlocks[len(newBlocks)-1].SourcePos.StartLine - oldBlocks[len(oldBlocks)-1].SourcePos.StartLine
+	}
+	suffix := 0
+	for ; suffix < len(oldBlocks)-prefix && suffix < len(newBlocks)-prefix &&
+		t.sameBlock(source, oldBlocks, newBlocks, len(oldBlocks)-1-suffix, len(newBlocks)-1-suffix, delta, lineDelta, blocks[end:]); suffix++ {
+	}
+
+	var next *ast.Node
+	if 0 < suffix {
+		next = oldBlocks[len(oldBlocks)-suffix]
+	} else if end < len(blocks) {
+		next = blocks[end]
+	} else {
+		next = tail
+	}
+	nodes = newBlocks[prefix : len(newBlocks)-suffix]
+	for _, n := range nodes {
+		n.Unlink()
+		if nil != next {
+			next.InsertBefore(n)
+		} else {
+			t.Root.AppendChild(n)
+		}
+	}
+	for _, n := range oldBlocks[prefix : len(oldBlocks)-suffix] {
+		n.Unlink()
+	}
+
+	// 修正后续节点的位置
+	if 0 != lineDelta || 0 != delta {
+		for n := next; nil != n; n = n.Next {
+			shiftSourcePos(n, lineDelta, delta)
+		}
+	}
+	if end == len(blocks) {
+		t.Root.SourcePos.EndLine = rt.Root.SourcePos.EndLine + lineShift
+		t.Root.SourcePos.EndColumn = rt.Root.SourcePos.EndColumn
+		t.Root.SourcePos.EndOffset = rt.Root.SourcePos.EndOffset + regionStart
+	} else {
+		t.Root.SourcePos.EndLine += lineDelta
+		t.Root.SourcePos.EndOffset += delta
+	}
+
+	if t.Context.ParseOption.KramdownSpanIAL {
+		t.parseKramdownSpanIAL()
+	}
+	if t.Context.ParseOption.CrossRef {
+		t.NumberCrossRefs()
+	}
+	t.source = source
+	return
+}
+
+// reparseAll 使用 source 全量解析并替换语法树。
+func (t *Tree) reparseAll(source []byte) (nodes []*ast.Node) {
+	tree := Parse(t.Name, source, t.Context.ParseOption)
+	t.Root, t.Context, t.source = tree.Root, tree.Context, tree.source
+	t.Context.Tree = t
+	if "" != tree.ID {
+		t.ID = tree.ID
+	}
+	for n := t.Root.FirstChild; nil != n; n = n.Next {
+		nodes = append(nodes, n)
+	}
+	return
+}
+
+// reparseBlocks 返回带有位置信息的顶层块以及末尾的文档 IAL 节点，存在其他没有位置信息的顶层节点（比如链接引用定义）时 ok 为 false。
+func (t *Tree) reparseBlocks() (blocks []*ast.Node, tail *ast.Node, ok bool) {
+	if nil == t.Root.SourcePos {
+		return
+	}
+
+	for n := t.Root.FirstChild; nil != n; n = n.Next {
+		if nil != n.SourcePos {
+			blocks = append(blocks, n)
+			continue
+		}
+		if nil == n.Next && ast.NodeKramdownBlockIAL == n.Type {
+			tail = n
+			continue
+		}
+		if ast.NodeLinkRefDefBlock != n.Type {
+			return
+		}
+	}
+	ok = 0 < len(blocks)
+	return
+}
+
+// hasFootnotesDef 判断语法树中是否存在脚注定义，脚注的编号依赖全文顺序。
+func (t *Tree) hasFootnotesDef() (ret bool) {
+	ast.Walk(t.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if entering && ast.NodeFootnotesDef == n.Type {
+			ret = true
+			return ast.WalkStop
+		}
+		return ast.WalkContinue
+	})
+	return
+}
+
+// hasDefs 判断 node 下是否存在链接引用定义或者脚注定义。
+func hasDefs(node *ast.Node) (ret bool) {
+	ast.Walk(node, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if entering && (ast.NodeLinkRefDefBlock == n.Type || ast.NodeFootnotesDef == n.Type) {
+			ret = true
+			return ast.WalkStop
+		}
+		return ast.WalkContinue
+	})
+	return
+}
+
+// sameBlock 判断旧块 oldBlocks[i] 和新块 newBlocks[j] 的类型、位置、原文内容（包括起始行缩进以及到下一个块之前的内容，比如紧随其后的 IAL）
+// 和行级结构是否一致，delta 和 lineDelta 为旧块位置到新原文位置的字节偏移量和行号偏移量，rest 为区间后未重新解析的旧顶层块。
+func (t *Tree) sameBlock(source []byte, oldBlocks, newBlocks []*ast.Node, i, j, delta, lineDelta int, rest []*ast.Node) bool {
+	o, n := oldBlocks[i].SourcePos, newBlocks[j].SourcePos
+	if oldBlocks[i].Type != newBlocks[j].Type || nil == n || o.StartOffset+delta != n.StartOffset || o.StartLine+lineDelta != n.StartLine ||
+		o.StartColumn != n.StartColumn || o.EndOffset-o.StartOffset != n.EndOffset-n.StartOffset {
+		return false
+	}
+
+	oldEnd, newEnd := len(t.source), len(source)
+	if k := nextBlock(oldBlocks, i); k < len(oldBlocks) {
+		oldEnd = lineStart(oldBlocks[k])
+	} else if 0 < len(rest) {
+		oldEnd = lineStart(rest[0])
+	}
+	if k := nextBlock(newBlocks, j); k < len(newBlocks) {
+		newEnd = lineStart(newBlocks[k])
+	} else if 0 < len(rest) {
+		newEnd = lineStart(rest[0]) + len(source) - len(t.source)
+	}
+	if !bytes.Equal(t.source[lineStart(oldBlocks[i]):oldEnd], source[lineStart(newBlocks[j]):newEnd]) {
+		return false
+	}
+	// 全量解析时块的行级结构可能受到前面内容的影响，所以还需要比较新旧块的行级解析结果
+	return sameNode(oldBlocks[i], newBlocks[j], !t.Context.ParseOption.KramdownBlockIAL)
+}
+
+// nextBlock 返回 blocks[i] 之后第一个不是 IAL 的块的下标，IAL 属于它前面的块。
+func nextBlock(blocks []*ast.Node, i int) int {
+	for i++; i < len(blocks) && ast.NodeKramdownBlockIAL == blocks[i].Type; i++ {
+	}
+	return i
+}
+
+// sameNode 判断节点 a 和 b 及其子节点的类型和 Tokens 是否一致，ial 为 true 时还需要比较 kramdown IAL。
+//
+// 关闭 KramdownBlockIAL 时 IAL 不会生成单独的节点，只能通过块上的属性进行比较；打开时块上可能存在随机生成的 ID，IAL 节点已经通过原文比较过了。
+func sameNode(a, b *ast.Node, ial bool) bool {
+	if a.Type != b.Type || !bytes.Equal(a.Tokens, b.Tokens) || (ial && !bytes.Equal(IAL2Tokens(a.KramdownIAL), IAL2Tokens(b.KramdownIAL))) {
+		return false
+	}
+	ca, cb := a.FirstChild, b.FirstChild
+	for ; nil != ca && nil != cb; ca, cb = ca.Next, cb.Next {
+		if !sameNode(ca, cb, ial) {
+			return false
+		}
+	}
+	return nil == ca && nil == cb
+}
+
+// lineStart 返回块 n 起始行在原文中的偏移量。
+func lineStart(n *ast.Node) int {
+	return n.SourcePos.StartOffset - n.SourcePos.StartColumn + 1
+}
+
+// shiftSourcePos 将 n 及其所有子节点的位置移动 lines 行、offset 个字节。
+func shiftSourcePos(n *ast.Node, lines, offset int) {
+	ast.Walk(n, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if entering && nil != n.SourcePos {
+			n.SourcePos.StartLine += lines
+			n.SourcePos.EndLine += lines
+			n.SourcePos.StartOffset += offset
+			n.SourcePos.EndOffset += offset
+		}
+		return ast.WalkContinue
+	})
+}
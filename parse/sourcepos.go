@@ -0,0 +1,360 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package parse
+
+import (
+	"bytes"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/lex"
+)
+
+// sourceLine 描述了块节点 Tokens 中的一段内容在原文中的位置。
+type sourceLine struct {
+	start  int // 在 Tokens 中的起始下标
+	line   int // 行号
+	column int // 列号
+	offset int // 字节偏移量
+}
+
+// sourceMap 用于将块节点 Tokens 的下标映射回原文位置，行级节点的位置通过它计算。
+type sourceMap struct {
+	tokens []byte       // 块节点累积的 Tokens，行级节点的 Tokens 是它的子切片
+	lines  []sourceLine // 每一行在 tokens 中的起始下标及原文位置
+}
+
+// pos 返回 tokens 下标 i 对应的原文行号、列号和偏移量。
+func (sm *sourceMap) pos(i int) (line, column, offset int) {
+	seg := sm.lines[0]
+	for _, l := range sm.lines[1:] {
+		if l.start > i {
+			break
+		}
+		seg = l
+	}
+	return seg.line, seg.column + i - seg.start, seg.offset + i - seg.start
+}
+
+// index 返回 sub 在 sm.tokens 中的起始下标，sub 不是 sm.tokens 的子切片时返回 -1。
+func (sm *sourceMap) index(sub []byte) int {
+	if 1 > len(sub) || 1 > len(sm.tokens) {
+		return -1
+	}
+	i := cap(sm.tokens) - cap(sub)
+	if 0 > i || i >= len(sm.tokens) || &sm.tokens[i] != &sub[0] {
+		return -1
+	}
+	return i
+}
+
+// newSourcePos 使用 tokens 下标区间 [start, end) 构造原文位置。
+func (sm *sourceMap) newSourcePos(start, end int) (ret *ast.SourcePos) {
+	ret = &ast.SourcePos{}
+	ret.StartLine, ret.StartColumn, ret.StartOffset = sm.pos(start)
+	ret.EndLine, ret.EndColumn, ret.EndOffset = sm.pos(end - 1)
+	ret.EndOffset++
+	return
+}
+
+// nextSourceLine 在开始处理第 num 行（起始偏移量为 offset）的内容 line 前记录该行的位置信息。
+func (context *Context) nextSourceLine(num, offset int, line []byte) {
+	context.prevLineNum, context.prevLineOffset, context.prevLineLen = context.lineNum, context.lineOffset, context.lineLen
+	context.lineNum = num
+	context.lineOffset = offset
+	context.lineLen = len(line)
+	if 0 < context.lineLen && lex.ItemNewline == line[context.lineLen-1] {
+		context.lineLen--
+	}
+}
+
+// sourcePosStart 将 node 的起始位置设置为当前行的下一个非空字符处。
+func (context *Context) sourcePosStart(node *ast.Node) {
+	node.SourcePos = &ast.SourcePos{
+		StartLine:   context.lineNum,
+		StartColumn: context.nextNonspace + 1,
+		StartOffset: context.lineOffset + context.nextNonspace,
+	}
+	context.sourcePosEnd(node, false)
+}
+
+// sourcePosEnd 将 node 的结束位置设置为当前行（previous 为 true 时为上一行）的行尾。
+func (context *Context) sourcePosEnd(node *ast.Node, previous bool) {
+	pos := node.SourcePos
+	if nil == pos {
+		return
+	}
+
+	if previous {
+		if context.prevLineNum < pos.StartLine {
+			// 在当前行开始并结束的节点不需要回退到上一行
+			return
+		}
+		pos.EndLine, pos.EndColumn, pos.EndOffset = context.prevLineNum, context.prevLineLen, context.prevLineOffset+context.prevLineLen
+	} else {
+		pos.EndLine, pos.EndColumn, pos.EndOffset = context.lineNum, context.lineLen, context.lineOffset+context.lineLen
+	}
+
+	switch node.Type {
+	case ast.NodeList, ast.NodeListItem, ast.NodeDefinitionList, ast.NodeDefinitionDesc:
+		// 列表和列表项不包含结尾的空行
+		if last := node.LastChild; nil != last && nil != last.SourcePos && last.SourcePos.EndLine < pos.EndLine {
+			pos.EndLine, pos.EndColumn, pos.EndOffset = last.SourcePos.EndLine, last.SourcePos.EndColumn, last.SourcePos.EndOffset
+		}
+	}
+}
+
+// finalizePrevious 最终化 block，block 在当前行之前就已经结束。
+func (context *Context) finalizePrevious(block *ast.Node) {
+	context.closingPrevious = true
+	context.finalize(block)
+	context.closingPrevious = false
+}
+
+// addSourceLine 记录末梢节点 context.Tip 新添加的一行内容在原文中的位置，start 为该行内容在 Tokens 中的起始下标，
+// offset 为该行内容在当前行中的起始下标。
+func (context *Context) addSourceLine(start, offset int) {
+	tip := context.Tip
+	if ast.NodeParagraph != tip.Type {
+		return
+	}
+
+	if nil == context.sourceMaps {
+		context.sourceMaps = map[*ast.Node]*sourceMap{}
+	}
+	sm := context.sourceMaps[tip]
+	if nil == sm {
+		sm = &sourceMap{}
+		context.sourceMaps[tip] = sm
+	}
+	sm.tokens = tip.Tokens
+	sm.lines = append(sm.lines, sourceLine{start: start, line: context.lineNum, column: offset + 1, offset: context.lineOffset + offset})
+}
+
+// setSourceMap 将 node 的 Tokens 映射到当前行 column 列开始的原文内容上。
+func (context *Context) setSourceMap(node *ast.Node, column int) {
+	if nil == context.sourceMaps {
+		context.sourceMaps = map[*ast.Node]*sourceMap{}
+	}
+	context.sourceMaps[node] = &sourceMap{
+		tokens: node.Tokens,
+		lines:  []sourceLine{{line: context.lineNum, column: column, offset: context.lineOffset + column - 1}},
+	}
+}
+
+// paragraphSourcePos 在段落 p 最终化后计算由段落转换而来的表格各行和单元格的位置。
+func (context *Context) paragraphSourcePos(p *ast.Node, insertTable bool) {
+	sm := context.sourceMaps[p]
+	if nil == sm {
+		return
+	}
+
+	var table *ast.Node
+	if insertTable {
+		table = p.Next
+	} else if ast.NodeTable == p.Type {
+		table = p
+	}
+	if nil == table {
+		return
+	}
+
+	start := sm.index(table.Tokens)
+	if 0 > start {
+		if table != p {
+			return
+		}
+		start = 0
+	}
+	if table != p && 1 < start {
+		// 段落后半部分是表格，段落在表格前一行结束
+		table.SourcePos = sm.newSourcePos(start, start+1)
+		table.SourcePos.EndLine, table.SourcePos.EndColumn, table.SourcePos.EndOffset = p.SourcePos.EndLine, p.SourcePos.EndColumn, p.SourcePos.EndOffset
+		p.SourcePos.EndLine, p.SourcePos.EndColumn, p.SourcePos.EndOffset = sm.pos(start - 2)
+		p.SourcePos.EndOffset++
+	}
+
+	// 表头行、分隔符行和数据行依次对应原文中的一行
+	var rows []*ast.Node
+	for row := table.FirstChild; nil != row; row = row.Next {
+		if ast.NodeTableHead == row.Type {
+			for tr := row.FirstChild; nil != tr; tr = tr.Next {
+				rows = append(rows, tr)
+			}
+			rows = append(rows, nil) // 分隔符行
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	lineStart := start
+	for _, row := range rows {
+		lineEnd := lineStart
+		for ; lineEnd < len(sm.tokens) && lex.ItemNewline != sm.tokens[lineEnd]; lineEnd++ {
+		}
+		if nil != row && lineStart < lineEnd {
+			context.tableRowSourcePos(row, sm, lineStart, lineEnd)
+		}
+		for nil != row && context.extendedTable() && nil != tableRowContinued(lex.TrimWhitespace(sm.tokens[lineStart:lineEnd])) && lineEnd+1 < len(sm.tokens) {
+			// 扩展表格续行，表格行在最后一个续行结束
+			lineStart = lineEnd + 1
+			for lineEnd = lineStart; lineEnd < len(sm.tokens) && lex.ItemNewline != sm.tokens[lineEnd]; lineEnd++ {
+			}
+			if nil != row.SourcePos && lineStart < lineEnd {
+				end := sm.newSourcePos(lineStart, lineEnd)
+				row.SourcePos.EndLine, row.SourcePos.EndColumn, row.SourcePos.EndOffset = end.EndLine, end.EndColumn, end.EndOffset
+			}
+		}
+		lineStart = lineEnd + 1
+		if lineStart >= len(sm.tokens) {
+			break
+		}
+	}
+	if head := table.FirstChild; nil != head && ast.NodeTableHead == head.Type && nil != head.FirstChild && nil != head.FirstChild.SourcePos {
+		head.SourcePos = &ast.SourcePos{}
+		*head.SourcePos = *head.FirstChild.SourcePos
+		head.SourcePos.EndLine, head.SourcePos.EndColumn, head.SourcePos.EndOffset = head.LastChild.SourcePos.EndLine, head.LastChild.SourcePos.EndColumn, head.LastChild.SourcePos.EndOffset
+	}
+}
+
+// tableRowSourcePos 计算表格行 row 及其单元格的位置，[start, end) 为该行在 sm.tokens 中的下标区间。
+func (context *Context) tableRowSourcePos(row *ast.Node, sm *sourceMap, start, end int) {
+	line := sm.tokens[start:end]
+	left, right := 0, len(line)
+	for ; left < right && lex.IsWhitespace(line[left]); left++ {
+	}
+	for ; right > left && lex.IsWhitespace(line[right-1]); right-- {
+	}
+	if left >= right {
+		return
+	}
+	row.SourcePos = sm.newSourcePos(start+left, start+right)
+
+	// 按未转义的 | 切分单元格，和 parseTableRow 保持一致
+	var spans [][2]int
+	cellStart := left
+	for i := left; i < right; i++ {
+		if lex.ItemPipe == line[i] && !lex.IsBackslashEscapePunct(line, i) {
+			spans = append(spans, [2]int{cellStart, i})
+			cellStart = i + 1
+		}
+	}
+	spans = append(spans, [2]int{cellStart, right})
+	if 0 < len(spans) && spans[0][0] == spans[0][1] {
+		spans = spans[1:]
+	}
+	if 0 < len(spans) && spans[len(spans)-1][0] == spans[len(spans)-1][1] {
+		spans = spans[:len(spans)-1]
+	}
+
+	cell := row.FirstChild
+	for _, span := range spans {
+		if nil == cell {
+			break
+		}
+		if ast.NodeTableCell != cell.Type {
+			cell = cell.Next
+			continue
+		}
+		s, e := span[0], span[1]
+		for ; s < e && lex.IsWhitespace(line[s]); s++ {
+		}
+		for ; e > s && lex.IsWhitespace(line[e-1]); e-- {
+		}
+		if s < e {
+			cell.SourcePos = sm.newSourcePos(start+s, start+e)
+			if 0 < len(cell.Tokens) && !bytes.Contains(cell.Tokens, []byte{lex.ItemNewline}) {
+				// 单元格 Tokens 是原文的拷贝，这里为它单独建立映射，合并续行后的多行单元格不建立映射
+				context.sourceMaps[cell] = &sourceMap{
+					tokens: cell.Tokens,
+					lines:  []sourceLine{{line: cell.SourcePos.StartLine, column: cell.SourcePos.StartColumn, offset: cell.SourcePos.StartOffset}},
+				}
+			}
+		}
+		cell = cell.Next
+	}
+}
+
+// inlineSourcePos 计算块节点 block 下所有行级节点的位置，ctx 为 block 的行级解析上下文。
+func (t *Tree) inlineSourcePos(block *ast.Node, ctx *InlineContext) {
+	sm := t.Context.sourceMaps[block]
+	if nil == sm {
+		return
+	}
+
+	// 解析时记录的区间可以覆盖子节点 Tokens 不是原文子切片的情况（比如链接的结尾括号）
+	parsed := map[*ast.Node][2]int{}
+	if base := sm.index(ctx.tokens); 0 <= base {
+		for n, span := range ctx.spans {
+			parsed[n] = [2]int{base + span[0], base + span[1]}
+		}
+	}
+
+	spans := map[*ast.Node][2]int{} // 节点在 sm.tokens 中的下标区间
+	ast.Walk(block, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if entering {
+			return ast.WalkContinue
+		}
+
+		// Tokens 经过转换（比如代码中的换行替换为空格）的子节点使用前后兄弟节点之间的区间
+		for c := n.FirstChild; nil != c; c = c.Next {
+			if _, ok := spans[c]; ok || nil == c.Previous || nil == c.Next {
+				continue
+			}
+			prev, okPrev := spans[c.Previous]
+			next, okNext := spans[c.Next]
+			if okPrev && okNext && prev[1] < next[0] {
+				spans[c] = [2]int{prev[1], next[0]}
+				c.SourcePos = sm.newSourcePos(prev[1], next[0])
+			}
+		}
+		if n == block {
+			return ast.WalkContinue
+		}
+
+		if i := sm.index(n.Tokens); 0 <= i {
+			spans[n] = [2]int{i, i + len(n.Tokens)}
+			n.SourcePos = sm.newSourcePos(i, i+len(n.Tokens))
+			return ast.WalkContinue
+		}
+
+		// 没有 Tokens 的节点（比如强调、链接）使用子节点的位置范围
+		start, end := -1, -1
+		for c := n.FirstChild; nil != c; c = c.Next {
+			if span, ok := spans[c]; ok {
+				if 0 > start {
+					start = span[0]
+				}
+				end = span[1]
+			}
+		}
+		if span, ok := parsed[n]; ok {
+			if 0 > start || span[0] < start {
+				start = span[0]
+			}
+			if span[1] > end {
+				end = span[1]
+			}
+		}
+		if 0 <= start && start < end {
+			spans[n] = [2]int{start, end}
+			n.SourcePos = sm.newSourcePos(start, end)
+		}
+		return ast.WalkContinue
+	})
+}
+
+// mergeSourcePos 将 next 的结束位置合并到 n 上。
+func mergeSourcePos(n, next *ast.Node) {
+	if nil == n.SourcePos || nil == next.SourcePos {
+		return
+	}
+	n.SourcePos.EndLine, n.SourcePos.EndColumn, n.SourcePos.EndOffset = next.SourcePos.EndLine, next.SourcePos.EndColumn, next.SourcePos.EndOffset
+}
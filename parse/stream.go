@@ -0,0 +1,414 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package parse
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/lex"
+)
+
+// Stream 描述了流式解析器。它从 io.Reader 中逐行读取 Markdown 进行块级解析，每当一个顶层块闭合（后面已经开始了新的顶层块或者
+// 读取到了输入结尾）时对其进行行级解析并通过 Next 产出，调用方渲染后该块会在下一次调用 Next 时从语法树上释放，所以内存占用只和
+// 单个顶层块的大小相关，而不是和整个文档的大小相关。
+//
+// 链接引用定义和脚注定义会影响全文的行级解析结果，并且可能出现在引用之后，流式解析采用如下延迟解析策略：
+//  1. 链接引用定义块和脚注定义块（包括嵌套在其他块中的定义）会一直保留在语法树上，直到解析结束，用于解析后续的引用
+//  2. 对顶层块进行行级解析时记录没有找到定义的引用标签（比如 [foo]、[text][foo] 和 [^foo]），如果存在这样的标签，则丢弃行级
+//     解析结果并使用缓存的原文重新进行块级解析，暂缓产出该块以及其后的所有块（保持输出顺序）
+//  3. 继续读取输入，直到这些标签全部有了定义或者读取到了输入结尾，然后重新对该块进行行级解析并产出
+//
+// 如果引用的标签始终没有定义（比如文本中的 array[0]），该块及其后的所有块会一直缓存到输入结尾，此时退化为全量解析的内存占用。
+// 脚注定义按照在原文中出现的顺序编号，和全量解析的结果一致；依赖全文的功能（比如目录 [toc]）只能看到尚未释放的块。
+type Stream struct {
+	Tree *Tree // 流式解析使用的语法树，根节点下仅包含尚未释放的顶层块以及保留的定义块
+
+	lines           []streamLine      // 尚未释放的顶层块的原文行
+	done            *ast.Node         // 已经处理过的最后一个顶层节点，为 nil 时表示从根节点的第一个子节点开始处理
+	emitted         *ast.Node         // 上一次产出的顶层块，下一次调用 Next 时释放
+	unresolved      [][]byte          // 行级解析时没有找到定义的引用标签
+	footnotesRefs   []*ast.Node       // 行级解析时添加了引用的脚注定义，丢弃解析结果时需要撤销
+	waiting         [][]byte          // 暂缓产出的块所等待的引用标签
+	inlineFootnotes map[*ast.Node]int // 尚未进行行级解析的顶层块中的行级脚注数量
+	eof             bool              // 是否已经读取到了输入结尾
+}
+
+// streamLine 描述了流式解析时缓存的一行原文。
+type streamLine struct {
+	num    int    // 行号
+	offset int    // 在原文中的起始偏移量
+	tokens []byte // 内容
+}
+
+// NewStream 创建一个从 reader 中读取 Markdown 的流式解析器。
+func NewStream(name string, reader io.Reader, options *Options) (ret *Stream) {
+	// 需要通过块的起始行号找到块对应的原文，所以总是记录源码位置
+	opts := *options
+	opts.SourcePos = true
+	ret = &Stream{Tree: &Tree{Name: name, Context: &Context{ParseOption: &opts}}}
+	ret.Tree.Context.Tree = ret.Tree
+	ret.Tree.Context.stream = ret
+	ret.Tree.lexer = lex.NewReaderLexer(reader)
+	ret.Tree.Root = &ast.Node{Type: ast.NodeDocument, SourcePos: &ast.SourcePos{StartLine: 1, StartColumn: 1}}
+	ret.Tree.Context.Tip = ret.Tree.Root
+	return
+}
+
+// Next 返回下一个已经完成解析的顶层块，所有块都产出后返回 io.EOF。返回的块在下一次调用 Next 之前保持挂在 Tree 上，
+// 以便渲染时访问其父节点和兄弟节点。
+func (s *Stream) Next() (block *ast.Node, err error) {
+	s.release()
+	for {
+		head := s.head()
+		if nil == head || !s.ready(head) {
+			if s.eof {
+				if err = s.Tree.lexer.Err(); nil == err {
+					err = io.EOF
+				}
+				return nil, err
+			}
+			s.readLine()
+			continue
+		}
+
+		if !s.ialsReady(head) {
+			s.readLine()
+			continue
+		}
+
+		if ast.NodeLinkRefDefBlock == head.Type {
+			// 链接引用定义块不需要行级解析，脚注定义块的内容中可能存在引用，和其他块一样进行处理
+			s.done = head
+			return head, nil
+		}
+
+		if 0 < len(s.waiting) && !s.eof && !s.resolved(s.waiting) {
+			s.readLine()
+			continue
+		}
+
+		s.unresolved, s.footnotesRefs = nil, nil
+		s.Tree.walkParseInline(head)
+		if nil == head.Parent {
+			// 空段落会在行级解析时被移除
+			s.dropSourceMaps(head)
+			continue
+		}
+		if 0 < len(s.unresolved) && !s.eof && nil != head.SourcePos {
+			// 引用的定义可能出现在后面，重新进行块级解析后暂缓产出
+			s.waiting = s.unresolved
+			for i := len(s.footnotesRefs) - 1; 0 <= i; i-- {
+				// 撤销丢弃的行级解析结果对脚注定义的引用计数
+				def := s.footnotesRefs[i]
+				def.FootnotesRefs = def.FootnotesRefs[:len(def.FootnotesRefs)-1]
+			}
+			if next := head.Next; nil != next && isInlineFootnotesDefBlock(next) {
+				// 丢弃行级脚注生成的匿名脚注定义，重新进行行级解析时会再次生成
+				s.dropSourceMaps(next)
+				next.Unlink()
+			}
+			s.reparse(head)
+			continue
+		}
+		s.waiting = nil
+		s.dropSourceMaps(head)
+		if s.Tree.Context.ParseOption.Footnotes && s.Tree.Context.ParseOption.InlineFootnotes {
+			s.refreshFootnotesRefIds(head)
+		}
+		for next := head.Next; nil != next && s.isIALParagraph(next); next = head.Next {
+			// 和全量解析一样将 IAL 应用到该块上并移除段落
+			s.Tree.walkParseInline(next)
+			s.dropSourceMaps(next)
+		}
+
+		if s.Tree.Context.ParseOption.KramdownSpanIAL {
+			s.Tree.parseKramdownSpanIAL()
+		}
+		if s.Tree.Context.ParseOption.KramdownBlockIAL {
+			s.Tree.parseBlockIAL(head)
+		}
+		if isDefBlock(head) {
+			s.done = head
+		} else {
+			s.emitted = head
+		}
+		return head, nil
+	}
+}
+
+// head 返回下一个待处理的顶层节点。
+func (s *Stream) head() *ast.Node {
+	if nil == s.done {
+		return s.Tree.Root.FirstChild
+	}
+	return s.done.Next
+}
+
+// ready 判断顶层节点 n 是否已经闭合：后面已经开始了新的顶层块或者已经读取到了输入结尾。
+func (s *Stream) ready(n *ast.Node) bool {
+	return s.eof || nil != s.nextPositioned(n)
+}
+
+// nextPositioned 返回 n 后面第一个带有位置信息的兄弟节点。
+func (s *Stream) nextPositioned(n *ast.Node) *ast.Node {
+	for next := n.Next; nil != next; next = next.Next {
+		if nil != next.SourcePos {
+			return next
+		}
+	}
+	return nil
+}
+
+// readLine 读取并解析下一行，读取到输入结尾时最终化所有未闭合的块。
+func (s *Stream) readLine() {
+	lexer := s.Tree.lexer
+	line := lexer.NextLine()
+	if nil != line {
+		s.lines = append(s.lines, streamLine{num: lexer.Line(), offset: lexer.LineOffset(), tokens: append([]byte{}, line...)})
+		if s.Tree.parseBlockLine(line) {
+			return
+		}
+	}
+
+	for nil != s.Tree.Context.Tip {
+		s.Tree.Context.finalize(s.Tree.Context.Tip)
+	}
+	s.eof = true
+}
+
+// resolved 判断 labels 是否都已经有了定义。
+func (s *Stream) resolved(labels [][]byte) bool {
+	for _, label := range labels {
+		if s.Tree.Context.ParseOption.Footnotes {
+			if _, def := s.Tree.FindFootnotesDef(label); nil != def {
+				continue
+			}
+		}
+		if nil == s.Tree.FindLinkRefDefLink(label) {
+			return false
+		}
+	}
+	return true
+}
+
+// blockParse 使用缓存的原文对顶层块 n 进行块级解析，返回一棵新的语法树。
+func (s *Stream) blockParse(n *ast.Node) (t *Tree) {
+	end := -1
+	if next := s.nextPositioned(n); nil != next {
+		end = next.SourcePos.StartLine
+	}
+
+	t = &Tree{Name: s.Tree.Name, Context: &Context{ParseOption: s.Tree.Context.ParseOption}}
+	t.Context.Tree = t
+	t.Root = &ast.Node{Type: ast.NodeDocument}
+	t.Context.Tip = t.Root
+	for _, line := range s.lines {
+		if line.num < n.SourcePos.StartLine {
+			continue
+		}
+		if -1 != end && line.num >= end {
+			break
+		}
+		t.parseLine(append([]byte{}, line.tokens...), line.num, line.offset)
+	}
+	for nil != t.Context.Tip {
+		t.Context.finalize(t.Context.Tip)
+	}
+	return
+}
+
+// reparse 使用缓存的原文重新对顶层块 n 进行块级解析，并使用解析结果替换 n。
+func (s *Stream) reparse(n *ast.Node) {
+	t := s.blockParse(n)
+	if nil == s.Tree.Context.sourceMaps {
+		s.Tree.Context.sourceMaps = map[*ast.Node]*sourceMap{}
+	}
+	for node, sm := range t.Context.sourceMaps {
+		s.Tree.Context.sourceMaps[node] = sm
+	}
+	// 保留之前的块中对脚注定义的引用
+	var oldDefs, newDefs []*ast.Node
+	collectFootnotesDefs(n, &oldDefs)
+	collectFootnotesDefs(t.Root, &newDefs)
+	for i := 0; i < len(oldDefs) && i < len(newDefs); i++ {
+		newDefs[i].FootnotesRefs = oldDefs[i].FootnotesRefs
+	}
+
+	for child := t.Root.FirstChild; nil != child; {
+		next := child.Next
+		if !isDefBlock(child) || (n.Type == child.Type && nil != child.SourcePos && n.SourcePos.StartLine == child.SourcePos.StartLine) {
+			// 其他定义块在之前的解析中已经保留在语法树上了
+			n.InsertBefore(child)
+		}
+		child = next
+	}
+	s.dropSourceMaps(n)
+	n.Unlink()
+}
+
+// refreshFootnotesRefIds 重新计算顶层块 head 中引用脚注的 id。head 产出时后面的块还没有进行行级解析，它们中的行级脚注生成的匿名定义
+// 会排在后面的脚注定义前面，所以引用后面的脚注定义时需要加上这些行级脚注的数量，和全量解析的编号保持一致。
+func (s *Stream) refreshFootnotesRefIds(head *ast.Node) {
+	s.Tree.refreshFootnotesRefIds(head)
+	ast.Walk(head, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if !entering || ast.NodeFootnotesRef != n.Type || n.FootnotesInline {
+			return ast.WalkContinue
+		}
+		_, def := s.Tree.FindFootnotesDef(n.Tokens)
+		if nil == def {
+			return ast.WalkContinue
+		}
+		if pending := s.pendingInlineFootnotes(head, def); 0 < pending {
+			id := strings.SplitN(n.FootnotesRefId, ":", 2)
+			idx, _ := strconv.Atoi(id[0])
+			id[0] = strconv.Itoa(idx + pending)
+			n.FootnotesRefId = strings.Join(id, ":")
+		}
+		return ast.WalkContinue
+	})
+}
+
+// pendingInlineFootnotes 返回顶层块 head 和脚注定义 def 之间尚未进行行级解析的块中的行级脚注数量，def 在 head 前面时返回 0。
+func (s *Stream) pendingInlineFootnotes(head, def *ast.Node) (ret int) {
+	top := def
+	for nil != top.Parent && s.Tree.Root != top.Parent {
+		top = top.Parent
+	}
+	var blocks []*ast.Node
+	for n := head.Next; n != top; n = n.Next {
+		if nil == n {
+			return 0
+		}
+		if nil != n.SourcePos && ast.NodeLinkRefDefBlock != n.Type {
+			blocks = append(blocks, n)
+		}
+	}
+
+	for _, n := range blocks {
+		count, ok := s.inlineFootnotes[n]
+		if !ok {
+			if bytes.Contains(s.source(n), []byte("^[")) {
+				// 使用缓存的原文在一棵临时的语法树上进行行级解析，统计其中的行级脚注数量
+				t := s.blockParse(n)
+				t.walkParseInline(t.Root)
+				count = t.Context.inlineFootnotes
+			}
+			if nil == s.inlineFootnotes {
+				s.inlineFootnotes = map[*ast.Node]int{}
+			}
+			s.inlineFootnotes[n] = count
+		}
+		ret += count
+	}
+	return
+}
+
+// source 返回顶层块 n 缓存的原文。
+func (s *Stream) source(n *ast.Node) (ret []byte) {
+	end := -1
+	if next := s.nextPositioned(n); nil != next {
+		end = next.SourcePos.StartLine
+	}
+	for _, line := range s.lines {
+		if line.num >= n.SourcePos.StartLine && (-1 == end || line.num < end) {
+			ret = append(ret, line.tokens...)
+		}
+	}
+	return
+}
+
+// release 从语法树上释放上一次产出的块，其中嵌套的定义块会被移到根节点下保留。
+func (s *Stream) release() {
+	if nil == s.emitted {
+		return
+	}
+
+	var defs []*ast.Node
+	ast.Walk(s.emitted, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if !entering {
+			return ast.WalkContinue
+		}
+		if isDefBlock(n) {
+			defs = append(defs, n)
+			return ast.WalkSkipChildren
+		}
+		return ast.WalkContinue
+	})
+	for _, def := range defs {
+		s.emitted.InsertBefore(def)
+	}
+	s.done = s.emitted.Previous
+	s.emitted.Unlink()
+	s.emitted = nil
+
+	// 释放已经不再需要的原文行
+	i := len(s.lines)
+	for n := s.head(); nil != n; n = n.Next {
+		if nil != n.SourcePos {
+			for i = 0; i < len(s.lines) && s.lines[i].num < n.SourcePos.StartLine; i++ {
+			}
+			break
+		}
+	}
+	s.lines = append(s.lines[:0], s.lines[i:]...)
+}
+
+// dropSourceMaps 删除 n 及其子节点的位置映射以及 n 缓存的行级脚注数量。
+func (s *Stream) dropSourceMaps(n *ast.Node) {
+	delete(s.inlineFootnotes, n)
+	if nil == s.Tree.Context.sourceMaps {
+		return
+	}
+	ast.Walk(n, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if entering {
+			delete(s.Tree.Context.sourceMaps, n)
+		}
+		return ast.WalkContinue
+	})
+}
+
+// collectFootnotesDefs 按照先序遍历顺序收集 n 中的脚注定义。
+func collectFootnotesDefs(n *ast.Node, defs *[]*ast.Node) {
+	ast.Walk(n, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if entering && ast.NodeFootnotesDef == n.Type {
+			*defs = append(*defs, n)
+		}
+		return ast.WalkContinue
+	})
+}
+
+// ialsReady 判断顶层节点 n 后面紧跟的 IAL 段落是否都已经闭合。行级解析时以 IAL {: attrs} 开头的段落会被应用到前一个块上并移除，
+// 所以需要等到这些段落闭合后才能产出 n。以 [ 开头的段落闭合时可能会移除开头的链接引用定义，剩下的内容也可能是 IAL，同样需要等待。
+func (s *Stream) ialsReady(n *ast.Node) bool {
+	for next := s.nextPositioned(n); nil != next && ast.NodeParagraph == next.Type && (bytes.HasPrefix(next.Tokens, []byte("{:")) || bytes.HasPrefix(next.Tokens, []byte("["))); next = s.nextPositioned(next) {
+		if !s.ready(next) {
+			return false
+		}
+		if !s.isIALParagraph(next) {
+			break
+		}
+	}
+	return true
+}
+
+// isIALParagraph 判断 n 是否是以 IAL {: attrs} 开头的段落。
+func (s *Stream) isIALParagraph(n *ast.Node) bool {
+	return ast.NodeParagraph == n.Type && 0 < len(s.Tree.Context.parseKramdownIALInListItem(n.Tokens))
+}
+
+// isDefBlock 判断 n 是否是链接引用定义块或者脚注定义块。
+func isDefBlock(n *ast.Node) bool {
+	return ast.NodeLinkRefDefBlock == n.Type || ast.NodeFootnotesDefBlock == n.Type
+}
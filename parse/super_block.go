@@ -56,7 +56,7 @@ func (context *Context) superBlockFinalize(superBlock *ast.Node) {
 		if child.Close {
 			continue
 		}
-		context.finalize(child)
+		context.finalizePrevious(child)
 	}
 }
 
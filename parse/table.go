@@ -134,6 +134,19 @@ func (context *Context) parseTable0(tokens []byte) (ret *ast.Node) {
 		return
 	}
 
+	var label, caption []byte
+	if context.ParseOption.CrossRef && 2 < length {
+		// 表格最后一行 {#tbl:label} 是交叉引用标签
+		if label = crossRefLabel(lines[length-1]); nil != label {
+			length--
+		}
+	}
+	if context.extendedTable() && 2 < length {
+		if caption = tableCaption(lex.TrimWhitespace(lines[length-1])); nil != caption {
+			length--
+		}
+	}
+
 	if 2 == length && 1 == len(aligns) && 0 == aligns[0] && !bytes.Contains(tokens, []byte("|")) {
 		// 如果只有两行并且对齐方式是默认对齐且没有 | 时（foo\n---）就和 Setext 标题规则冲突了
 		// 但在块级解析时显然已经尝试进行解析 Setext 标题，还能走到这里说明 Setetxt 标题解析失败，
@@ -168,11 +181,31 @@ func (context *Context) parseTable0(tokens []byte) (ret *ast.Node) {
 	ret.TableAligns = aligns
 	ret.AppendChild(context.newTableHead([]*ast.Node{headRow}))
 	for i := 2; i < length; i++ {
-		line := lex.TrimWhitespace(lines[i])
-		tableRow := context.parseTableRow(line, aligns, false)
+		rowLines := [][]byte{lex.TrimWhitespace(lines[i])}
+		for context.extendedTable() {
+			// 扩展表格中以 \ 结尾的行在下一行继续
+			last := len(rowLines) - 1
+			continued := tableRowContinued(rowLines[last])
+			if nil == continued {
+				break
+			}
+			rowLines[last] = continued
+			if i+1 >= length {
+				break
+			}
+			i++
+			rowLines = append(rowLines, lex.TrimWhitespace(lines[i]))
+		}
+
+		tableRow := context.parseTableRow(rowLines[0], aligns, false)
 		if nil == tableRow {
 			return
 		}
+		for _, rowLine := range rowLines[1:] {
+			if nextRow := context.parseTableRow(rowLine, aligns, false); nil != nextRow {
+				mergeTableRow(tableRow, nextRow)
+			}
+		}
 		if context.ParseOption.KramdownSpanIAL {
 			for th := tableRow.FirstChild; nil != th; th = th.Next {
 				ialStart := bytes.LastIndex(th.Tokens, []byte("{:"))
@@ -192,6 +225,11 @@ func (context *Context) parseTable0(tokens []byte) (ret *ast.Node) {
 		}
 		ret.AppendChild(tableRow)
 	}
+	if context.extendedTable() {
+		tableRowspans(ret)
+		ret.TableCaption = string(caption)
+	}
+	ret.CrossRefLabel = string(label)
 	return
 }
 
@@ -218,7 +256,8 @@ func (context *Context) parseTableRow(line []byte, aligns []int, isHead bool) (r
 	if lex.IsBlank(cols[0]) {
 		cols = cols[1:]
 	}
-	if len(cols) > 0 && lex.IsBlank(cols[len(cols)-1]) {
+	ext := context.extendedTable()
+	if len(cols) > 0 && lex.IsBlank(cols[len(cols)-1]) && (!ext || 0 < len(cols[len(cols)-1])) { // 扩展表格中结尾的 || 表示跨列
 		cols = cols[:len(cols)-1]
 	}
 
@@ -230,9 +269,26 @@ func (context *Context) parseTableRow(line []byte, aligns []int, isHead bool) (r
 
 	var i int
 	var col []byte
+	var spanCell *ast.Node // 扩展表格中可以通过 || 向右跨列的单元格
 	for ; i < colsLen && i < alignsLen; i++ {
 		col = lex.TrimWhitespace(cols[i])
 		cell := &ast.Node{Type: ast.NodeTableCell, TableCellAlign: aligns[i]}
+		if ext {
+			if 0 == len(cols[i]) && nil != spanCell {
+				cell.TableCellMerged = 1
+				if 1 > spanCell.TableCellColspan {
+					spanCell.TableCellColspan = 1
+				}
+				spanCell.TableCellColspan++
+				ret.AppendChild(cell)
+				continue
+			}
+			if bytes.Equal(col, tableRowspanMarker) {
+				cell.TableCellMerged = 2
+				col = nil
+			}
+			spanCell = cell
+		}
 		cell.Tokens = col
 		ret.AppendChild(cell)
 	}
@@ -331,3 +387,138 @@ func (context *Context) tableDelimAlign(col []byte) int {
 	}
 	return 0
 }
+
+// tableRowspanMarker 是扩展表格中向上合并单元格的标记。
+var tableRowspanMarker = []byte("^^")
+
+// extendedTable 判断是否需要解析扩展表格语法，Protyle 使用 IAL 记录合并单元格，不使用扩展表格语法。
+func (context *Context) extendedTable() bool {
+	return context.ParseOption.ExtendedTable && !context.ParseOption.ProtyleWYSIWYG
+}
+
+// tableCaption 判断行 line 是否是表格标题 [Caption]，是的话返回标题内容。
+func tableCaption(line []byte) []byte {
+	length := len(line)
+	if 3 > length || lex.ItemOpenBracket != line[0] || lex.ItemCloseBracket != line[length-1] {
+		return nil
+	}
+	caption := line[1 : length-1]
+	if bytes.ContainsAny(caption, "[]") || lex.IsBlank(caption) {
+		return nil
+	}
+	return lex.TrimWhitespace(caption)
+}
+
+// tableRowContinued 判断表格行 line 是否以续行标记 |\ 结尾，是的话返回去掉续行标记后的行。
+func tableRowContinued(line []byte) []byte {
+	length := len(line)
+	if 2 > length || lex.ItemBackslash != line[length-1] {
+		return nil
+	}
+	ret := lex.TrimWhitespace(line[:length-1])
+	if 1 > len(ret) || lex.ItemPipe != ret[len(ret)-1] || lex.IsBackslashEscapePunct(ret, len(ret)-1) {
+		return nil
+	}
+	return ret
+}
+
+// mergeTableRow 将续行 next 中的单元格内容按列合并到表格行 row 中，各行内容之间使用硬换行分隔。
+// 被 || 跨列合并的列内容合并到跨列单元格中，^^ 单元格的内容暂存在该单元格上，计算跨行时再合并到上方单元格中。
+func mergeTableRow(row, next *ast.Node) {
+	var owner *ast.Node // 当前列内容所属的单元格
+	for cell, nextCell := row.FirstChild, next.FirstChild; nil != cell && nil != nextCell; cell, nextCell = cell.Next, nextCell.Next {
+		if 1 != cell.TableCellMerged {
+			owner = cell
+		}
+		if 1 > len(nextCell.Tokens) || nil == owner {
+			continue
+		}
+		appendTableCellLine(owner, nextCell.Tokens)
+	}
+}
+
+// appendTableCellLine 将 line 使用硬换行追加到单元格 cell 的内容后。
+func appendTableCellLine(cell *ast.Node, line []byte) {
+	if 1 > len(cell.Tokens) {
+		cell.Tokens = line
+		return
+	}
+	tokens := make([]byte, 0, len(cell.Tokens)+3+len(line))
+	tokens = append(tokens, cell.Tokens...)
+	if trailingBackslash(tokens) {
+		// 单元格末尾的 \ 是普通字符，需要转义，否则会和后面硬换行标记中的 \ 组成转义
+		tokens = append(tokens, lex.ItemBackslash)
+	}
+	tokens = append(tokens, lex.ItemBackslash, lex.ItemNewline)
+	cell.Tokens = append(tokens, line...)
+}
+
+// trailingBackslash 判断 tokens 是否以没有被转义的 \ 结尾。
+func trailingBackslash(tokens []byte) bool {
+	i := len(tokens)
+	for ; 0 < i && lex.ItemBackslash == tokens[i-1]; i-- {
+	}
+	return 1 == (len(tokens)-i)%2
+}
+
+// tableRowspans 计算表格 table 中 ^^ 向上合并的单元格跨行数，上方没有可以合并的单元格或者上方单元格跨列数不同时 ^^ 作为普通文本。
+func tableRowspans(table *ast.Node) {
+	var above []*ast.Node // 每列上方最近的可跨行单元格
+	for row := table.FirstChild; nil != row; row = row.Next {
+		rows := []*ast.Node{row}
+		if ast.NodeTableHead == row.Type {
+			// 表头和表体之间不能合并
+			rows, above = nil, nil
+			for tr := row.FirstChild; nil != tr; tr = tr.Next {
+				rows = append(rows, tr)
+			}
+		}
+
+		for _, tr := range rows {
+			col := 0
+			for cell := tr.FirstChild; nil != cell; cell = cell.Next {
+				if ast.NodeTableCell != cell.Type {
+					continue
+				}
+				if col >= len(above) {
+					above = append(above, nil)
+				}
+				if 2 == cell.TableCellMerged {
+					if owner := above[col]; nil != owner && 0 == owner.TableCellMerged && tableCellColspan(owner) == tableCellColspan(cell) {
+						if 1 > owner.TableCellRowspan {
+							owner.TableCellRowspan = 1
+						}
+						owner.TableCellRowspan++
+						if 0 < len(cell.Tokens) {
+							// 续行中的内容
+							appendTableCellLine(owner, cell.Tokens)
+							cell.Tokens = nil
+						}
+						col++
+						continue
+					}
+					lines := cell.Tokens
+					cell.TableCellMerged = 0
+					cell.Tokens = append([]byte{}, tableRowspanMarker...)
+					if 0 < len(lines) {
+						appendTableCellLine(cell, lines)
+					}
+				}
+				above[col] = cell
+				col++
+			}
+		}
+
+		if ast.NodeTableHead == row.Type {
+			above = nil
+		}
+	}
+}
+
+// tableCellColspan 返回单元格 cell 的跨列数。
+func tableCellColspan(cell *ast.Node) int {
+	if 1 > cell.TableCellColspan {
+		return 1
+	}
+	return cell.TableCellColspan
+}
@@ -0,0 +1,78 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+)
+
+// taskStateNames 定义了常用任务状态字符的名称，渲染时用于生成 class。
+var taskStateNames = map[byte]string{
+	' ': "todo",
+	'x': "done",
+	'X': "done",
+	'-': "cancelled",
+	'>': "deferred",
+	'?': "question",
+	'!': "important",
+}
+
+// TaskStateName 返回任务状态字符 state 的名称，比如 [-] 返回 cancelled，未定义名称的状态字符返回空字符串。
+func TaskStateName(state byte) string {
+	return taskStateNames[state]
+}
+
+// IsExtendedTaskState 判断任务状态字符 state 是否是 [ ]、[x] 和 [X] 以外的扩展状态。
+func IsExtendedTaskState(state byte) bool {
+	return ' ' != state && 'x' != state && 'X' != state
+}
+
+// isTaskListItemState 判断 token 是否是允许的任务列表项状态字符。
+func (options *Options) isTaskListItemState(token byte) bool {
+	if ' ' == token || 'x' == token || 'X' == token {
+		return true
+	}
+	return ' ' < token && 0x80 > token && ']' != token && strings.IndexByte(options.TaskListItemStates, token) > -1
+}
+
+// Task 描述了一个任务列表项。
+type Task struct {
+	State    byte      // 状态字符
+	Checked  bool      // 是否勾选
+	Text     string    // 任务文本
+	ListItem *ast.Node // 列表项节点
+}
+
+// Tasks 按文档顺序返回树上所有的任务列表项。
+func (t *Tree) Tasks() (ret []*Task) {
+	ast.Walk(t.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if !entering || ast.NodeTaskListItemMarker != n.Type {
+			return ast.WalkContinue
+		}
+
+		paragraph, listItem := n.Parent, n.Parent
+		if ast.NodeParagraph == paragraph.Type {
+			listItem = paragraph.Parent
+		} else {
+			// Protyle 中任务列表项标记位于段落前面
+			paragraph = n.Next
+		}
+		task := &Task{State: n.TaskState(), Checked: n.TaskListItemChecked, ListItem: listItem}
+		if nil != paragraph {
+			task.Text = strings.TrimSpace(paragraph.Text())
+		}
+		ret = append(ret, task)
+		return ast.WalkContinue
+	})
+	return
+}
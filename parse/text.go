@@ -30,16 +30,32 @@ func (t *Tree) parseText(ctx *InlineContext) *ast.Node {
 	return &ast.Node{Type: ast.NodeText, Tokens: ctx.tokens[start:ctx.pos]}
 }
 
+// isCaretMarker 判断 ^ 是否是潜在的标记符，上标 ^sup^ 和行级脚注 ^[text] 都以 ^ 开头。
+func (t *Tree) isCaretMarker() bool {
+	return t.Context.ParseOption.Sup || (t.Context.ParseOption.Footnotes && t.Context.ParseOption.InlineFootnotes)
+}
+
 // isMarker 判断 token 是否是潜在的 Markdown 标记符。
 func (t *Tree) isMarker(token byte) bool {
+	if t.isBuiltinMarker(token) {
+		return true
+	}
+	if 0 < len(t.Context.ParseOption.InlineSyntaxes) || 0 < len(t.Context.ParseOption.DelimiterSyntaxes) {
+		return t.Context.ParseOption.isInlineTrigger(token)
+	}
+	return false
+}
+
+// isBuiltinMarker 判断 token 是否是内置行级语法会处理的潜在标记符。
+func (t *Tree) isBuiltinMarker(token byte) bool {
 	if lex.IsMarker(token) {
 		return true
 	}
 
-	if t.Context.ParseOption.Sup && lex.ItemCaret == token {
+	if lex.ItemCaret == token && t.isCaretMarker() {
 		return true
 	}
-	return false
+	return lex.ItemAt == token && (t.Context.ParseOption.CrossRef || t.Context.ParseOption.Citation)
 }
 
 var backslash = util.StrToBytes("\\")
@@ -131,12 +147,14 @@ func (t *Tree) mergeText(node *ast.Node) {
 			// 逐个合并后续兄弟节点
 			for nil != next && ast.NodeText == next.Type {
 				child.AppendTokens(next.Tokens)
+				mergeSourcePos(child, next)
 				next.Unlink()
 				next = child.Next
 			}
 		} else if ast.NodeLinkText == child.Type {
 			for nil != next && ast.NodeLinkText == next.Type {
 				child.AppendTokens(next.Tokens)
+				mergeSourcePos(child, next)
 				next.Unlink()
 				next = child.Next
 			}
@@ -342,7 +342,7 @@ func processNestedNode(n *ast.Node, tag string, tags *[]string, unlinks *[]*ast.
 		*unlinks = append(*unlinks, n)
 		for c := n.FirstChild; nil != c; {
 			next := c.Next
-			if ast.NodeTextMark == c.Type {
+			if ast.NodeTextMark == c.Type || ast.NodeWikiLink == c.Type { // Wiki 链接不支持文本标记，直接提升
 				n.InsertBefore(c)
 			}
 			c = next
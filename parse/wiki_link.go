@@ -0,0 +1,76 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package parse
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/lex"
+	"github.com/Dofingert/lute-for-ficus/util"
+)
+
+// parseWikiLink 解析 Wiki 链接 [[target#heading|alias]]，不匹配时返回 nil 并且不移动解析位置。
+func (t *Tree) parseWikiLink(ctx *InlineContext) *ast.Node {
+	if !t.Context.ParseOption.WikiLink {
+		return nil
+	}
+
+	tokens := ctx.tokens[ctx.pos:]
+	if 5 > len(tokens) || lex.ItemOpenBracket != tokens[1] {
+		return nil
+	}
+	end := bytes.Index(tokens[2:], []byte("]]"))
+	if 1 > end {
+		return nil
+	}
+	content := tokens[2 : 2+end]
+	if bytes.ContainsAny(content, "[]\n") {
+		return nil
+	}
+
+	ret := NewWikiLink(util.BytesToStr(content))
+	if nil == ret {
+		return nil
+	}
+	ret.Tokens = tokens[:2+end+2] // 保留原文，用于计算表格单元格宽度等
+	ctx.pos += 2 + end + 2
+	return ret
+}
+
+// NewWikiLink 使用 Wiki 链接 [[ 和 ]] 之间的内容 content 构造 Wiki 链接节点，content 不合法时返回 nil。
+//
+// content 的格式为 target#heading|alias 或者 target^block|alias（也可以写作 target#^block），除 target 外都是可选的，
+// target 为空时表示当前页面。表格中的别名分隔符可以写作 \|。
+func NewWikiLink(content string) (ret *ast.Node) {
+	target, alias := content, ""
+	if i := strings.IndexByte(content, '|'); 0 <= i {
+		target, alias = content[:i], content[i+1:]
+		target = strings.TrimSuffix(target, "\\")
+	}
+
+	var heading, block string
+	if i := strings.IndexByte(target, '#'); 0 <= i {
+		target, heading = target[:i], target[i+1:]
+		if strings.HasPrefix(heading, "^") {
+			block, heading = heading[1:], ""
+		}
+	} else if i = strings.IndexByte(target, '^'); 0 <= i {
+		target, block = target[:i], target[i+1:]
+	}
+
+	target, heading, block, alias = strings.TrimSpace(target), strings.TrimSpace(heading), strings.TrimSpace(block), strings.TrimSpace(alias)
+	if "" == target && "" == heading && "" == block {
+		return nil
+	}
+	return &ast.Node{Type: ast.NodeWikiLink, WikiLinkTarget: target, WikiLinkHeading: heading, WikiLinkBlock: block, WikiLinkAlias: alias}
+}
@@ -29,6 +29,9 @@ func YamlFrontMatterStart(t *Tree, container *ast.Node) int {
 		node := &ast.Node{Type: ast.NodeYamlFrontMatter}
 		t.Root.AppendChild(node)
 		t.Context.Tip = node
+		if t.Context.ParseOption.SourcePos {
+			t.Context.sourcePosStart(node)
+		}
 		return 2
 	}
 	return 0
@@ -684,7 +684,7 @@ func (lute *Lute) genASTByBlockDOM(n *html.Node, tree *parse.Tree) {
 			tree.Context.Tip.AppendChild(&ast.Node{Type: ast.NodeCodeBlockCode, Tokens: buf.Bytes()})
 		} else if ast.NodeListItem == tree.Context.Tip.Type {
 			if 3 == tree.Context.Tip.ListData.Typ { // 任务列表
-				tree.Context.Tip.AppendChild(&ast.Node{Type: ast.NodeTaskListItemMarker, TaskListItemChecked: strings.Contains(util.DomAttrValue(n.Parent, "class"), "protyle-task--done")})
+				tree.Context.Tip.AppendChild(&ast.Node{Type: ast.NodeTaskListItemMarker, TaskListItemChecked: strings.Contains(util.DomAttrValue(n.Parent, "class"), "protyle-task--done"), TaskListItemState: lute.taskListItemState(n.Parent)})
 			}
 		}
 		return
@@ -699,7 +699,7 @@ func (lute *Lute) genASTByBlockDOM(n *html.Node, tree *parse.Tree) {
 
 	nodeID := util.DomAttrValue(n, "data-node-id")
 	node := &ast.Node{ID: nodeID}
-	if "" != node.ID && !lute.parentIs(n, atom.Table) {
+	if "" != node.ID && !lute.parentIs(n, atom.Table) && ast.NodeFencedDiv != dataType { // 围栏 div 的 KramdownIAL 保存的是 div 属性
 		node.KramdownIAL = [][]string{{"id", node.ID}}
 		ialTokens := lute.setBlockIAL(n, node)
 		ial := &ast.Node{Type: ast.NodeKramdownBlockIAL, Tokens: ialTokens}
@@ -824,6 +824,18 @@ func (lute *Lute) genASTByBlockDOM(n *html.Node, tree *parse.Tree) {
 		tree.Context.Tip.AppendChild(node)
 		tree.Context.Tip = node
 		defer tree.Context.ParentTip()
+	case ast.NodeCallout:
+		node.Type = ast.NodeCallout
+		lute.setCallout(n, node)
+		tree.Context.Tip.AppendChild(node)
+		tree.Context.Tip = node
+		defer tree.Context.ParentTip()
+	case ast.NodeFencedDiv:
+		node.Type = ast.NodeFencedDiv
+		node.KramdownIAL = parse.FencedDivAttributes(util.DomAttrValue(n, "data-fenced-div"))
+		tree.Context.Tip.AppendChild(node)
+		tree.Context.Tip = node
+		defer tree.Context.ParentTip()
 	case ast.NodeList:
 		node.Type = ast.NodeList
 		marker := util.DomAttrValue(n, "data-marker")
@@ -994,6 +1006,7 @@ func (lute *Lute) genASTByBlockDOM(n *html.Node, tree *parse.Tree) {
 		if ast.NodeListItem == tree.Context.Tip.Type && atom.Input == n.DataAtom {
 			node.Type = ast.NodeTaskListItemMarker
 			node.TaskListItemChecked = lute.hasAttr(n, "checked")
+			node.TaskListItemState = lute.taskListItemState(n)
 			tree.Context.Tip.AppendChild(node)
 			return
 		}
@@ -1349,6 +1362,37 @@ func (lute *Lute) genASTContenteditable(n *html.Node, tree *parse.Tree) {
 			node.AppendChild(&ast.Node{Type: ast.NodeCloseParen})
 			tree.Context.Tip.AppendChild(node)
 			return
+		} else if "wiki-link" == dataType {
+			node.Type = ast.NodeWikiLink
+			node.WikiLinkTarget = util.DomAttrValue(n, "data-target")
+			node.WikiLinkHeading = util.DomAttrValue(n, "data-heading")
+			node.WikiLinkBlock = util.DomAttrValue(n, "data-block")
+			node.WikiLinkAlias = util.DomAttrValue(n, "data-alias")
+			if "" == node.WikiLinkTarget && "" == node.WikiLinkHeading && "" == node.WikiLinkBlock {
+				node.Type = ast.NodeText
+				node.Tokens = util.StrToBytes(util.DomText(n))
+			}
+			tree.Context.Tip.AppendChild(node)
+			return
+		} else if "crossref" == dataType {
+			source := strings.TrimSpace(util.DomText(n))
+			if "" == source {
+				return
+			}
+			node.Type = ast.NodeCrossRef
+			node.Tokens = util.StrToBytes(source)
+			node.CrossRefLabel = strings.TrimPrefix(source, "@")
+			tree.Context.Tip.AppendChild(node)
+			return
+		} else if "citation" == dataType {
+			source := strings.TrimSpace(util.DomText(n))
+			if "" == source {
+				return
+			}
+			node.Type = ast.NodeCitation
+			node.Tokens = util.StrToBytes(source)
+			tree.Context.Tip.AppendChild(node)
+			return
 		} else if "file-annotation-ref" == dataType {
 			refText := util.DomText(n)
 			refText = strings.TrimSpace(refText)
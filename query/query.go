@@ -0,0 +1,323 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+// Package query 实现了语法树上类似 CSS 的节点选择器。
+//
+// 选择器由节点类型、属性和伪类组成，比如：
+//
+//	NodeHeading[level=2]
+//	NodeListItem > NodeCodeBlock[info=go]
+//	[custom-riff-decks]
+//	NodeParagraph:has(NodeImage), NodeBlockquote
+//
+// 支持的组合符有后代（空格）、子节点 >、紧邻兄弟节点 + 和后续兄弟节点 ~。属性运算符有 =、!=、^=、$=、*= 和 ~=，
+// 属性名 level、info 和 dest 分别对应标题级别、代码块信息和链接地址，id 对应节点 ID，其他属性名对应 kramdown IAL 属性。
+// 支持的伪类有 :has()、:not()、:first-child、:last-child、:only-child、:empty 和 :root。
+package query
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/util"
+)
+
+// Selector 描述了编译后的选择器。
+type Selector struct {
+	src  string
+	list []*complexSelector
+}
+
+// Compile 编译选择器 selector。
+func Compile(selector string) (*Selector, error) {
+	p := &selectorParser{src: selector}
+	list, err := p.parseList(false)
+	if nil != err {
+		return nil, err
+	}
+	if p.pos < len(p.src) {
+		return nil, p.errorf("unexpected character [" + string(p.src[p.pos]) + "]")
+	}
+	return &Selector{src: selector, list: list}, nil
+}
+
+// MustCompile 编译选择器 selector，选择器不合法时 panic。
+func MustCompile(selector string) *Selector {
+	ret, err := Compile(selector)
+	if nil != err {
+		panic(err)
+	}
+	return ret
+}
+
+// String 返回选择器字符串。
+func (s *Selector) String() string {
+	return s.src
+}
+
+// Match 判断节点 n 是否匹配选择器。
+func (s *Selector) Match(n *ast.Node) bool {
+	return matchList(s.list, n, nil)
+}
+
+// All 按文档顺序返回 root（包括 root）中所有匹配选择器的节点。
+func (s *Selector) All(root *ast.Node) (ret []*ast.Node) {
+	s.Each(root, func(n *ast.Node) {
+		ret = append(ret, n)
+	})
+	return
+}
+
+// First 返回 root（包括 root）中第一个匹配选择器的节点，没有匹配的节点时返回 nil。
+func (s *Selector) First(root *ast.Node) (ret *ast.Node) {
+	ast.Walk(root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if entering && s.Match(n) {
+			ret = n
+			return ast.WalkStop
+		}
+		return ast.WalkContinue
+	})
+	return
+}
+
+// Each 按文档顺序对 root（包括 root）中每个匹配选择器的节点调用 f。f 中不能修改语法树结构，修改结构请使用 Replace。
+func (s *Selector) Each(root *ast.Node, f func(n *ast.Node)) {
+	ast.Walk(root, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if entering && s.Match(n) {
+			f(n)
+		}
+		return ast.WalkContinue
+	})
+}
+
+// Replace 使用 f 的返回值替换 root 中所有匹配选择器的节点。f 返回 nil 时删除节点，返回 n 时保留节点。
+//
+// 先收集所有匹配的节点再依次替换，位于已经被替换或者删除的节点中的匹配节点会被跳过。root 本身不会被替换。
+func (s *Selector) Replace(root *ast.Node, f func(n *ast.Node) *ast.Node) {
+	replaced := map[*ast.Node]bool{}
+	for _, n := range s.All(root) {
+		if root == n || detached(n, root, replaced) {
+			continue
+		}
+
+		replacement := f(n)
+		if n == replacement {
+			continue
+		}
+		if nil != replacement {
+			n.InsertBefore(replacement)
+		}
+		n.Unlink()
+		replaced[n] = true
+	}
+}
+
+// detached 判断节点 n 是否位于已经被替换的节点中。
+func detached(n, root *ast.Node, replaced map[*ast.Node]bool) bool {
+	for p := n.Parent; nil != p && root != p; p = p.Parent {
+		if replaced[p] {
+			return true
+		}
+	}
+	return false
+}
+
+// All 按文档顺序返回 root（包括 root）中所有匹配选择器 selector 的节点。
+func All(root *ast.Node, selector string) ([]*ast.Node, error) {
+	s, err := Compile(selector)
+	if nil != err {
+		return nil, err
+	}
+	return s.All(root), nil
+}
+
+// First 返回 root（包括 root）中第一个匹配选择器 selector 的节点，没有匹配的节点时返回 nil。
+func First(root *ast.Node, selector string) (*ast.Node, error) {
+	s, err := Compile(selector)
+	if nil != err {
+		return nil, err
+	}
+	return s.First(root), nil
+}
+
+// Each 按文档顺序对 root（包括 root）中每个匹配选择器 selector 的节点调用 f。
+func Each(root *ast.Node, selector string, f func(n *ast.Node)) error {
+	s, err := Compile(selector)
+	if nil != err {
+		return err
+	}
+	s.Each(root, f)
+	return nil
+}
+
+// Replace 使用 f 的返回值替换 root 中所有匹配选择器 selector 的节点，参见 Selector.Replace。
+func Replace(root *ast.Node, selector string, f func(n *ast.Node) *ast.Node) error {
+	s, err := Compile(selector)
+	if nil != err {
+		return err
+	}
+	s.Replace(root, f)
+	return nil
+}
+
+func matchList(list []*complexSelector, n, scope *ast.Node) bool {
+	for _, c := range list {
+		if c.match(len(c.compounds)-1, n, scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// match 从右向左匹配，判断节点 n 是否匹配第 i 个复合选择器并且 n 的祖先或者兄弟节点匹配前面的复合选择器。
+func (c *complexSelector) match(i int, n, scope *ast.Node) bool {
+	if !c.compounds[i].match(n, scope) {
+		return false
+	}
+	if 0 == i {
+		return true
+	}
+
+	switch c.combs[i-1] {
+	case combChild:
+		return nil != n.Parent && c.match(i-1, n.Parent, scope)
+	case combDescendant:
+		for p := n.Parent; nil != p; p = p.Parent {
+			if c.match(i-1, p, scope) {
+				return true
+			}
+		}
+	case combAdjacent:
+		return nil != n.Previous && c.match(i-1, n.Previous, scope)
+	case combSibling:
+		for prev := n.Previous; nil != prev; prev = prev.Previous {
+			if c.match(i-1, prev, scope) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (compound *compoundSelector) match(n, scope *ast.Node) bool {
+	if compound.scope {
+		return n == scope
+	}
+	if -1 != compound.typ && compound.typ != n.Type {
+		return false
+	}
+	for _, attr := range compound.attrs {
+		if !attr.match(n) {
+			return false
+		}
+	}
+	for _, pseudo := range compound.pseudos {
+		if !pseudo.match(n) {
+			return false
+		}
+	}
+	return true
+}
+
+func (attr *attrSelector) match(n *ast.Node) bool {
+	value, ok := attrValue(n, attr.name)
+	switch attr.op {
+	case "":
+		return ok
+	case "=":
+		return ok && attr.value == value
+	case "!=":
+		return !ok || attr.value != value
+	case "^=":
+		return ok && "" != attr.value && strings.HasPrefix(value, attr.value)
+	case "$=":
+		return ok && "" != attr.value && strings.HasSuffix(value, attr.value)
+	case "*=":
+		return ok && "" != attr.value && strings.Contains(value, attr.value)
+	case "~=":
+		if ok {
+			for _, field := range strings.Fields(value) {
+				if attr.value == field {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// attrValue 返回节点 n 上属性 name 的值，ok 表示该属性是否存在。
+func attrValue(n *ast.Node, name string) (value string, ok bool) {
+	switch name {
+	case "level":
+		if ast.NodeHeading == n.Type {
+			return strconv.Itoa(n.HeadingLevel), true
+		}
+	case "info":
+		if ast.NodeCodeBlock == n.Type && n.IsFencedCodeBlock {
+			return util.BytesToStr(bytes.TrimSpace(n.CodeBlockInfo)), true
+		}
+	case "dest":
+		if ast.NodeLink == n.Type || ast.NodeImage == n.Type {
+			if dest := n.ChildByType(ast.NodeLinkDest); nil != dest {
+				return util.BytesToStr(dest.Tokens), true
+			}
+		}
+	case "id":
+		if "" != n.ID {
+			return n.ID, true
+		}
+	}
+
+	for _, kv := range n.KramdownIAL {
+		if name == kv[0] {
+			return n.IALAttr(name), true
+		}
+	}
+	return "", false
+}
+
+func (pseudo *pseudoSelector) match(n *ast.Node) bool {
+	switch pseudo.name {
+	case "first-child":
+		return nil != n.Parent && n == n.Parent.FirstChild
+	case "last-child":
+		return nil != n.Parent && n == n.Parent.LastChild
+	case "only-child":
+		return nil != n.Parent && n == n.Parent.FirstChild && n == n.Parent.LastChild
+	case "empty":
+		return nil == n.FirstChild
+	case "root":
+		return nil == n.Parent
+	case "not":
+		return !matchList(pseudo.args, n, nil)
+	case "has":
+		// 兄弟节点组合符开头的相对选择器需要在父节点范围内查找
+		root := n
+		for _, c := range pseudo.args {
+			if combDescendant != c.combs[0] && combChild != c.combs[0] && nil != n.Parent {
+				root = n.Parent
+			}
+		}
+
+		found := false
+		ast.Walk(root, func(d *ast.Node, entering bool) ast.WalkStatus {
+			if entering && n != d && matchList(pseudo.args, d, n) {
+				found = true
+				return ast.WalkStop
+			}
+			return ast.WalkContinue
+		})
+		return found
+	}
+	return false
+}
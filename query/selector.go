@@ -0,0 +1,268 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package query
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+)
+
+// combinator 描述了两个复合选择器之间的关系。
+type combinator byte
+
+const (
+	combDescendant combinator = ' ' // 后代 A B
+	combChild      combinator = '>' // 子节点 A > B
+	combAdjacent   combinator = '+' // 紧邻的后一个兄弟节点 A + B
+	combSibling    combinator = '~' // 后面的兄弟节点 A ~ B
+)
+
+// attrSelector 描述了属性选择器 [name op value]。
+type attrSelector struct {
+	name  string
+	op    string // 为空时仅判断属性是否存在
+	value string
+}
+
+// pseudoSelector 描述了伪类选择器，比如 :has(NodeImage)。
+type pseudoSelector struct {
+	name string
+	args []*complexSelector // :has 和 :not 的参数
+}
+
+// compoundSelector 描述了复合选择器，比如 NodeHeading[level=2]:has(NodeImage)。
+type compoundSelector struct {
+	typ     ast.NodeType // 节点类型，为 -1 时匹配所有类型
+	scope   bool         // 是否匹配 :has 的作用节点，用于 :has(> NodeImage) 这类相对选择器
+	attrs   []*attrSelector
+	pseudos []*pseudoSelector
+}
+
+// complexSelector 描述了通过组合符连接的多个复合选择器，combs[i] 是 compounds[i] 和 compounds[i+1] 之间的组合符。
+type complexSelector struct {
+	compounds []*compoundSelector
+	combs     []combinator
+}
+
+// selectorParser 用于解析选择器字符串。
+type selectorParser struct {
+	src string
+	pos int
+}
+
+func (p *selectorParser) errorf(msg string) error {
+	return errors.New("query: " + msg + " at offset " + strconv.Itoa(p.pos) + " in selector [" + p.src + "]")
+}
+
+func (p *selectorParser) skipSpaces() (skipped bool) {
+	for ; p.pos < len(p.src) && isSpace(p.src[p.pos]); p.pos++ {
+		skipped = true
+	}
+	return
+}
+
+func (p *selectorParser) peek() byte {
+	if p.pos < len(p.src) {
+		return p.src[p.pos]
+	}
+	return 0
+}
+
+// parseList 解析逗号分隔的选择器列表，relative 为 true 时允许以组合符开头（:has 参数）。
+func (p *selectorParser) parseList(relative bool) (ret []*complexSelector, err error) {
+	for {
+		p.skipSpaces()
+		var c *complexSelector
+		if c, err = p.parseComplex(relative); nil != err {
+			return
+		}
+		ret = append(ret, c)
+		p.skipSpaces()
+		if ',' != p.peek() {
+			return
+		}
+		p.pos++
+	}
+}
+
+func (p *selectorParser) parseComplex(relative bool) (ret *complexSelector, err error) {
+	ret = &complexSelector{}
+	if relative {
+		// 在开头补上匹配作用节点的复合选择器，:has(> A) 匹配作用节点的子节点，没有组合符时匹配后代节点
+		comb := combDescendant
+		if c := p.peek(); '>' == c || '+' == c || '~' == c {
+			comb = combinator(c)
+			p.pos++
+			p.skipSpaces()
+		}
+		ret.compounds = append(ret.compounds, &compoundSelector{typ: -1, scope: true})
+		ret.combs = append(ret.combs, comb)
+	}
+
+	for {
+		var compound *compoundSelector
+		if compound, err = p.parseCompound(); nil != err {
+			return
+		}
+		ret.compounds = append(ret.compounds, compound)
+
+		spaced := p.skipSpaces()
+		c := p.peek()
+		switch {
+		case '>' == c || '+' == c || '~' == c:
+			p.pos++
+			p.skipSpaces()
+			ret.combs = append(ret.combs, combinator(c))
+		case spaced && 0 != c && ',' != c && ')' != c:
+			ret.combs = append(ret.combs, combDescendant)
+		default:
+			return
+		}
+	}
+}
+
+func (p *selectorParser) parseCompound() (ret *compoundSelector, err error) {
+	ret = &compoundSelector{typ: -1}
+	start := p.pos
+	if '*' == p.peek() {
+		p.pos++
+	} else if name := p.parseIdent(); "" != name {
+		if ret.typ = ast.Str2NodeType(name); -1 == ret.typ {
+			p.pos = start
+			return nil, p.errorf("unknown node type [" + name + "]")
+		}
+	}
+
+	for {
+		switch p.peek() {
+		case '[':
+			var attr *attrSelector
+			if attr, err = p.parseAttr(); nil != err {
+				return
+			}
+			ret.attrs = append(ret.attrs, attr)
+		case ':':
+			var pseudo *pseudoSelector
+			if pseudo, err = p.parsePseudo(); nil != err {
+				return
+			}
+			ret.pseudos = append(ret.pseudos, pseudo)
+		default:
+			if start == p.pos {
+				return nil, p.errorf("expected selector")
+			}
+			return
+		}
+	}
+}
+
+func (p *selectorParser) parseAttr() (ret *attrSelector, err error) {
+	p.pos++ // [
+	p.skipSpaces()
+	ret = &attrSelector{name: p.parseIdent()}
+	if "" == ret.name {
+		return nil, p.errorf("expected attribute name")
+	}
+	p.skipSpaces()
+	if ']' == p.peek() {
+		p.pos++
+		return
+	}
+
+	for _, op := range []string{"=", "!=", "^=", "$=", "*=", "~="} {
+		if strings.HasPrefix(p.src[p.pos:], op) {
+			ret.op = op
+			p.pos += len(op)
+			break
+		}
+	}
+	if "" == ret.op {
+		return nil, p.errorf("expected attribute operator")
+	}
+	p.skipSpaces()
+	if ret.value, err = p.parseValue(); nil != err {
+		return
+	}
+	p.skipSpaces()
+	if ']' != p.peek() {
+		return nil, p.errorf("expected ]")
+	}
+	p.pos++
+	return
+}
+
+// parseValue 解析属性值，属性值可以使用单引号或者双引号括起来。
+func (p *selectorParser) parseValue() (string, error) {
+	quote := p.peek()
+	if '"' != quote && '\'' != quote {
+		start := p.pos
+		for ; p.pos < len(p.src) && ']' != p.src[p.pos] && !isSpace(p.src[p.pos]); p.pos++ {
+		}
+		return p.src[start:p.pos], nil
+	}
+
+	p.pos++
+	var buf strings.Builder
+	for ; p.pos < len(p.src); p.pos++ {
+		c := p.src[p.pos]
+		if quote == c {
+			p.pos++
+			return buf.String(), nil
+		}
+		if '\\' == c && p.pos+1 < len(p.src) {
+			p.pos++
+			c = p.src[p.pos]
+		}
+		buf.WriteByte(c)
+	}
+	return "", p.errorf("unclosed quoted value")
+}
+
+func (p *selectorParser) parsePseudo() (ret *pseudoSelector, err error) {
+	p.pos++ // :
+	ret = &pseudoSelector{name: p.parseIdent()}
+	switch ret.name {
+	case "first-child", "last-child", "only-child", "empty", "root":
+		return
+	case "has", "not":
+		if '(' != p.peek() {
+			return nil, p.errorf("expected ( after :" + ret.name)
+		}
+		p.pos++
+		if ret.args, err = p.parseList("has" == ret.name); nil != err {
+			return
+		}
+		if ')' != p.peek() {
+			return nil, p.errorf("expected )")
+		}
+		p.pos++
+		return
+	}
+	return nil, p.errorf("unknown pseudo-class [:" + ret.name + "]")
+}
+
+func (p *selectorParser) parseIdent() string {
+	start := p.pos
+	for ; p.pos < len(p.src); p.pos++ {
+		c := p.src[p.pos]
+		if !('a' <= c && 'z' >= c) && !('A' <= c && 'Z' >= c) && !('0' <= c && '9' >= c) && '-' != c && '_' != c {
+			break
+		}
+	}
+	return p.src[start:p.pos]
+}
+
+func isSpace(c byte) bool {
+	return ' ' == c || '\t' == c || '\n' == c || '\r' == c
+}
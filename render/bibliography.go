@@ -0,0 +1,546 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package render
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// BibName 描述了文献的一个作者。
+type BibName struct {
+	Family  string `json:"family,omitempty"`  // 姓
+	Given   string `json:"given,omitempty"`   // 名
+	Literal string `json:"literal,omitempty"` // 机构等不区分姓名的作者
+}
+
+// BibEntry 描述了文献库中的一条文献，字段对应 CSL-JSON 中的同名变量。
+type BibEntry struct {
+	ID             string     // 引用键
+	Type           string     // 文献类型，比如 article-journal、book
+	Title          string     // 标题
+	Authors        []*BibName // 作者
+	Year           string     // 出版年份
+	ContainerTitle string     // 期刊、会议论文集或者书名
+	Publisher      string     // 出版者
+	Volume         string     // 卷
+	Issue          string     // 期
+	Page           string     // 页码
+	DOI            string     // DOI
+	URL            string     // 链接
+}
+
+// Bibliography 描述了文献库，通过引用键查找文献。
+type Bibliography struct {
+	Entries map[string]*BibEntry // 引用键到文献的映射
+}
+
+// NewBibliography 使用 entries 构造一个文献库，引用键重复时后面的文献覆盖前面的。
+func NewBibliography(entries ...*BibEntry) *Bibliography {
+	ret := &Bibliography{Entries: map[string]*BibEntry{}}
+	ret.Add(entries...)
+	return ret
+}
+
+// Add 将 entries 添加到文献库中。
+func (bibliography *Bibliography) Add(entries ...*BibEntry) {
+	for _, entry := range entries {
+		bibliography.Entries[entry.ID] = entry
+	}
+}
+
+// Get 返回引用键 key 对应的文献，没有时返回 nil。
+func (bibliography *Bibliography) Get(key string) *BibEntry {
+	if nil == bibliography {
+		return nil
+	}
+	return bibliography.Entries[key]
+}
+
+// LoadBibliography 从本地文件加载文献库，扩展名为 .json 的文件按 CSL-JSON 解析，扩展名为 .bib 的文件按 BibTeX 解析。
+func LoadBibliography(paths ...string) (*Bibliography, error) {
+	ret := NewBibliography()
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if nil != err {
+			return nil, err
+		}
+
+		var entries []*BibEntry
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".json":
+			entries, err = ParseCSLJSON(data)
+		case ".bib":
+			entries, err = ParseBibTeX(data)
+		default:
+			err = errors.New("unsupported bibliography format")
+		}
+		if nil != err {
+			return nil, fmt.Errorf("load bibliography [%s] failed: %s", path, err)
+		}
+		ret.Add(entries...)
+	}
+	return ret, nil
+}
+
+// cslItem 描述了 CSL-JSON 中的一条文献。
+type cslItem struct {
+	ID             json.RawMessage `json:"id"`
+	Type           string          `json:"type"`
+	Title          string          `json:"title"`
+	Author         []*BibName      `json:"author"`
+	Issued         *cslDate        `json:"issued"`
+	ContainerTitle string          `json:"container-title"`
+	Publisher      string          `json:"publisher"`
+	Volume         json.RawMessage `json:"volume"`
+	Issue          json.RawMessage `json:"issue"`
+	Page           json.RawMessage `json:"page"`
+	DOI            string          `json:"DOI"`
+	URL            string          `json:"URL"`
+}
+
+// cslDate 描述了 CSL-JSON 中的日期 {"date-parts": [[2020, 1, 2]]}。
+type cslDate struct {
+	DateParts [][]json.RawMessage `json:"date-parts"`
+	Literal   string              `json:"literal"`
+	Raw       string              `json:"raw"`
+}
+
+// ParseCSLJSON 解析 CSL-JSON 格式的文献列表。
+func ParseCSLJSON(data []byte) (ret []*BibEntry, err error) {
+	var items []*cslItem
+	if err = json.Unmarshal(data, &items); nil != err {
+		return
+	}
+
+	for _, item := range items {
+		if nil == item {
+			continue
+		}
+		id := cslString(item.ID)
+		if "" == id {
+			continue
+		}
+		var authors []*BibName
+		for _, name := range item.Author {
+			if nil != name && ("" != name.Family || "" != name.Given || "" != name.Literal) {
+				authors = append(authors, name)
+			}
+		}
+		entry := &BibEntry{ID: id, Type: item.Type, Title: item.Title, Authors: authors, ContainerTitle: item.ContainerTitle,
+			Publisher: item.Publisher, Volume: cslString(item.Volume), Issue: cslString(item.Issue), Page: cslString(item.Page), DOI: item.DOI, URL: item.URL}
+		if nil != item.Issued {
+			if 0 < len(item.Issued.DateParts) && 0 < len(item.Issued.DateParts[0]) {
+				entry.Year = cslString(item.Issued.DateParts[0][0])
+			} else if "" != item.Issued.Literal {
+				entry.Year = item.Issued.Literal
+			} else if 4 <= len(item.Issued.Raw) {
+				entry.Year = item.Issued.Raw[:4]
+			}
+		}
+		ret = append(ret, entry)
+	}
+	return
+}
+
+// cslString 返回 CSL-JSON 中字符串或者数字类型的值。
+func cslString(raw json.RawMessage) string {
+	if 1 > len(raw) {
+		return ""
+	}
+	var str string
+	if nil == json.Unmarshal(raw, &str) {
+		return str
+	}
+	var num json.Number
+	if nil == json.Unmarshal(raw, &num) {
+		return num.String()
+	}
+	return ""
+}
+
+// bibTeXTypes 定义了 BibTeX 条目类型对应的 CSL 文献类型。
+var bibTeXTypes = map[string]string{
+	"article":       "article-journal",
+	"book":          "book",
+	"inbook":        "chapter",
+	"incollection":  "chapter",
+	"inproceedings": "paper-conference",
+	"conference":    "paper-conference",
+	"phdthesis":     "thesis",
+	"mastersthesis": "thesis",
+	"techreport":    "report",
+	"online":        "webpage",
+}
+
+// bibTeXMonths 定义了 BibTeX 内置的月份缩写。
+var bibTeXMonths = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+
+// bibTeXParser 用于解析 BibTeX 文本。
+type bibTeXParser struct {
+	data    string
+	pos     int
+	strings map[string]string // @string 定义的缩写
+}
+
+// ParseBibTeX 解析 BibTeX 格式的文献列表，忽略 @comment 和 @preamble，支持 @string 缩写和 # 拼接。
+func ParseBibTeX(data []byte) (ret []*BibEntry, err error) {
+	p := &bibTeXParser{data: string(data), strings: map[string]string{}}
+	for i, month := range bibTeXMonths {
+		p.strings[month] = strconv.Itoa(i + 1)
+	}
+
+	for {
+		at := strings.IndexByte(p.data[p.pos:], '@')
+		if 0 > at {
+			return
+		}
+		p.pos += at + 1
+
+		typ := strings.ToLower(p.identifier())
+		p.skipSpace()
+		if p.pos >= len(p.data) || ('{' != p.data[p.pos] && '(' != p.data[p.pos]) {
+			continue
+		}
+		closer := byte('}')
+		if '(' == p.data[p.pos] {
+			closer = ')'
+		}
+
+		switch typ {
+		case "comment", "preamble":
+			p.skipBalanced()
+			continue
+		case "string":
+			p.pos++
+			fields, e := p.fields(closer)
+			if nil != e {
+				return nil, e
+			}
+			for _, field := range fields {
+				p.strings[field[0]] = field[1]
+			}
+			continue
+		}
+
+		p.pos++
+		comma := strings.IndexByte(p.data[p.pos:], ',')
+		if 0 > comma {
+			return nil, fmt.Errorf("entry key of @%s not found", typ)
+		}
+		key := strings.TrimSpace(p.data[p.pos : p.pos+comma])
+		p.pos += comma + 1
+		fields, e := p.fields(closer)
+		if nil != e {
+			return nil, fmt.Errorf("parse entry [%s] failed: %s", key, e)
+		}
+		ret = append(ret, newBibTeXEntry(typ, key, fields))
+	}
+}
+
+// fields 解析 name = value 形式的字段列表直到 closer，字段名转换为小写。
+func (p *bibTeXParser) fields(closer byte) (ret [][2]string, err error) {
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.data) {
+			return nil, errors.New("unexpected end of input")
+		}
+		if closer == p.data[p.pos] {
+			p.pos++
+			return
+		}
+		if ',' == p.data[p.pos] {
+			p.pos++
+			continue
+		}
+
+		name := strings.ToLower(p.identifier())
+		p.skipSpace()
+		if "" == name || p.pos >= len(p.data) || '=' != p.data[p.pos] {
+			return nil, errors.New("invalid field")
+		}
+		p.pos++
+		value, e := p.value()
+		if nil != e {
+			return nil, e
+		}
+		ret = append(ret, [2]string{name, value})
+	}
+}
+
+// value 解析字段值，字段值可以是 {text}、"text"、数字或者缩写，使用 # 拼接。
+func (p *bibTeXParser) value() (ret string, err error) {
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.data) {
+			return "", errors.New("unexpected end of input")
+		}
+
+		switch p.data[p.pos] {
+		case '{':
+			start := p.pos + 1
+			if !p.skipBalanced() {
+				return "", errors.New("unclosed brace")
+			}
+			ret += p.data[start : p.pos-1]
+		case '"':
+			start := p.pos + 1
+			depth := 0
+			for p.pos++; p.pos < len(p.data); p.pos++ {
+				c := p.data[p.pos]
+				if '{' == c {
+					depth++
+				} else if '}' == c {
+					depth--
+				} else if '"' == c && 0 == depth && '\\' != p.data[p.pos-1] {
+					break
+				}
+			}
+			if p.pos >= len(p.data) {
+				return "", errors.New("unclosed quote")
+			}
+			ret += p.data[start:p.pos]
+			p.pos++
+		default:
+			name := p.identifier()
+			if "" == name {
+				return "", errors.New("invalid value")
+			}
+			if abbr, ok := p.strings[strings.ToLower(name)]; ok {
+				ret += abbr
+			} else {
+				ret += name
+			}
+		}
+
+		p.skipSpace()
+		if p.pos >= len(p.data) || '#' != p.data[p.pos] {
+			return
+		}
+		p.pos++
+	}
+}
+
+// identifier 解析条目类型、字段名或者缩写名。
+func (p *bibTeXParser) identifier() string {
+	start := p.pos
+	for ; p.pos < len(p.data); p.pos++ {
+		c := p.data[p.pos]
+		if ' ' == c || '\t' == c || '\n' == c || '\r' == c || 0 <= strings.IndexByte("{}()=,#\"@", c) {
+			break
+		}
+	}
+	return p.data[start:p.pos]
+}
+
+// skipBalanced 跳过从当前位置开始的配对括号，成功时返回 true。
+func (p *bibTeXParser) skipBalanced() bool {
+	open := p.data[p.pos]
+	closer := byte('}')
+	if '(' == open {
+		closer = ')'
+	}
+	depth := 0
+	for ; p.pos < len(p.data); p.pos++ {
+		c := p.data[p.pos]
+		if open == c {
+			depth++
+		} else if closer == c {
+			if depth--; 0 == depth {
+				p.pos++
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (p *bibTeXParser) skipSpace() {
+	for ; p.pos < len(p.data); p.pos++ {
+		c := p.data[p.pos]
+		if ' ' != c && '\t' != c && '\n' != c && '\r' != c {
+			break
+		}
+	}
+}
+
+// newBibTeXEntry 使用 BibTeX 条目类型 typ、引用键 key 和字段 fields 构造文献。
+func newBibTeXEntry(typ, key string, fields [][2]string) *BibEntry {
+	ret := &BibEntry{ID: key, Type: typ}
+	if cslType, ok := bibTeXTypes[typ]; ok {
+		ret.Type = cslType
+	}
+	for _, field := range fields {
+		value := field[1]
+		switch field[0] {
+		case "title":
+			ret.Title = bibTeXText(value)
+		case "author":
+			ret.Authors = bibTeXNames(value)
+		case "year":
+			ret.Year = bibTeXText(value)
+		case "date":
+			if date := bibTeXText(value); 4 <= len(date) && "" == ret.Year {
+				ret.Year = date[:4]
+			}
+		case "journal", "journaltitle", "booktitle":
+			ret.ContainerTitle = bibTeXText(value)
+		case "publisher", "institution", "school", "organization":
+			if "" == ret.Publisher {
+				ret.Publisher = bibTeXText(value)
+			}
+		case "volume":
+			ret.Volume = bibTeXText(value)
+		case "number":
+			ret.Issue = bibTeXText(value)
+		case "pages":
+			ret.Page = bibTeXText(value)
+		case "doi":
+			ret.DOI = bibTeXText(value)
+		case "url":
+			ret.URL = strings.TrimSpace(value)
+		}
+	}
+	return ret
+}
+
+// bibTeXNames 解析使用 and 分隔的作者列表，支持 Family, Given 和 Given Family 两种写法，整体使用 {} 包裹的作者作为机构名称。
+func bibTeXNames(value string) (ret []*BibName) {
+	for _, name := range bibTeXSplitNames(value) {
+		name = strings.TrimSpace(name)
+		if "" == name {
+			continue
+		}
+		if strings.HasPrefix(name, "{") && strings.HasSuffix(name, "}") && !strings.Contains(name[1:len(name)-1], "{") {
+			ret = append(ret, &BibName{Literal: bibTeXText(name)})
+			continue
+		}
+
+		if parts := strings.Split(name, ","); 1 < len(parts) {
+			// Family, Given 或者 Family, Jr, Given
+			ret = append(ret, &BibName{Family: bibTeXText(parts[0]), Given: bibTeXText(parts[len(parts)-1])})
+			continue
+		}
+		words := strings.Fields(name)
+		ret = append(ret, &BibName{Family: bibTeXText(words[len(words)-1]), Given: bibTeXText(strings.Join(words[:len(words)-1], " "))})
+	}
+	return
+}
+
+// bibTeXSplitNames 使用 {} 外的 and 分割作者列表。
+func bibTeXSplitNames(value string) (ret []string) {
+	depth, start := 0, 0
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		default:
+			if 0 == depth && i+5 <= len(value) && strings.EqualFold(value[i:i+5], " and ") {
+				ret = append(ret, value[start:i])
+				start = i + 5
+				i += 4
+			}
+		}
+	}
+	return append(ret, value[start:])
+}
+
+// bibTeXAccents 定义了 LaTeX 重音命令和字母组合对应的字符。
+var bibTeXAccents = map[string]string{
+	"\"a": "ä", "\"o": "ö", "\"u": "ü", "\"A": "Ä", "\"O": "Ö", "\"U": "Ü", "\"e": "ë", "\"i": "ï",
+	"'a": "á", "'e": "é", "'i": "í", "'o": "ó", "'u": "ú", "'E": "É", "'c": "ć", "'n": "ń", "'s": "ś", "'z": "ź",
+	"`a": "à", "`e": "è", "`i": "ì", "`o": "ò", "`u": "ù",
+	"^a": "â", "^e": "ê", "^i": "î", "^o": "ô", "^u": "û",
+	"~n": "ñ", "~a": "ã", "~o": "õ", "~N": "Ñ",
+	"cc": "ç", "cC": "Ç", "ss": "ß", "o": "ø", "O": "Ø", "aa": "å", "AA": "Å", "ae": "æ", "AE": "Æ",
+}
+
+// bibTeXLogos 定义了 TeX 系列标志命令对应的文本。
+var bibTeXLogos = map[string]string{
+	"TeX": "TeX", "LaTeX": "LaTeX", "LaTeXe": "LaTeX2ε", "BibTeX": "BibTeX", "XeTeX": "XeTeX", "XeLaTeX": "XeLaTeX",
+	"LuaTeX": "LuaTeX", "LuaLaTeX": "LuaLaTeX", "ConTeXt": "ConTeXt", "AmS": "AMS", "METAFONT": "METAFONT",
+}
+
+// bibTeXText 将 BibTeX 字段值转换为纯文本：处理常见的 LaTeX 转义、重音命令和标志命令，去掉 {}，-- 和 --- 转换为短划线和长划线，合并空白。
+// 带参数的其他命令（比如 \emph{a}）只保留参数，不带参数的其他命令保留命令名。
+func bibTeXText(value string) string {
+	buf := strings.Builder{}
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch c {
+		case '{', '}':
+			continue
+		case '~':
+			buf.WriteByte(' ')
+			continue
+		case '-':
+			if strings.HasPrefix(value[i:], "---") {
+				buf.WriteString("—")
+				i += 2
+				continue
+			}
+			if strings.HasPrefix(value[i:], "--") {
+				buf.WriteString("–")
+				i++
+				continue
+			}
+		case '\\':
+			if i+1 >= len(value) {
+				continue
+			}
+			next := value[i+1]
+			if 0 <= strings.IndexByte("&%$#_{}", next) {
+				buf.WriteByte(next)
+				i++
+				continue
+			}
+
+			// \"u、\"{u}、\c{c}、\ss 这样的重音命令
+			j := i + 1
+			cmd := string(next)
+			if 'a' <= next && 'z' >= next || 'A' <= next && 'Z' >= next {
+				for j = i + 1; j < len(value) && ('a' <= value[j] && 'z' >= value[j] || 'A' <= value[j] && 'Z' >= value[j]); j++ {
+				}
+				cmd = value[i+1 : j]
+				j--
+			}
+			rest := strings.TrimLeft(value[j+1:], " {")
+			if accent, ok := bibTeXAccents[cmd]; ok {
+				buf.WriteString(accent)
+				i = j
+				continue
+			}
+			if 0 < len(rest) {
+				if accent, ok := bibTeXAccents[cmd+rest[:1]]; ok {
+					buf.WriteString(accent)
+					i = len(value) - len(rest)
+					continue
+				}
+			}
+			if logo, ok := bibTeXLogos[cmd]; ok {
+				buf.WriteString(logo)
+			} else if arg := strings.TrimLeft(value[j+1:], " "); 1 < len(cmd) && (!strings.HasPrefix(arg, "{") || strings.HasPrefix(arg, "{}")) {
+				buf.WriteString(cmd)
+			}
+			i = j
+			continue
+		}
+		buf.WriteByte(c)
+	}
+	return strings.Join(strings.Fields(buf.String()), " ")
+}
@@ -0,0 +1,51 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package render
+
+import (
+	"strings"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/html"
+)
+
+// calloutMarker 返回提示块首行的 Markdown 标记，比如 [!NOTE]、[!tip]- Title。
+func calloutMarker(node *ast.Node) string {
+	ret := "[!" + node.CalloutType + "]" + node.CalloutFold
+	if "" != node.CalloutTitle {
+		ret += " " + node.CalloutTitle
+	}
+	return ret
+}
+
+// calloutTitle 返回提示块的标题，没有设置标题时使用首字母大写的类型，比如 [!NOTE] 的标题为 Note。
+func calloutTitle(node *ast.Node) string {
+	if "" != node.CalloutTitle {
+		return node.CalloutTitle
+	}
+	typ := strings.ToLower(node.CalloutType)
+	if "" == typ {
+		return ""
+	}
+	return strings.ToUpper(typ[:1]) + typ[1:]
+}
+
+// calloutAttrs 返回在 DOM 中保存提示块类型、标题和折叠标记的属性，标题保存 Markdown 原文。
+func calloutAttrs(node *ast.Node) (ret [][]string) {
+	ret = append(ret, []string{"data-callout", node.CalloutType})
+	if "" != node.CalloutTitle {
+		ret = append(ret, []string{"data-callout-title", html.EscapeHTMLStr(node.CalloutTitle)})
+	}
+	if "" != node.CalloutFold {
+		ret = append(ret, []string{"data-callout-fold", node.CalloutFold})
+	}
+	return
+}
@@ -0,0 +1,269 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package render
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+)
+
+const (
+	CitationStyleAuthorDate = "author-date" // 作者-年份样式 (Smith 2020, p. 33)
+	CitationStyleNumeric    = "numeric"     // 数字编号样式 [1, p. 33]
+)
+
+// citationItem 描述了一个引用项的显示内容。
+type citationItem struct {
+	prefix string    // 前缀，不包含在链接中
+	text   string    // 显示文本
+	entry  *BibEntry // 对应的文献，为 nil 时表示引用未解析
+}
+
+// citationNumeric 判断是否使用数字编号样式。
+func (r *BaseRenderer) citationNumeric() bool {
+	return CitationStyleNumeric == r.Options.CitationStyle
+}
+
+// citeEntry 记录引用的文献，数字编号样式下按首次引用的顺序编号，返回文献的编号。
+func (r *BaseRenderer) citeEntry(entry *BibEntry) int {
+	if num, ok := r.citationNums[entry.ID]; ok {
+		return num
+	}
+	if nil == r.citationNums {
+		r.citationNums = map[string]int{}
+	}
+	r.citedEntries = append(r.citedEntries, entry)
+	r.citationNums[entry.ID] = len(r.citedEntries)
+	return len(r.citedEntries)
+}
+
+// citationItems 返回文献引用节点 node 的引用项和包裹引用项的开闭符号，正文中的引用 @smith2020 没有开闭符号。
+func (r *BaseRenderer) citationItems(node *ast.Node) (items []*citationItem, open, close, sep string) {
+	numeric := r.citationNumeric()
+	if !node.CitationInText {
+		open, close, sep = "(", ")", "; "
+		if numeric {
+			open, close, sep = "[", "]", ", "
+		}
+	}
+
+	for _, citation := range node.Citations {
+		item := &citationItem{prefix: citation.Prefix, entry: r.Options.Bibliography.Get(citation.Key)}
+		items = append(items, item)
+		if nil == item.entry {
+			item.text = citation.Key + "?"
+		} else {
+			num := r.citeEntry(item.entry)
+			switch {
+			case node.CitationInText && numeric:
+				item.text = citationAuthors(item.entry) + " [" + strconv.Itoa(num) + "]"
+			case node.CitationInText:
+				item.text = citationAuthors(item.entry) + " (" + citationYear(item.entry) + ")"
+			case numeric:
+				item.text = strconv.Itoa(num)
+			case citation.SuppressAuthor:
+				item.text = citationYear(item.entry)
+			default:
+				item.text = citationAuthors(item.entry) + " " + citationYear(item.entry)
+			}
+		}
+		if "" != citation.Locator {
+			item.text += ", " + citation.Locator
+			if numeric {
+				sep = "; "
+			}
+		}
+	}
+	return
+}
+
+// citationText 返回文献引用节点 node 的纯文本显示内容，比如 (see Smith 2020, p. 33; Doe 2019)。
+func (r *BaseRenderer) citationText(node *ast.Node) string {
+	items, open, close, sep := r.citationItems(node)
+	var texts []string
+	for _, item := range items {
+		text := item.text
+		if "" != item.prefix {
+			text = item.prefix + " " + text
+		}
+		texts = append(texts, text)
+	}
+	return open + strings.Join(texts, sep) + close
+}
+
+// citationAuthors 返回文献 entry 在引用中显示的作者，比如 Smith、Smith and Doe、Smith et al.，没有作者时使用标题，标题也没有时使用引用键。
+func citationAuthors(entry *BibEntry) string {
+	switch len(entry.Authors) {
+	case 0:
+		if "" == entry.Title {
+			return entry.ID
+		}
+		return entry.Title
+	case 1:
+		return bibNameShort(entry.Authors[0])
+	case 2:
+		return bibNameShort(entry.Authors[0]) + " and " + bibNameShort(entry.Authors[1])
+	default:
+		return bibNameShort(entry.Authors[0]) + " et al."
+	}
+}
+
+func citationYear(entry *BibEntry) string {
+	if "" == entry.Year {
+		return "n.d."
+	}
+	return entry.Year
+}
+
+func bibNameShort(name *BibName) string {
+	if "" != name.Literal {
+		return name.Literal
+	}
+	return name.Family
+}
+
+// bibNameFull 返回作者全名，inverted 为 true 时使用 Family, Given 的形式。
+func bibNameFull(name *BibName, inverted bool) string {
+	if "" != name.Literal || "" == name.Given {
+		return bibNameShort(name)
+	}
+	if inverted {
+		return name.Family + ", " + name.Given
+	}
+	return name.Given + " " + name.Family
+}
+
+// bibAuthorsFull 返回参考文献列表中的作者，比如 Smith, John, Jane Doe, and Bob Roe。
+func bibAuthorsFull(entry *BibEntry) string {
+	var names []string
+	for i, name := range entry.Authors {
+		names = append(names, bibNameFull(name, 0 == i))
+	}
+	switch len(names) {
+	case 0:
+		return ""
+	case 1:
+		return names[0]
+	case 2:
+		return names[0] + ", and " + names[1]
+	default:
+		return strings.Join(names[:len(names)-1], ", ") + ", and " + names[len(names)-1]
+	}
+}
+
+// citedBibliography 返回需要列入参考文献列表的文献，数字编号样式下按编号排序，作者-年份样式下按作者、年份和标题排序。
+func (r *BaseRenderer) citedBibliography() (ret []*BibEntry) {
+	ret = append(ret, r.citedEntries...)
+	if r.citationNumeric() {
+		return
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		a, b := strings.ToLower(citationAuthors(ret[i])), strings.ToLower(citationAuthors(ret[j]))
+		if a != b {
+			return a < b
+		}
+		if ret[i].Year != ret[j].Year {
+			return ret[i].Year < ret[j].Year
+		}
+		return ret[i].Title < ret[j].Title
+	})
+	return
+}
+
+// bibliographyTitle 返回参考文献列表标题。
+func (r *BaseRenderer) bibliographyTitle() string {
+	if "" != r.Options.BibliographyTitle {
+		return r.Options.BibliographyTitle
+	}
+	return "References"
+}
+
+// bibEntryText 返回文献 entry 在参考文献列表中的内容，escape 用于转义文本，emph 用于输出强调的书名或者期刊名。
+//
+// 作者-年份样式：Smith, John. 2020. “Title.” Journal 12 (3): 33–45. Publisher. https://doi.org/10.1000/1
+// 数字编号样式：Smith, John, “Title,” Journal 12 (3): 33–45, Publisher, 2020. https://doi.org/10.1000/1
+func (r *BaseRenderer) bibEntryText(entry *BibEntry, escape, emph func(string) string) string {
+	numeric := r.citationNumeric()
+	sep := ". "
+	if numeric {
+		sep = ", "
+	}
+
+	var parts []string
+	authors := bibAuthorsFull(entry)
+	if "" != authors {
+		if !numeric {
+			// 作者以缩写的名结尾时（比如 Knuth, Donald E.）不能和分隔符中的 . 重复
+			authors = strings.TrimSuffix(authors, ".")
+		}
+		parts = append(parts, escape(authors))
+	} else if "" != entry.Title {
+		// 没有作者时和 CSL 一样将标题提前到作者的位置
+		parts = append(parts, emph(strings.TrimSuffix(entry.Title, ".")))
+	} else {
+		parts = append(parts, escape(entry.ID))
+	}
+	if !numeric {
+		parts = append(parts, escape(citationYear(entry)))
+	}
+	if "" != authors && "" != entry.Title {
+		title := strings.TrimSuffix(entry.Title, ".")
+		if "" == entry.ContainerTitle {
+			parts = append(parts, emph(title))
+		} else {
+			parts = append(parts, escape("“"+title+strings.TrimSpace(sep)+"”"))
+		}
+	}
+	if "" != entry.ContainerTitle {
+		container := emph(entry.ContainerTitle)
+		if "" != entry.Volume {
+			container += escape(" " + entry.Volume)
+		}
+		if "" != entry.Issue {
+			container += escape(" (" + entry.Issue + ")")
+		}
+		if "" != entry.Page {
+			container += escape(": " + entry.Page)
+		}
+		parts = append(parts, container)
+	}
+	if "" != entry.Publisher {
+		parts = append(parts, escape(entry.Publisher))
+	}
+	if numeric && "" != entry.Year {
+		parts = append(parts, escape(entry.Year))
+	}
+
+	buf := strings.Builder{}
+	for i, part := range parts {
+		buf.WriteString(part)
+		if i < len(parts)-1 {
+			if strings.HasSuffix(part, "”") || (". " == sep && strings.HasSuffix(part, ".")) {
+				// 标题中已经包含了分隔符，或者 n.d. 这样的部分已经以句点结尾
+				buf.WriteString(" ")
+			} else {
+				buf.WriteString(sep)
+			}
+		}
+	}
+	if !strings.HasSuffix(buf.String(), ".") {
+		buf.WriteString(".")
+	}
+	if "" != entry.DOI {
+		buf.WriteString(" " + escape("https://doi.org/"+entry.DOI))
+	} else if "" != entry.URL {
+		buf.WriteString(" " + escape(entry.URL))
+	}
+	return buf.String()
+}
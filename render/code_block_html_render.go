@@ -48,6 +48,7 @@ func (r *HtmlRenderer) renderCodeBlock(node *ast.Node, entering bool) ast.WalkSt
 				var attrs [][]string
 				r.handleKramdownBlockIAL(node)
 				attrs = append(attrs, node.KramdownIAL...)
+				r.renderSourcePos(node, &attrs)
 				r.Tag("pre", attrs, false)
 				r.WriteString("<code>")
 				tokens = html.EscapeHTML(tokens)
@@ -74,6 +75,7 @@ func (r *HtmlRenderer) renderCodeBlockCode(node *ast.Node, entering bool) ast.Wa
 		var attrs [][]string
 		r.handleKramdownBlockIAL(node.Parent)
 		attrs = append(attrs, node.Parent.KramdownIAL...)
+		r.renderSourcePos(node.Parent, &attrs)
 
 		tokens := node.Tokens
 		if 0 < len(node.Previous.CodeBlockInfo) {
@@ -148,6 +150,7 @@ func highlightChroma(codeNode *ast.Node, tokens []byte, language string, r *Html
 	var attrs [][]string
 	r.handleKramdownBlockIAL(codeNode)
 	attrs = append(attrs, codeNode.KramdownIAL...)
+	r.renderSourcePos(codeNode, &attrs)
 
 	codeBlock := util.BytesToStr(tokens)
 	var lexer chroma.Lexer
@@ -0,0 +1,75 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package render
+
+import (
+	"strconv"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/parse"
+)
+
+// DefaultCrossRefNames 定义了交叉引用类型的默认显示名称。
+var DefaultCrossRefNames = map[string]string{"fig": "Figure", "tbl": "Table", "eq": "Equation"}
+
+// crossRefText 返回已编号节点或者已解析的交叉引用节点 node 的显示文本，比如 Figure 3，没有编号时返回空字符串。
+func (r *BaseRenderer) crossRefText(node *ast.Node) string {
+	if 1 > node.CrossRefNum {
+		return ""
+	}
+
+	kind := parse.CrossRefKind(node.CrossRefLabel)
+	name, ok := r.Options.CrossRefNames[kind]
+	if !ok {
+		name = DefaultCrossRefNames[kind]
+	}
+	return name + " " + strconv.Itoa(node.CrossRefNum)
+}
+
+// crossRefCaption 返回已编号节点 node 的标题，比如 Figure 3: caption，caption 为空时只返回编号文本。
+func (r *BaseRenderer) crossRefCaption(node *ast.Node, caption string) string {
+	ret := r.crossRefText(node)
+	if "" != caption {
+		ret += ": " + caption
+	}
+	return ret
+}
+
+// crossRefFigure 判断段落 paragraph 是否仅包含一张已编号的图片，是的话返回该图片节点，这样的段落渲染为 figure。
+func crossRefFigure(paragraph *ast.Node) *ast.Node {
+	if ast.NodeParagraph != paragraph.Type {
+		return nil
+	}
+	if image := paragraph.ChildByType(ast.NodeImage); nil != image && 0 < image.CrossRefNum && parse.StandaloneImage(image) {
+		return image
+	}
+	return nil
+}
+
+// crossRefID 返回已编号节点 node 需要渲染的 id 属性值，标签来自 kramdown IAL 的 id 属性时已经随 IAL 渲染，返回空字符串。
+// 行内图片不编号，但是仍然使用标签作为锚点。
+func crossRefID(node *ast.Node) string {
+	if 1 > node.CrossRefNum && (ast.NodeImage != node.Type || parse.StandaloneImage(node)) {
+		return ""
+	}
+	if node.CrossRefLabel == node.IALAttr("id") {
+		return ""
+	}
+	return node.CrossRefLabel
+}
+
+// crossRefLabelMarkdown 返回表格和数学公式块节点 node 的交叉引用标签 {#tbl:label}，标签来自 kramdown IAL 的 id 属性时返回空字符串。
+func crossRefLabelMarkdown(node *ast.Node) string {
+	if "" == node.CrossRefLabel || node.CrossRefLabel == node.IALAttr("id") {
+		return ""
+	}
+	return "{#" + node.CrossRefLabel + "}"
+}
@@ -0,0 +1,40 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package render
+
+import (
+	"bytes"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/parse"
+)
+
+// definitionListMarkdown 返回定义列表节点 node 的 Markdown。
+//
+// 编辑器中没有定义列表块，定义列表作为段落显示原文，转换回 Markdown 时重新解析为定义列表，不会丢失内容。
+func definitionListMarkdown(tree *parse.Tree, node *ast.Node, options *Options) []byte {
+	r := NewFormatRenderer(tree, options)
+	r.Writer = &bytes.Buffer{}
+	r.NodeWriterStack = append(r.NodeWriterStack, r.Writer)
+	r.renderNode(node)
+	return bytes.Trim(r.Writer.Bytes(), "\n")
+}
+
+// protyleDefinitionListMarkdown 返回 Protyle 中定义列表节点 node 的 Markdown。
+//
+// Protyle 树中的行级元素已经转换为 TextMark 节点，需要使用导出 Markdown 的渲染器生成标记符。
+func protyleDefinitionListMarkdown(tree *parse.Tree, node *ast.Node, options *Options) []byte {
+	r := NewProtyleExportMdRenderer(tree, options)
+	r.Writer = &bytes.Buffer{}
+	r.NodeWriterStack = append(r.NodeWriterStack, r.Writer)
+	r.renderNode(node)
+	return bytes.Trim(r.Writer.Bytes(), "\n")
+}
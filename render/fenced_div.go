@@ -0,0 +1,67 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package render
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/html"
+	"github.com/Dofingert/lute-for-ficus/parse"
+	"github.com/Dofingert/lute-for-ficus/util"
+)
+
+// fencedDivFence 返回围栏 div 节点 node 的标记符。
+//
+// 优先使用解析时的标记符长度，没有的话（比如从 DOM 生成的节点）按照嵌套深度生成，保证外层标记符比内层长。
+func fencedDivFence(node *ast.Node) string {
+	fenceLen := node.FencedDivFenceLen
+	if 3 > fenceLen {
+		fenceLen = 3 + fencedDivDepth(node)
+	}
+	return strings.Repeat(":", fenceLen)
+}
+
+// fencedDivDepth 返回围栏 div 节点 node 下嵌套的围栏 div 的最大层数。
+func fencedDivDepth(node *ast.Node) (ret int) {
+	for child := node.FirstChild; nil != child; child = child.Next {
+		depth := fencedDivDepth(child)
+		if ast.NodeFencedDiv == child.Type {
+			depth++
+		}
+		if depth > ret {
+			ret = depth
+		}
+	}
+	return
+}
+
+// fencedDivAttrs 返回围栏 div 节点 node 的属性 Markdown，只有一个 class 时直接使用 class 名，否则使用 Pandoc 通用属性 {#id .class}。
+func fencedDivAttrs(node *ast.Node) string {
+	if 1 == len(node.KramdownIAL) && "class" == node.KramdownIAL[0][0] {
+		if class := html.UnescapeAttrVal(node.KramdownIAL[0][1]); "" != class && !strings.ContainsAny(class, " \t") {
+			return class
+		}
+	}
+	return util.BytesToStr(parse.IAL2PandocTokens(node.KramdownIAL))
+}
+
+// fencedDivMarkdown 返回围栏 div 节点 node 的 Markdown，content 为子块的 Markdown。
+func fencedDivMarkdown(node *ast.Node, content []byte) []byte {
+	fence := fencedDivFence(node)
+	ret := []byte(fence + " " + fencedDivAttrs(node) + "\n")
+	if content = bytes.Trim(content, "\n"); 0 < len(content) {
+		ret = append(ret, content...)
+		ret = append(ret, '\n')
+	}
+	return append(ret, fence...)
+}
@@ -0,0 +1,256 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package render
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/lex"
+)
+
+const (
+	OrderedListRenumber = ""     // 从起始序号开始递增，比如 1. 2. 3.
+	OrderedListOne      = "one"  // 所有项都使用起始序号，比如 1. 1. 1.
+	OrderedListAuto     = "auto" // 原文前两项序号相同时使用 OrderedListOne，否则使用 OrderedListRenumber
+)
+
+const (
+	HeadingStylePreserve = ""       // 保留原有标题风格
+	HeadingStyleATX      = "atx"    // 全部使用 # 标题
+	HeadingStyleSetext   = "setext" // 一二级标题使用 Setext 标题（=== 和 ---），其他使用 # 标题
+)
+
+// FormatOptions 描述了格式化渲染器输出的 Markdown 风格，字段为零值时保留原文风格。
+type FormatOptions struct {
+	BulletChar       byte   // 无序列表项标记符 -、* 或者 +，相邻的列表交替使用 - 和 * 以免被合并
+	EmphasisChar     byte   // 强调标记符 * 或者 _
+	StrongChar       byte   // 加粗标记符 * 或者 _
+	OrderedList      string // 有序列表序号风格：OrderedListRenumber、OrderedListOne 或者 OrderedListAuto
+	FenceChar        byte   // 代码块围栏字符 ` 或者 ~，缩进代码块也使用该字符转换为围栏代码块
+	FenceLength      int    // 代码块围栏最小长度，代码中包含围栏时自动加长
+	HeadingStyle     string // 标题风格：HeadingStylePreserve、HeadingStyleATX 或者 HeadingStyleSetext
+	ThematicBreak    string // 分隔线，为空时使用 ---
+	CompactTable     bool   // 是否不对齐表格列宽
+	UnescapeOptional bool   // 是否移除不影响解析结果的反斜杠转义，比如 a\,b 中的 \
+}
+
+// FormatPresets 定义了常见工具默认风格的预设。
+var FormatPresets = map[string]*FormatOptions{
+	"lute": {},
+	"prettier": {
+		BulletChar:    lex.ItemHyphen,
+		EmphasisChar:  lex.ItemUnderscore,
+		StrongChar:    lex.ItemAsterisk,
+		OrderedList:   OrderedListAuto,
+		FenceChar:     lex.ItemBacktick,
+		FenceLength:   3,
+		HeadingStyle:  HeadingStyleATX,
+		ThematicBreak: "---",
+	},
+	"markdownlint": {
+		BulletChar:    lex.ItemHyphen,
+		EmphasisChar:  lex.ItemAsterisk,
+		StrongChar:    lex.ItemAsterisk,
+		OrderedList:   OrderedListRenumber,
+		FenceChar:     lex.ItemBacktick,
+		FenceLength:   3,
+		HeadingStyle:  HeadingStyleATX,
+		ThematicBreak: "---",
+	},
+}
+
+// formatStyle 返回格式化风格选项，未设置时返回保留原文风格的零值。
+func (r *FormatRenderer) formatStyle() *FormatOptions {
+	if nil != r.Options.FormatStyle {
+		return r.Options.FormatStyle
+	}
+	return FormatPresets["lute"]
+}
+
+// listItemMarker 返回列表项 listItem 的标记符。
+func (r *FormatRenderer) listItemMarker(listItem *ast.Node) string {
+	style := r.formatStyle()
+	if 1 == listItem.ListData.Typ || (3 == listItem.ListData.Typ && 0 == listItem.ListData.BulletChar) {
+		num := listItem.ListData.Num
+		if first := listItem.Parent.FirstChild; nil != first && nil != first.ListData {
+			switch style.OrderedList {
+			case OrderedListOne:
+				num = first.ListData.Num
+			case OrderedListAuto:
+				// 原文中的序号保存在 Start 中，Num 是解析时修正过的序号
+				if second := first.Next; nil != second && nil != second.ListData && first.ListData.Start == second.ListData.Start {
+					num = first.ListData.Num
+				}
+			}
+		}
+		return strconv.Itoa(num) + string(listItem.ListData.Delimiter)
+	}
+
+	if 0 == style.BulletChar {
+		return string(listItem.ListData.Marker)
+	}
+	// 相邻的无序列表使用相同的标记符会被合并为一个列表
+	bullet := style.BulletChar
+	for prev := listItem.Parent.Previous; nil != prev && ast.NodeList == prev.Type && nil != prev.ListData && 0 != prev.ListData.BulletChar; prev = prev.Previous {
+		if lex.ItemHyphen == bullet {
+			bullet = lex.ItemAsterisk
+		} else {
+			bullet = lex.ItemHyphen
+		}
+	}
+	return string(bullet)
+}
+
+// emphasisMarker 返回强调或者加粗节点 emphasis 使用的标记符，original 为原文中的标记符。
+func (r *FormatRenderer) emphasisMarker(emphasis *ast.Node, original byte) byte {
+	style := r.formatStyle().EmphasisChar
+	if ast.NodeStrong == emphasis.Type {
+		style = r.formatStyle().StrongChar
+	}
+	if 0 == style || original == style {
+		return original
+	}
+
+	if lex.ItemUnderscore == style {
+		// 单词内部的 _ 不能作为强调标记符
+		if prev, _ := utf8.DecodeLastRuneInString(emphasis.PreviousNodeText()); unicode.IsLetter(prev) || unicode.IsDigit(prev) {
+			return original
+		}
+		if next, _ := utf8.DecodeRuneInString(emphasis.NextNodeText()); unicode.IsLetter(next) || unicode.IsDigit(next) {
+			return original
+		}
+	}
+
+	// 紧邻的嵌套强调节点使用相同的标记符会改变解析结果，比如 _*a*_ 不能转换为 **a**
+	isEmphasis := func(n *ast.Node) bool {
+		return nil != n && (ast.NodeEmphasis == n.Type || ast.NodeStrong == n.Type)
+	}
+	if nil != emphasis.FirstChild && (isEmphasis(emphasis.FirstChild.Next) || isEmphasis(emphasis.LastChild.Previous)) {
+		return original
+	}
+	if isEmphasis(emphasis.Parent) && (emphasis.Parent.FirstChild == emphasis.Previous || emphasis.Parent.LastChild == emphasis.Next) {
+		return original
+	}
+	return style
+}
+
+// codeBlockFence 返回代码块 codeBlock 使用的围栏，original 为原文中的围栏。
+func (r *FormatRenderer) codeBlockFence(codeBlock *ast.Node, original []byte) []byte {
+	style := r.formatStyle()
+	if 0 == style.FenceChar && 1 > style.FenceLength {
+		return original
+	}
+
+	fenceChar := style.FenceChar
+	if 0 == fenceChar {
+		fenceChar = codeBlock.CodeBlockFenceChar
+	}
+	if 0 == fenceChar {
+		fenceChar = lex.ItemBacktick
+	}
+	var info, code []byte
+	if marker := codeBlock.ChildByType(ast.NodeCodeBlockFenceInfoMarker); nil != marker {
+		info = marker.CodeBlockInfo
+	}
+	if c := codeBlock.ChildByType(ast.NodeCodeBlockCode); nil != c {
+		code = c.Tokens
+	} else if nil != codeBlock.FirstChild && !codeBlock.IsFencedCodeBlock {
+		code = codeBlock.FirstChild.Tokens
+	}
+	if lex.ItemBacktick == fenceChar && 0 <= bytes.IndexByte(info, lex.ItemBacktick) {
+		// 信息字符串中包含 ` 时只能使用 ~ 围栏
+		fenceChar = lex.ItemTilde
+	}
+
+	length := style.FenceLength
+	if 3 > length {
+		length = 3
+	}
+	// 围栏需要比代码中同样字符组成的行更长
+	for _, line := range strings.Split(string(code), "\n") {
+		line = strings.TrimLeft(line, " ")
+		if n := len(line) - len(strings.TrimLeft(line, string(fenceChar))); length <= n {
+			length = n + 1
+		}
+	}
+	return bytes.Repeat([]byte{fenceChar}, length)
+}
+
+// headingSetext 判断标题 heading 是否使用 Setext 风格输出。
+func (r *FormatRenderer) headingSetext(heading *ast.Node) bool {
+	switch r.formatStyle().HeadingStyle {
+	case HeadingStyleATX:
+		// 包含换行的 Setext 标题无法转换为 # 标题
+		return heading.HeadingSetext && (nil != heading.ChildByType(ast.NodeSoftBreak) || nil != heading.ChildByType(ast.NodeHardBreak))
+	case HeadingStyleSetext:
+		return 2 >= heading.HeadingLevel && !heading.ParentIs(ast.NodeTableCell) && "" != strings.TrimSpace(heading.Text())
+	}
+	return heading.HeadingSetext
+}
+
+// thematicBreak 返回分隔线。
+func (r *FormatRenderer) thematicBreak() string {
+	if ret := r.formatStyle().ThematicBreak; "" != ret {
+		return ret
+	}
+	return "---"
+}
+
+// optionalEscape 判断转义节点 backslash 中的反斜杠是否可以移除。
+func (r *FormatRenderer) optionalEscape(backslash *ast.Node) bool {
+	if !r.formatStyle().UnescapeOptional || nil == backslash.FirstChild || 1 != len(backslash.FirstChild.Tokens) {
+		return false
+	}
+
+	c := backslash.FirstChild.Tokens[0]
+	if ';' == c {
+		// &copy\; 和 &#169\; 中的反斜杠移除后会被解析为实体
+		prev := backslash.Previous
+		return nil == prev || ast.NodeText != prev.Type || !entityPrefix(prev.Tokens)
+	}
+	if 0 <= strings.IndexByte("\"',/?%", c) {
+		return true
+	}
+	if 0 > strings.IndexByte("-+.)", c) {
+		return false
+	}
+	// - + . ) 只在行首（或者行首数字后）才可能被解析为列表项标记符
+	lineStart := func(n *ast.Node) bool {
+		return nil == n || ast.NodeSoftBreak == n.Type || ast.NodeHardBreak == n.Type
+	}
+	prev := backslash.Previous
+	if lineStart(prev) {
+		return false
+	}
+	if ('.' == c || ')' == c) && ast.NodeText == prev.Type && "" == strings.Trim(string(prev.Tokens), "0123456789") && lineStart(prev.Previous) {
+		return false
+	}
+	return true
+}
+
+// entityPrefix 判断 tokens 是否以 &name、&#123 或者 &#x1F 这样的实体前缀结尾。
+func entityPrefix(tokens []byte) bool {
+	i := len(tokens)
+	for ; 0 < i && ('0' <= tokens[i-1] && '9' >= tokens[i-1] || 'a' <= tokens[i-1] && 'z' >= tokens[i-1] || 'A' <= tokens[i-1] && 'Z' >= tokens[i-1]); i-- {
+	}
+	if i == len(tokens) || 1 > i {
+		return false
+	}
+	if '&' == tokens[i-1] {
+		return true
+	}
+	return 2 <= i && '#' == tokens[i-1] && '&' == tokens[i-2]
+}
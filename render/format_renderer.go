@@ -12,7 +12,6 @@ package render
 
 import (
 	"bytes"
-	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -29,6 +28,7 @@ import (
 type FormatRenderer struct {
 	*BaseRenderer
 	NodeWriterStack []*bytes.Buffer // 节点输出缓冲栈
+	proseWrapping   bool            // 是否正在输出需要折行的段落
 }
 
 // NewFormatRenderer 创建一个格式化渲染器。
@@ -65,12 +65,17 @@ func NewFormatRenderer(tree *parse.Tree, options *Options) *FormatRenderer {
 	ret.RendererFuncs[ast.NodeStrongU8eOpenMarker] = ret.renderStrongU8eOpenMarker
 	ret.RendererFuncs[ast.NodeStrongU8eCloseMarker] = ret.renderStrongU8eCloseMarker
 	ret.RendererFuncs[ast.NodeBlockquote] = ret.renderBlockquote
+	ret.RendererFuncs[ast.NodeCallout] = ret.renderBlockquote
+	ret.RendererFuncs[ast.NodeFencedDiv] = ret.renderFencedDiv
 	ret.RendererFuncs[ast.NodeBlockquoteMarker] = ret.renderBlockquoteMarker
 	ret.RendererFuncs[ast.NodeHeading] = ret.renderHeading
 	ret.RendererFuncs[ast.NodeHeadingC8hMarker] = ret.renderHeadingC8hMarker
 	ret.RendererFuncs[ast.NodeHeadingID] = ret.renderHeadingID
 	ret.RendererFuncs[ast.NodeList] = ret.renderList
 	ret.RendererFuncs[ast.NodeListItem] = ret.renderListItem
+	ret.RendererFuncs[ast.NodeDefinitionList] = ret.renderList
+	ret.RendererFuncs[ast.NodeDefinitionTerm] = ret.renderDefinitionTerm
+	ret.RendererFuncs[ast.NodeDefinitionDesc] = ret.renderDefinitionDesc
 	ret.RendererFuncs[ast.NodeThematicBreak] = ret.renderThematicBreak
 	ret.RendererFuncs[ast.NodeHardBreak] = ret.renderHardBreak
 	ret.RendererFuncs[ast.NodeSoftBreak] = ret.renderSoftBreak
@@ -119,6 +124,10 @@ func NewFormatRenderer(tree *parse.Tree, options *Options) *FormatRenderer {
 	ret.RendererFuncs[ast.NodeYamlFrontMatterContent] = ret.renderYamlFrontMatterContent
 	ret.RendererFuncs[ast.NodeYamlFrontMatterCloseMarker] = ret.renderYamlFrontMatterCloseMarker
 	ret.RendererFuncs[ast.NodeBlockRef] = ret.renderBlockRef
+	ret.RendererFuncs[ast.NodeWikiLink] = ret.renderWikiLink
+	ret.RendererFuncs[ast.NodeCrossRef] = ret.renderCrossRef
+	ret.RendererFuncs[ast.NodeCitation] = ret.renderCitation
+	ret.RendererFuncs[ast.NodeBracketedSpan] = ret.renderBracketedSpan
 	ret.RendererFuncs[ast.NodeBlockRefID] = ret.renderBlockRefID
 	ret.RendererFuncs[ast.NodeBlockRefSpace] = ret.renderBlockRefSpace
 	ret.RendererFuncs[ast.NodeBlockRefText] = ret.renderBlockRefText
@@ -169,6 +178,11 @@ func NewFormatRenderer(tree *parse.Tree, options *Options) *FormatRenderer {
 	ret.RendererFuncs[ast.NodeTextMark] = ret.renderTextMark
 	ret.RendererFuncs[ast.NodeAttributeView] = ret.renderAttributeView
 	ret.RendererFuncs[ast.NodeCustomBlock] = ret.renderCustomBlock
+	for _, typ := range proseWrapAtoms {
+		if f := ret.RendererFuncs[typ]; nil != f {
+			ret.RendererFuncs[typ] = ret.proseWrapAtom(f)
+		}
+	}
 	return ret
 }
 
@@ -446,6 +460,26 @@ func (r *FormatRenderer) renderGitConflict(node *ast.Node, entering bool) ast.Wa
 	return ast.WalkContinue
 }
 
+func (r *FormatRenderer) renderFencedDiv(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Newline()
+		r.Writer = &bytes.Buffer{}
+		r.NodeWriterStack = append(r.NodeWriterStack, r.Writer)
+	} else {
+		writer := r.NodeWriterStack[len(r.NodeWriterStack)-1]
+		r.NodeWriterStack = r.NodeWriterStack[:len(r.NodeWriterStack)-1]
+		r.Writer = r.NodeWriterStack[len(r.NodeWriterStack)-1]
+		r.Write(fencedDivMarkdown(node, writer.Bytes()))
+		r.Newline()
+		if !r.isLastNode(r.Tree.Root, node) {
+			if r.withoutKramdownBlockIAL(node) {
+				r.WriteByte(lex.ItemNewline)
+			}
+		}
+	}
+	return ast.WalkContinue
+}
+
 func (r *FormatRenderer) renderSuperBlock(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.Newline()
@@ -552,6 +586,22 @@ func (r *FormatRenderer) renderKramdownBlockIAL(node *ast.Node, entering bool) a
 }
 
 func (r *FormatRenderer) renderKramdownSpanIAL(node *ast.Node, entering bool) ast.WalkStatus {
+	if owner := pandocAttributesOwner(node); nil != owner {
+		if !entering {
+			return ast.WalkContinue
+		}
+
+		switch owner.Type {
+		case ast.NodeCodeBlock:
+			// 围栏代码块的属性在信息或者结束标记后输出
+		case ast.NodeHeading:
+			r.WriteString(" " + pandocAttributesMarkdown(owner, true))
+		default:
+			r.WriteString(pandocAttributesMarkdown(owner, r.pandocAttributesStyle(owner)))
+		}
+		return ast.WalkContinue
+	}
+
 	if !r.Options.KramdownSpanIAL {
 		return ast.WalkContinue
 	}
@@ -651,6 +701,36 @@ func (r *FormatRenderer) renderBlockQueryEmbed(node *ast.Node, entering bool) as
 	return ast.WalkContinue
 }
 
+func (r *FormatRenderer) renderWikiLink(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.WriteString(wikiLinkMarkdown(node))
+	}
+	return ast.WalkContinue
+}
+
+func (r *FormatRenderer) renderBracketedSpan(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.WriteByte(lex.ItemOpenBracket)
+	} else {
+		r.WriteByte(lex.ItemCloseBracket)
+	}
+	return ast.WalkContinue
+}
+
+func (r *FormatRenderer) renderCrossRef(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Write(node.Tokens)
+	}
+	return ast.WalkContinue
+}
+
+func (r *FormatRenderer) renderCitation(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Write(node.Tokens)
+	}
+	return ast.WalkContinue
+}
+
 func (r *FormatRenderer) renderBlockRef(node *ast.Node, entering bool) ast.WalkStatus {
 	return ast.WalkContinue
 }
@@ -769,7 +849,7 @@ func (r *FormatRenderer) renderBackslashContent(node *ast.Node, entering bool) a
 }
 
 func (r *FormatRenderer) renderBackslash(node *ast.Node, entering bool) ast.WalkStatus {
-	if entering {
+	if entering && !r.optionalEscape(node) {
 		r.WriteByte(lex.ItemBackslash)
 	}
 	return ast.WalkContinue
@@ -784,16 +864,35 @@ func (r *FormatRenderer) renderToC(node *ast.Node, entering bool) ast.WalkStatus
 
 func (r *FormatRenderer) renderFootnotesRef(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
+		if node.FootnotesInline {
+			// 行级脚注在引用处输出匿名脚注定义的内容
+			if _, def := r.Tree.FindFootnotesDef(node.Tokens); nil != def && nil != def.FirstChild {
+				r.WriteString("^[")
+				for c := def.FirstChild.FirstChild; nil != c; c = c.Next {
+					r.renderNode(c)
+				}
+				r.WriteByte(lex.ItemCloseBracket)
+				return ast.WalkContinue
+			}
+		}
 		r.WriteString("[" + util.BytesToStr(node.Tokens) + "]")
 	}
 	return ast.WalkContinue
 }
 
 func (r *FormatRenderer) renderFootnotesDefBlock(node *ast.Node, entering bool) ast.WalkStatus {
+	if nil != node.FirstChild && node.FirstChild.FootnotesInline {
+		// 匿名脚注定义已经在行级脚注引用处输出
+		return ast.WalkSkipChildren
+	}
 	return ast.WalkContinue
 }
 
 func (r *FormatRenderer) renderFootnotesDef(node *ast.Node, entering bool) ast.WalkStatus {
+	if node.FootnotesInline {
+		return ast.WalkSkipChildren
+	}
+
 	if entering {
 		r.Writer = &bytes.Buffer{}
 		r.NodeWriterStack = append(r.NodeWriterStack, r.Writer)
@@ -801,7 +900,7 @@ func (r *FormatRenderer) renderFootnotesDef(node *ast.Node, entering bool) ast.W
 	} else {
 		writer := r.NodeWriterStack[len(r.NodeWriterStack)-1]
 		r.NodeWriterStack = r.NodeWriterStack[:len(r.NodeWriterStack)-1]
-		buf := writer.String()
+		buf := strings.TrimRight(writer.String(), "\n") // 内容末尾的换行统一处理，避免多出空行
 		lines := strings.Split(buf, "\n")
 		contentBuf := bytes.Buffer{}
 		for i, line := range lines {
@@ -815,6 +914,7 @@ func (r *FormatRenderer) renderFootnotesDef(node *ast.Node, entering bool) ast.W
 				}
 			}
 		}
+		contentBuf.WriteString("\n")
 		r.NodeWriterStack[len(r.NodeWriterStack)-1].Write(contentBuf.Bytes())
 		r.Writer = r.NodeWriterStack[len(r.NodeWriterStack)-1]
 	}
@@ -841,40 +941,174 @@ func (r *FormatRenderer) renderEmoji(node *ast.Node, entering bool) ast.WalkStat
 }
 
 func (r *FormatRenderer) renderTableCell(node *ast.Node, entering bool) ast.WalkStatus {
-	padding := node.TableCellContentMaxWidth - node.TableCellContentWidth
+	if 1 == node.TableCellMerged { // 被左侧单元格合并，仅输出 ||
+		if entering {
+			r.WriteByte(lex.ItemPipe)
+		}
+		return ast.WalkSkipChildren
+	}
+
+	padding := r.tableCellPadding(node, node.TableCellContentWidth)
 	if entering {
-		r.WriteByte(lex.ItemPipe)
-		if !r.Options.ProtyleWYSIWYG {
-			r.WriteByte(lex.ItemSpace)
-			switch node.TableCellAlign {
-			case 2:
-				r.Write(bytes.Repeat([]byte{lex.ItemSpace}, padding/2))
-			case 3:
-				r.Write(bytes.Repeat([]byte{lex.ItemSpace}, padding))
-			}
+		r.renderTableCellOpen(node, padding)
+		if 2 == node.TableCellMerged { // 被上方单元格合并
+			r.WriteString("^^")
+			return ast.WalkSkipChildren
 		}
 	} else {
-		if !r.Options.ProtyleWYSIWYG {
-			switch node.TableCellAlign {
-			case 2:
-				r.Write(bytes.Repeat([]byte{lex.ItemSpace}, padding/2))
-			case 3:
-			default:
-				r.Write(bytes.Repeat([]byte{lex.ItemSpace}, padding))
-			}
-			r.WriteByte(lex.ItemSpace)
-		}
+		r.renderTableCellClose(node, padding)
 	}
 	return ast.WalkContinue
 }
 
+func (r *FormatRenderer) renderTableCellOpen(node *ast.Node, padding int) {
+	r.WriteByte(lex.ItemPipe)
+	if !r.Options.ProtyleWYSIWYG {
+		r.WriteByte(lex.ItemSpace)
+		switch node.TableCellAlign {
+		case 2:
+			r.Write(bytes.Repeat([]byte{lex.ItemSpace}, padding/2))
+		case 3:
+			r.Write(bytes.Repeat([]byte{lex.ItemSpace}, padding))
+		}
+	}
+}
+
+func (r *FormatRenderer) renderTableCellClose(node *ast.Node, padding int) {
+	if !r.Options.ProtyleWYSIWYG {
+		switch node.TableCellAlign {
+		case 2:
+			r.Write(bytes.Repeat([]byte{lex.ItemSpace}, padding-padding/2))
+		case 3:
+		default:
+			r.Write(bytes.Repeat([]byte{lex.ItemSpace}, padding))
+		}
+		r.WriteByte(lex.ItemSpace)
+	}
+}
+
+// tableCellPadding 返回单元格 node 内容宽度为 width 时需要补齐的空格数，跨列单元格需要补齐到所跨各列的总宽度。
+func (r *FormatRenderer) tableCellPadding(node *ast.Node, width int) (ret int) {
+	maxWidth := node.TableCellContentMaxWidth
+	for i, next := 1, node.Next; i < node.TableCellColspan && nil != next; i, next = i+1, next.Next {
+		maxWidth += next.TableCellContentMaxWidth + 2 // 被合并的单元格只输出一个 |，其余两个字符由跨列单元格补齐
+	}
+	if ret = maxWidth - width; 0 > ret {
+		ret = 0
+	}
+	return
+}
+
+// tableCellLines 渲染单元格 node 的内容并按硬换行切分为多行。
+func (r *FormatRenderer) tableCellLines(node *ast.Node) (ret []string) {
+	writer, lastOut := r.Writer, r.LastOut
+	r.Writer = &bytes.Buffer{}
+	// 列表等节点会在 NodeWriterStack 中查找父节点的输出缓冲，所以需要一并入栈
+	r.NodeWriterStack = append(r.NodeWriterStack, r.Writer)
+	for c := node.FirstChild; nil != c; c = c.Next {
+		r.renderNode(c)
+	}
+	ret = strings.Split(r.NodeWriterStack[len(r.NodeWriterStack)-1].String(), "\n")
+	r.NodeWriterStack = r.NodeWriterStack[:len(r.NodeWriterStack)-1]
+	r.Writer, r.LastOut = writer, lastOut
+	return
+}
+
+// extendedTable 判断是否打开了扩展表格支持。
+func (r *FormatRenderer) extendedTable() bool {
+	return nil != r.Tree.Context && r.Tree.Context.ParseOption.ExtendedTable
+}
+
+// multilineTableRow 判断扩展表格行 row 的单元格中是否包含硬换行，包含的话需要使用 \ 续行输出。
+func (r *FormatRenderer) multilineTableRow(row *ast.Node) (ret bool) {
+	if !r.extendedTable() {
+		return
+	}
+	ast.Walk(row, func(n *ast.Node, entering bool) ast.WalkStatus {
+		if entering && ast.NodeHardBreak == n.Type {
+			ret = true
+			return ast.WalkStop
+		}
+		return ast.WalkContinue
+	})
+	return
+}
+
 func (r *FormatRenderer) renderTableRow(node *ast.Node, entering bool) ast.WalkStatus {
-	if !entering {
+	if entering {
+		if r.multilineTableRow(node) {
+			r.renderMultilineTableRow(node)
+			return ast.WalkSkipChildren
+		}
+	} else {
 		r.WriteString("|\n")
 	}
 	return ast.WalkContinue
 }
 
+// renderMultilineTableRow 使用 \ 续行输出包含硬换行的表格行 row，最后一行结尾的 | 由 renderTableRow 输出。
+func (r *FormatRenderer) renderMultilineTableRow(row *ast.Node) {
+	var cellsLines [][]string
+	lineCnt := 1
+	for cell := row.FirstChild; nil != cell; cell = cell.Next {
+		var lines []string
+		if ast.NodeTableCell == cell.Type && 0 == cell.TableCellMerged {
+			lines = r.tableCellLines(cell)
+		}
+		if lineCnt < len(lines) {
+			lineCnt = len(lines)
+		}
+		cellsLines = append(cellsLines, lines)
+	}
+
+	for i := 0; i < lineCnt; i++ {
+		if 0 < i {
+			r.WriteString("| \\\n")
+		}
+		cellIndex := 0
+		for cell := row.FirstChild; nil != cell; cell, cellIndex = cell.Next, cellIndex+1 {
+			if ast.NodeTableCell != cell.Type {
+				if 0 == i {
+					r.renderNode(cell)
+				}
+				continue
+			}
+			if 1 == cell.TableCellMerged {
+				r.WriteByte(lex.ItemPipe)
+				continue
+			}
+
+			var line string
+			if lines := cellsLines[cellIndex]; i < len(lines) {
+				line = lines[i]
+			} else if 0 == i && 2 == cell.TableCellMerged {
+				line = "^^"
+			}
+			padding := r.tableCellPadding(cell, util.DisplayWidth(line))
+			r.renderTableCellOpen(cell, padding)
+			r.WriteString(line)
+			r.renderTableCellClose(cell, padding)
+		}
+	}
+}
+
+// tableCellWidth 返回单元格 node 的内容宽度，包含多行时返回最宽一行的宽度。
+func (r *FormatRenderer) tableCellWidth(node *ast.Node) (ret int) {
+	switch node.TableCellMerged {
+	case 1:
+		return 0
+	case 2:
+		return 2
+	}
+
+	for _, line := range r.tableCellLines(node) {
+		if width := util.DisplayWidth(line); ret < width {
+			ret = width
+		}
+	}
+	return
+}
+
 func (r *FormatRenderer) renderTableHead(node *ast.Node, entering bool) ast.WalkStatus {
 	if !entering {
 		headRow := node.FirstChild
@@ -948,20 +1182,10 @@ func (r *FormatRenderer) renderTable(node *ast.Node, entering bool) ast.WalkStat
 		var maxWidth int
 		for col := 0; col < len(cells[0]); col++ {
 			for row := 0; row < len(cells) && col < len(cells[row]); row++ {
-				cells[row][col].TableCellContentWidth = cells[row][col].TokenLen()
-				// 自动添加空格会导致单元格宽度发生变化
-				if r.Options.AutoSpace {
-					ret := 0
-					// 遍历字节点，将可能会多出来的空格计算出来
-					ast.Walk(cells[row][col], func(n *ast.Node, entering bool) ast.WalkStatus {
-						if !entering {
-							return ast.WalkContinue
-						}
-						// 空格仅一个字节，可以直接计算长度
-						ret += len(r.Space(n.Tokens)) - len(n.Tokens)
-						return ast.WalkContinue
-					})
-					cells[row][col].TableCellContentWidth += ret
+				cells[row][col].TableCellContentWidth = r.tableCellWidth(cells[row][col])
+				if 1 < cells[row][col].TableCellColspan || 1 == cells[row][col].TableCellMerged {
+					// 跨列单元格的宽度由所跨各列分摊，不计入当前列宽度
+					continue
 				}
 				if maxWidth < cells[row][col].TableCellContentWidth {
 					maxWidth = cells[row][col].TableCellContentWidth
@@ -972,7 +1196,21 @@ func (r *FormatRenderer) renderTable(node *ast.Node, entering bool) ast.WalkStat
 			}
 			maxWidth = 0
 		}
+		if r.formatStyle().CompactTable {
+			// 不对齐列宽时单元格不补齐空格，分隔行使用最短的 -
+			for _, row := range cells {
+				for _, cell := range row {
+					cell.TableCellContentMaxWidth = 0
+				}
+			}
+		}
 	} else {
+		if "" != node.TableCaption {
+			r.WriteString("[" + node.TableCaption + "]\n")
+		}
+		if label := crossRefLabelMarkdown(node); "" != label {
+			r.WriteString(label + "\n")
+		}
 		r.Newline()
 		if !r.isLastNode(r.Tree.Root, node) {
 			if r.withoutKramdownBlockIAL(node) {
@@ -1203,7 +1441,21 @@ func (r *FormatRenderer) renderDocument(node *ast.Node, entering bool) ast.WalkS
 }
 
 func (r *FormatRenderer) renderParagraph(node *ast.Node, entering bool) ast.WalkStatus {
-	if !entering {
+	if entering {
+		if r.proseWrappable(node) {
+			r.proseWrapping = true
+			r.Writer = &bytes.Buffer{}
+			r.NodeWriterStack = append(r.NodeWriterStack, r.Writer)
+		}
+	} else {
+		if r.proseWrapping {
+			r.proseWrapping = false
+			writer := r.NodeWriterStack[len(r.NodeWriterStack)-1]
+			r.NodeWriterStack = r.NodeWriterStack[:len(r.NodeWriterStack)-1]
+			r.Writer = r.NodeWriterStack[len(r.NodeWriterStack)-1]
+			r.WriteString(r.proseWrap(node, writer.String()))
+		}
+
 		if !r.Options.KeepParagraphBeginningSpace && nil != node.FirstChild {
 			node.FirstChild.Tokens = bytes.TrimSpace(node.FirstChild.Tokens)
 		}
@@ -1236,6 +1488,8 @@ func (r *FormatRenderer) renderParagraph(node *ast.Node, entering bool) ast.Walk
 				} else {
 					inTightList = true
 				}
+			} else if ast.NodeDefinitionDesc == parent.Type && nil != parent.Parent { // DefinitionDesc.Paragraph
+				inTightList = parent.Parent.ListData.Tight
 			}
 		}
 
@@ -1260,6 +1514,9 @@ func (r *FormatRenderer) renderText(node *ast.Node, entering bool) ast.WalkStatu
 		if r.Options.FixTermTypo {
 			tokens = r.FixTermTypo(tokens)
 		}
+		if r.Options.EmojiUnicode2Alias {
+			tokens = parse.EmojiUnicode2Alias(tokens, r.Tree.Context.ParseOption.EmojiAlias)
+		}
 		if (nil == node.Previous || ast.NodeTaskListItemMarker == node.Previous.Type) &&
 			nil != node.Parent.Parent && nil != node.Parent.Parent.ListData && 3 == node.Parent.Parent.ListData.Typ {
 			if ' ' == r.LastOut {
@@ -1270,11 +1527,26 @@ func (r *FormatRenderer) renderText(node *ast.Node, entering bool) ast.WalkStatu
 				}
 			}
 		}
+		if r.Tree.Context.ParseOption.DefinitionList && r.definitionMarkerText(node, tokens) {
+			// 段落行首的 : 会被解析为定义列表的描述标记符，需要转义
+			r.WriteByte(lex.ItemBackslash)
+		}
 		r.Write(tokens)
 	}
 	return ast.WalkContinue
 }
 
+// definitionMarkerText 判断段落中的文本节点 node 是否位于行首并且以定义列表描述标记符 : 开头。
+func (r *FormatRenderer) definitionMarkerText(node *ast.Node, tokens []byte) bool {
+	if nil == node.Parent || ast.NodeParagraph != node.Parent.Type || 1 > len(tokens) || ':' != tokens[0] {
+		return false
+	}
+	if prev := node.Previous; nil != prev && ast.NodeSoftBreak != prev.Type && ast.NodeHardBreak != prev.Type {
+		return false
+	}
+	return 1 == len(tokens) || ' ' == tokens[1] || '\t' == tokens[1]
+}
+
 func (r *FormatRenderer) renderCodeSpan(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		if r.Options.AutoSpace {
@@ -1387,6 +1659,9 @@ func (r *FormatRenderer) renderInlineMathCloseMarker(node *ast.Node, entering bo
 func (r *FormatRenderer) renderMathBlockCloseMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.Write(parse.MathBlockMarker)
+		if label := crossRefLabelMarkdown(node.Parent); "" != label {
+			r.WriteString(" " + label)
+		}
 		r.WriteByte(lex.ItemNewline)
 	}
 	return ast.WalkContinue
@@ -1421,8 +1696,14 @@ func (r *FormatRenderer) renderMathBlock(node *ast.Node, entering bool) ast.Walk
 func (r *FormatRenderer) renderCodeBlockCloseMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.Newline()
-		r.Write(node.Tokens)
+		if 0 < len(node.Tokens) {
+			r.Write(r.codeBlockFence(node.Parent, node.Tokens))
+		}
 		r.Newline()
+		if nil != parse.PandocAttributes(node.Parent) && !r.pandocAttributesStyle(node.Parent) {
+			r.WriteString(pandocAttributesMarkdown(node.Parent, false))
+			r.Newline()
+		}
 		if !r.isLastNode(r.Tree.Root, node) {
 			if r.withoutKramdownBlockIAL(node.Parent) {
 				r.WriteByte(lex.ItemNewline)
@@ -1442,6 +1723,12 @@ func (r *FormatRenderer) renderCodeBlockCode(node *ast.Node, entering bool) ast.
 func (r *FormatRenderer) renderCodeBlockInfoMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.Write(node.CodeBlockInfo)
+		if nil != parse.PandocAttributes(node.Parent) && r.pandocAttributesStyle(node.Parent) {
+			if 0 < len(node.CodeBlockInfo) {
+				r.WriteByte(lex.ItemSpace)
+			}
+			r.WriteString(pandocAttributesMarkdown(node.Parent, true))
+		}
 		r.WriteByte(lex.ItemNewline)
 	}
 	return ast.WalkContinue
@@ -1449,7 +1736,7 @@ func (r *FormatRenderer) renderCodeBlockInfoMarker(node *ast.Node, entering bool
 
 func (r *FormatRenderer) renderCodeBlockOpenMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
-		r.Write(node.Tokens)
+		r.Write(r.codeBlockFence(node.Parent, node.Tokens))
 	}
 	return ast.WalkContinue
 }
@@ -1458,10 +1745,11 @@ func (r *FormatRenderer) renderCodeBlock(node *ast.Node, entering bool) ast.Walk
 	if entering {
 		r.Newline()
 		if !node.IsFencedCodeBlock {
-			r.Write(bytes.Repeat([]byte{lex.ItemBacktick}, 3))
+			fence := r.codeBlockFence(node, bytes.Repeat([]byte{lex.ItemBacktick}, 3))
+			r.Write(fence)
 			r.WriteByte(lex.ItemNewline)
 			r.Write(node.FirstChild.Tokens)
-			r.Write(bytes.Repeat([]byte{lex.ItemBacktick}, 3))
+			r.Write(fence)
 			r.Newline()
 			if !r.isLastNode(r.Tree.Root, node) {
 				if r.withoutKramdownBlockIAL(node) {
@@ -1485,28 +1773,28 @@ func (r *FormatRenderer) renderEmphasis(node *ast.Node, entering bool) ast.WalkS
 
 func (r *FormatRenderer) renderEmAsteriskOpenMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
-		r.WriteByte(lex.ItemAsterisk)
+		r.WriteByte(r.emphasisMarker(node.Parent, lex.ItemAsterisk))
 	}
 	return ast.WalkContinue
 }
 
 func (r *FormatRenderer) renderEmAsteriskCloseMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
-		r.WriteByte(lex.ItemAsterisk)
+		r.WriteByte(r.emphasisMarker(node.Parent, lex.ItemAsterisk))
 	}
 	return ast.WalkContinue
 }
 
 func (r *FormatRenderer) renderEmUnderscoreOpenMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
-		r.WriteByte(lex.ItemUnderscore)
+		r.WriteByte(r.emphasisMarker(node.Parent, lex.ItemUnderscore))
 	}
 	return ast.WalkContinue
 }
 
 func (r *FormatRenderer) renderEmUnderscoreCloseMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
-		r.WriteByte(lex.ItemUnderscore)
+		r.WriteByte(r.emphasisMarker(node.Parent, lex.ItemUnderscore))
 	}
 	return ast.WalkContinue
 }
@@ -1522,28 +1810,28 @@ func (r *FormatRenderer) renderStrong(node *ast.Node, entering bool) ast.WalkSta
 
 func (r *FormatRenderer) renderStrongA6kOpenMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
-		r.WriteString("**")
+		r.Write(bytes.Repeat([]byte{r.emphasisMarker(node.Parent, lex.ItemAsterisk)}, 2))
 	}
 	return ast.WalkContinue
 }
 
 func (r *FormatRenderer) renderStrongA6kCloseMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
-		r.WriteString("**")
+		r.Write(bytes.Repeat([]byte{r.emphasisMarker(node.Parent, lex.ItemAsterisk)}, 2))
 	}
 	return ast.WalkContinue
 }
 
 func (r *FormatRenderer) renderStrongU8eOpenMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
-		r.WriteString("__")
+		r.Write(bytes.Repeat([]byte{r.emphasisMarker(node.Parent, lex.ItemUnderscore)}, 2))
 	}
 	return ast.WalkContinue
 }
 
 func (r *FormatRenderer) renderStrongU8eCloseMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
-		r.WriteString("__")
+		r.Write(bytes.Repeat([]byte{r.emphasisMarker(node.Parent, lex.ItemUnderscore)}, 2))
 	}
 	return ast.WalkContinue
 }
@@ -1552,6 +1840,9 @@ func (r *FormatRenderer) renderBlockquote(node *ast.Node, entering bool) ast.Wal
 	if entering {
 		r.Writer = &bytes.Buffer{}
 		r.NodeWriterStack = append(r.NodeWriterStack, r.Writer)
+		if ast.NodeCallout == node.Type {
+			r.WriteString(calloutMarker(node) + "\n")
+		}
 	} else {
 		writer := r.NodeWriterStack[len(r.NodeWriterStack)-1]
 		r.NodeWriterStack = r.NodeWriterStack[:len(r.NodeWriterStack)-1]
@@ -1571,7 +1862,7 @@ func (r *FormatRenderer) renderBlockquote(node *ast.Node, entering bool) ast.Wal
 		}
 
 		length = len(lines)
-		for _, line := range lines {
+		for i, line := range lines {
 			if 0 == len(line) {
 				blockquoteLines.WriteString(">\n")
 				continue
@@ -1582,6 +1873,12 @@ func (r *FormatRenderer) renderBlockquote(node *ast.Node, entering bool) ast.Wal
 			} else {
 				blockquoteLines.WriteString("> ")
 			}
+			if 0 == i && ast.NodeBlockquote == node.Type && r.Tree.Context.ParseOption.Callout {
+				if callout, _, _, _ := parse.ParseCalloutMarker(line); callout {
+					// 普通块引用首行的 [!NOTE] 需要转义，否则会被解析为提示块
+					blockquoteLines.WriteByte(lex.ItemBackslash)
+				}
+			}
 			blockquoteLines.Write(line)
 			blockquoteLines.WriteByte(lex.ItemNewline)
 		}
@@ -1608,12 +1905,12 @@ func (r *FormatRenderer) renderBlockquoteMarker(node *ast.Node, entering bool) a
 
 func (r *FormatRenderer) renderHeading(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
-		if !node.HeadingSetext {
+		if !r.headingSetext(node) {
 			r.Write(bytes.Repeat([]byte{lex.ItemCrosshatch}, node.HeadingLevel))
 			r.WriteByte(lex.ItemSpace)
 		}
 	} else {
-		if node.HeadingSetext {
+		if r.headingSetext(node) {
 			r.WriteByte(lex.ItemNewline)
 			contentLen := r.setextHeadingLen(node)
 			if 1 == node.HeadingLevel {
@@ -1702,11 +1999,7 @@ func (r *FormatRenderer) renderListItem(node *ast.Node, entering bool) ast.WalkS
 		}
 
 		listItemBuf := bytes.Buffer{}
-		if 1 == node.ListData.Typ || (3 == node.ListData.Typ && 0 == node.ListData.BulletChar) {
-			listItemBuf.WriteString(strconv.Itoa(node.ListData.Num) + string(node.ListData.Delimiter))
-		} else {
-			listItemBuf.Write(node.ListData.Marker)
-		}
+		listItemBuf.WriteString(r.listItemMarker(node))
 		listItemBuf.WriteByte(lex.ItemSpace)
 		buf = append(listItemBuf.Bytes(), buf...)
 		if node.ParentIs(ast.NodeTableCell) {
@@ -1730,13 +2023,52 @@ func (r *FormatRenderer) renderListItem(node *ast.Node, entering bool) ast.WalkS
 	return ast.WalkContinue
 }
 
+func (r *FormatRenderer) renderDefinitionTerm(node *ast.Node, entering bool) ast.WalkStatus {
+	if !entering {
+		r.WriteByte(lex.ItemNewline)
+		if next := node.Next; nil != next && ast.NodeDefinitionDesc == next.Type && !node.Parent.ListData.Tight {
+			r.WriteByte(lex.ItemNewline)
+		}
+	}
+	return ast.WalkContinue
+}
+
+func (r *FormatRenderer) renderDefinitionDesc(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Writer = &bytes.Buffer{}
+		r.NodeWriterStack = append(r.NodeWriterStack, r.Writer)
+	} else {
+		writer := r.NodeWriterStack[len(r.NodeWriterStack)-1]
+		r.NodeWriterStack = r.NodeWriterStack[:len(r.NodeWriterStack)-1]
+		r.Writer = r.NodeWriterStack[len(r.NodeWriterStack)-1]
+
+		// 描述内容缩进两个空格对齐到 : 后面
+		buf := bytes.TrimLeft(bytes.TrimRight(writer.Bytes(), " \t\n"), "\n")
+		r.WriteString(": ")
+		for i, line := range bytes.Split(buf, []byte{lex.ItemNewline}) {
+			if 0 < i {
+				r.WriteByte(lex.ItemNewline)
+				if 0 < len(line) {
+					r.WriteString("  ")
+				}
+			}
+			r.Write(line)
+		}
+		r.WriteByte(lex.ItemNewline)
+		if next := node.Next; nil != next && (ast.NodeDefinitionTerm == next.Type || !node.Parent.ListData.Tight) {
+			r.WriteByte(lex.ItemNewline)
+		}
+	}
+	return ast.WalkContinue
+}
+
 func (r *FormatRenderer) renderTaskListItemMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.WriteByte(lex.ItemOpenBracket)
 		if node.TaskListItemChecked {
 			r.WriteByte('X')
 		} else {
-			r.WriteByte(lex.ItemSpace)
+			r.WriteByte(node.TaskState())
 		}
 		r.WriteByte(lex.ItemCloseBracket)
 	} else {
@@ -1750,7 +2082,7 @@ func (r *FormatRenderer) renderThematicBreak(node *ast.Node, entering bool) ast.
 		if node.ParentIs(ast.NodeTableCell) {
 			r.WriteString("<hr/>")
 		} else {
-			r.WriteString("---")
+			r.WriteString(r.thematicBreak())
 			if r.withoutKramdownBlockIAL(node) {
 				r.WriteByte(lex.ItemNewline)
 				r.WriteByte(lex.ItemNewline)
@@ -1762,7 +2094,10 @@ func (r *FormatRenderer) renderThematicBreak(node *ast.Node, entering bool) ast.
 
 func (r *FormatRenderer) renderHardBreak(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
-		if !r.Options.SoftBreak2HardBreak {
+		if node.ParentIs(ast.NodeTableCell) && r.extendedTable() {
+			// 扩展表格单元格中的硬换行由 renderMultilineTableRow 切分为续行
+			r.WriteByte(lex.ItemNewline)
+		} else if !r.Options.SoftBreak2HardBreak {
 			r.WriteString("\\\n")
 		} else {
 			if node.ParentIs(ast.NodeTableCell) {
@@ -1777,6 +2112,10 @@ func (r *FormatRenderer) renderHardBreak(node *ast.Node, entering bool) ast.Walk
 
 func (r *FormatRenderer) renderSoftBreak(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
+		if r.proseWrapping {
+			r.Writer.WriteByte(proseSoftBreak)
+			return ast.WalkContinue
+		}
 		r.Newline()
 	}
 	return ast.WalkContinue
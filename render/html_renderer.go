@@ -12,6 +12,7 @@ package render
 
 import (
 	"bytes"
+	"io"
 	"strconv"
 	"strings"
 	"unicode"
@@ -64,12 +65,17 @@ func NewHtmlRenderer(tree *parse.Tree, options *Options) *HtmlRenderer {
 	ret.RendererFuncs[ast.NodeStrongU8eOpenMarker] = ret.renderStrongU8eOpenMarker
 	ret.RendererFuncs[ast.NodeStrongU8eCloseMarker] = ret.renderStrongU8eCloseMarker
 	ret.RendererFuncs[ast.NodeBlockquote] = ret.renderBlockquote
+	ret.RendererFuncs[ast.NodeCallout] = ret.renderCallout
+	ret.RendererFuncs[ast.NodeFencedDiv] = ret.renderFencedDiv
 	ret.RendererFuncs[ast.NodeBlockquoteMarker] = ret.renderBlockquoteMarker
 	ret.RendererFuncs[ast.NodeHeading] = ret.renderHeading
 	ret.RendererFuncs[ast.NodeHeadingC8hMarker] = ret.renderHeadingC8hMarker
 	ret.RendererFuncs[ast.NodeHeadingID] = ret.renderHeadingID
 	ret.RendererFuncs[ast.NodeList] = ret.renderList
 	ret.RendererFuncs[ast.NodeListItem] = ret.renderListItem
+	ret.RendererFuncs[ast.NodeDefinitionList] = ret.renderDefinitionList
+	ret.RendererFuncs[ast.NodeDefinitionTerm] = ret.renderDefinitionTerm
+	ret.RendererFuncs[ast.NodeDefinitionDesc] = ret.renderDefinitionDesc
 	ret.RendererFuncs[ast.NodeThematicBreak] = ret.renderThematicBreak
 	ret.RendererFuncs[ast.NodeHardBreak] = ret.renderHardBreak
 	ret.RendererFuncs[ast.NodeSoftBreak] = ret.renderSoftBreak
@@ -118,6 +124,10 @@ func NewHtmlRenderer(tree *parse.Tree, options *Options) *HtmlRenderer {
 	ret.RendererFuncs[ast.NodeYamlFrontMatterContent] = ret.renderYamlFrontMatterContent
 	ret.RendererFuncs[ast.NodeYamlFrontMatterCloseMarker] = ret.renderYamlFrontMatterCloseMarker
 	ret.RendererFuncs[ast.NodeBlockRef] = ret.renderBlockRef
+	ret.RendererFuncs[ast.NodeWikiLink] = ret.renderWikiLink
+	ret.RendererFuncs[ast.NodeCrossRef] = ret.renderCrossRef
+	ret.RendererFuncs[ast.NodeCitation] = ret.renderCitation
+	ret.RendererFuncs[ast.NodeBracketedSpan] = ret.renderBracketedSpan
 	ret.RendererFuncs[ast.NodeBlockRefID] = ret.renderBlockRefID
 	ret.RendererFuncs[ast.NodeBlockRefSpace] = ret.renderBlockRefSpace
 	ret.RendererFuncs[ast.NodeBlockRefText] = ret.renderBlockRefText
@@ -173,10 +183,62 @@ func NewHtmlRenderer(tree *parse.Tree, options *Options) *HtmlRenderer {
 
 func (r *HtmlRenderer) Render() (output []byte) {
 	output = r.BaseRenderer.Render()
+	output = append(output, r.RenderBibliography()...)
 	output = append(output, r.RenderFootnotes()...)
 	return
 }
 
+// RenderStream 依次渲染 stream 产出的顶层块，每渲染完一个块就将结果写入 writer，最后写入参考文献列表和脚注定义。
+// 渲染器需要使用 stream.Tree 构造。
+func (r *HtmlRenderer) RenderStream(writer io.Writer, stream *parse.Stream) (err error) {
+	r.LastOut = lex.ItemNewline
+	r.Writer = &bytes.Buffer{}
+	r.Writer.Grow(4096)
+	var block *ast.Node
+	for {
+		if block, err = stream.Next(); nil != err {
+			if io.EOF != err {
+				return
+			}
+			break
+		}
+
+		r.Writer.Reset()
+		r.renderNode(block)
+		if _, err = writer.Write(r.Writer.Bytes()); nil != err {
+			return
+		}
+	}
+	if _, err = writer.Write(r.RenderBibliography()); nil != err {
+		return
+	}
+	_, err = writer.Write(r.RenderFootnotes())
+	return
+}
+
+// RenderBibliography 渲染已引用文献的参考文献列表，没有设置文献库或者没有引用时返回 nil。
+func (r *HtmlRenderer) RenderBibliography() []byte {
+	entries := r.citedBibliography()
+	if 1 > len(entries) {
+		return nil
+	}
+
+	emph := func(text string) string { return "<em>" + html.EscapeHTMLStr(text) + "</em>" }
+	buf := bytes.Buffer{}
+	buf.WriteString("<div id=\"refs\" class=\"references\">\n")
+	buf.WriteString("<h2>" + html.EscapeHTMLStr(r.bibliographyTitle()) + "</h2>\n")
+	for _, entry := range entries {
+		buf.WriteString("<div id=\"ref-" + html.EscapeHTMLStr(entry.ID) + "\" class=\"csl-entry\">")
+		if r.citationNumeric() {
+			buf.WriteString("[" + strconv.Itoa(r.citationNums[entry.ID]) + "] ")
+		}
+		buf.WriteString(r.bibEntryText(entry, html.EscapeHTMLStr, emph))
+		buf.WriteString("</div>\n")
+	}
+	buf.WriteString("</div>\n")
+	return buf.Bytes()
+}
+
 func (r *HtmlRenderer) renderCustomBlock(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.Newline()
@@ -380,6 +442,21 @@ func (r *HtmlRenderer) renderGitConflict(node *ast.Node, entering bool) ast.Walk
 	return ast.WalkContinue
 }
 
+func (r *HtmlRenderer) renderFencedDiv(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Newline()
+		attrs := append([][]string{}, node.KramdownIAL...)
+		r.renderSourcePos(node, &attrs)
+		r.Tag("div", attrs, false)
+		r.Newline()
+	} else {
+		r.Newline()
+		r.Tag("/div", nil, false)
+		r.Newline()
+	}
+	return ast.WalkContinue
+}
+
 func (r *HtmlRenderer) renderSuperBlock(node *ast.Node, entering bool) ast.WalkStatus {
 	return ast.WalkContinue
 }
@@ -638,6 +715,10 @@ func (r *HtmlRenderer) renderToC(node *ast.Node, entering bool) ast.WalkStatus {
 func (r *HtmlRenderer) renderFootnotesRef(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		idx, _ := r.Tree.FindFootnotesDef(node.Tokens)
+		if refIdx, err := strconv.Atoi(strings.SplitN(node.FootnotesRefId, ":", 2)[0]); nil == err {
+			// 流式解析时后面的行级脚注还没有插入匿名定义，以解析时计算的 id 为准
+			idx = refIdx
+		}
 		idxStr := strconv.Itoa(idx)
 		r.Tag("sup", [][]string{{"class", "footnotes-ref"}, {"id", "footnotes-ref-" + node.FootnotesRefId}}, false)
 		r.Tag("a", [][]string{{"href", r.Options.LinkBase + "#footnotes-def-" + idxStr}}, false)
@@ -773,6 +854,9 @@ func (r *HtmlRenderer) renderMathBlockCloseMarker(node *ast.Node, entering bool)
 func (r *HtmlRenderer) renderMathBlockContent(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.Write(html.EscapeHTML(node.Tokens))
+		if 0 < node.Parent.CrossRefNum {
+			r.WriteString(" \\tag{" + strconv.Itoa(node.Parent.CrossRefNum) + "}")
+		}
 	}
 	return ast.WalkContinue
 }
@@ -787,12 +871,20 @@ func (r *HtmlRenderer) renderMathBlock(node *ast.Node, entering bool) ast.WalkSt
 		attrs := [][]string{{"class", "language-math"}}
 		r.handleKramdownBlockIAL(node)
 		attrs = append(attrs, node.KramdownIAL...)
+		if id := crossRefID(node); "" != id {
+			attrs = append(attrs, []string{"id", id})
+		}
+		r.renderSourcePos(node, &attrs)
 		r.Tag("div", attrs, false)
 	}
 	return ast.WalkContinue
 }
 
 func (r *HtmlRenderer) renderTableCell(node *ast.Node, entering bool) ast.WalkStatus {
+	if 0 != node.TableCellMerged { // 被合并的单元格不输出
+		return ast.WalkSkipChildren
+	}
+
 	tag := "td"
 	if ast.NodeTableHead == node.Parent.Parent.Type {
 		tag = "th"
@@ -807,6 +899,13 @@ func (r *HtmlRenderer) renderTableCell(node *ast.Node, entering bool) ast.WalkSt
 		case 3:
 			attrs = append(attrs, []string{"align", "right"})
 		}
+		if 1 < node.TableCellColspan {
+			attrs = append(attrs, []string{"colspan", strconv.Itoa(node.TableCellColspan)})
+		}
+		if 1 < node.TableCellRowspan {
+			attrs = append(attrs, []string{"rowspan", strconv.Itoa(node.TableCellRowspan)})
+		}
+		r.renderSourcePos(node, &attrs)
 		r.Tag(tag, attrs, false)
 	} else {
 		r.Tag("/"+tag, nil, false)
@@ -817,7 +916,9 @@ func (r *HtmlRenderer) renderTableCell(node *ast.Node, entering bool) ast.WalkSt
 
 func (r *HtmlRenderer) renderTableRow(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
-		r.Tag("tr", nil, false)
+		var attrs [][]string
+		r.renderSourcePos(node, &attrs)
+		r.Tag("tr", attrs, false)
 		r.Newline()
 	} else {
 		r.Tag("/tr", nil, false)
@@ -844,8 +945,24 @@ func (r *HtmlRenderer) renderTableHead(node *ast.Node, entering bool) ast.WalkSt
 func (r *HtmlRenderer) renderTable(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.handleKramdownBlockIAL(node)
-		r.Tag("table", node.KramdownIAL, false)
+		var attrs [][]string
+		attrs = append(attrs, node.KramdownIAL...)
+		if id := crossRefID(node); "" != id {
+			attrs = append(attrs, []string{"id", id})
+		}
+		r.renderSourcePos(node, &attrs)
+		r.Tag("table", attrs, false)
 		r.Newline()
+		caption := node.TableCaption
+		if 0 < node.CrossRefNum {
+			caption = r.crossRefCaption(node, caption)
+		}
+		if "" != caption {
+			r.Tag("caption", nil, false)
+			r.WriteString(html.EscapeHTMLStr(caption))
+			r.Tag("/caption", nil, false)
+			r.Newline()
+		}
 	} else {
 		if nil != node.FirstChild.Next {
 			r.Tag("/tbody", nil, false)
@@ -914,6 +1031,9 @@ func (r *HtmlRenderer) renderLinkText(node *ast.Node, entering bool) ast.WalkSta
 		} else {
 			tokens = node.Tokens
 		}
+		if r.Options.SmartPunct && 2 != node.Parent.LinkType {
+			tokens = r.SmartPunct(node, tokens)
+		}
 		r.Write(html.EscapeHTML(tokens))
 	}
 	return ast.WalkContinue
@@ -992,6 +1112,11 @@ func (r *HtmlRenderer) renderImage(node *ast.Node, entering bool) ast.WalkStatus
 		if "" != ial {
 			r.WriteString(" " + ial)
 		}
+		if id := crossRefID(node); "" != id && node != crossRefFigure(node.Parent) {
+			r.WriteString(" id=\"" + id + "\"")
+		} else if id = node.IALAttr("id"); "" != id && nil != parse.PandocAttributes(node) {
+			r.WriteString(" id=\"" + id + "\"")
+		}
 		r.WriteString(" />")
 		if style := node.IALAttr("style"); "" != style {
 			r.Tag("/span", nil, false)
@@ -1056,6 +1181,9 @@ func (r *HtmlRenderer) renderLink(node *ast.Node, entering bool) ast.WalkStatus
 		if title := node.ChildByType(ast.NodeLinkTitle); nil != title && nil != title.Tokens {
 			attrs = append(attrs, []string{"title", util.BytesToStr(html.EscapeHTML(title.Tokens))})
 		}
+		if nil != parse.PandocAttributes(node) {
+			attrs = append(attrs, node.KramdownIAL...)
+		}
 		r.Tag("a", attrs, false)
 	} else {
 		r.Tag("/a", nil, false)
@@ -1065,6 +1193,82 @@ func (r *HtmlRenderer) renderLink(node *ast.Node, entering bool) ast.WalkStatus
 	return ast.WalkContinue
 }
 
+func (r *HtmlRenderer) renderWikiLink(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		href, exists := r.wikiLinkHref(node)
+		if r.Options.Sanitize && strings.HasPrefix(strings.ToLower(strings.TrimSpace(href)), "javascript:") {
+			href = ""
+		}
+		class := "wikilink"
+		if !exists {
+			class += " wikilink-missing"
+		}
+		r.Tag("a", [][]string{{"href", html.EscapeHTMLStr(href)}, {"class", class}}, false)
+		r.WriteString(html.EscapeHTMLStr(wikiLinkText(node)))
+		r.Tag("/a", nil, false)
+	}
+	return ast.WalkContinue
+}
+
+func (r *HtmlRenderer) renderBracketedSpan(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Tag("span", node.KramdownIAL, false)
+	} else {
+		r.Tag("/span", nil, false)
+	}
+	return ast.WalkContinue
+}
+
+func (r *HtmlRenderer) renderCrossRef(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		if text := r.crossRefText(node); "" != text {
+			r.Tag("a", [][]string{{"href", "#" + node.CrossRefLabel}, {"class", "crossref"}}, false)
+			r.WriteString(html.EscapeHTMLStr(text))
+			r.Tag("/a", nil, false)
+		} else {
+			r.Tag("span", [][]string{{"class", "crossref crossref-unresolved"}}, false)
+			r.Write(html.EscapeHTML(node.Tokens))
+			r.Tag("/span", nil, false)
+		}
+	}
+	return ast.WalkContinue
+}
+
+func (r *HtmlRenderer) renderCitation(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		items, open, close, sep := r.citationItems(node)
+		var keys []string
+		class := "citation"
+		for i, citation := range node.Citations {
+			keys = append(keys, citation.Key)
+			if nil == items[i].entry {
+				class = "citation citation-unresolved"
+			}
+		}
+		r.Tag("span", [][]string{{"class", class}, {"data-cites", html.EscapeHTMLStr(strings.Join(keys, " "))}}, false)
+		r.WriteString(open)
+		for i, item := range items {
+			if 0 < i {
+				r.WriteString(sep)
+			}
+			if "" != item.prefix {
+				r.WriteString(html.EscapeHTMLStr(item.prefix) + " ")
+			}
+			if nil == item.entry || node.ParentIs(ast.NodeLink) {
+				// 链接中的引用不再嵌套链接
+				r.WriteString(html.EscapeHTMLStr(item.text))
+				continue
+			}
+			r.Tag("a", [][]string{{"href", "#ref-" + html.EscapeHTMLStr(item.entry.ID)}}, false)
+			r.WriteString(html.EscapeHTMLStr(item.text))
+			r.Tag("/a", nil, false)
+		}
+		r.WriteString(close)
+		r.Tag("/span", nil, false)
+	}
+	return ast.WalkContinue
+}
+
 func (r *HtmlRenderer) renderHTML(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.Newline()
@@ -1095,7 +1299,11 @@ func (r *HtmlRenderer) renderDocument(node *ast.Node, entering bool) ast.WalkSta
 }
 
 func (r *HtmlRenderer) renderParagraph(node *ast.Node, entering bool) ast.WalkStatus {
-	if grandparent := node.Parent.Parent; nil != grandparent && ast.NodeList == grandparent.Type && grandparent.ListData.Tight { // List.ListItem.Paragraph
+	if image := crossRefFigure(node); nil != image {
+		return r.renderFigure(node, image, entering)
+	}
+
+	if grandparent := node.Parent.Parent; nil != grandparent && (ast.NodeList == grandparent.Type || ast.NodeDefinitionList == grandparent.Type) && grandparent.ListData.Tight { // List.ListItem.Paragraph
 		return ast.WalkContinue
 	}
 
@@ -1107,6 +1315,7 @@ func (r *HtmlRenderer) renderParagraph(node *ast.Node, entering bool) ast.WalkSt
 		if r.Options.ChineseParagraphBeginningSpace && ast.NodeDocument == node.Parent.Type {
 			attrs = append(attrs, []string{"class", "indent--2"})
 		}
+		r.renderSourcePos(node, &attrs)
 		r.Tag("p", attrs, false)
 	} else {
 		r.Tag("/p", nil, false)
@@ -1115,6 +1324,31 @@ func (r *HtmlRenderer) renderParagraph(node *ast.Node, entering bool) ast.WalkSt
 	return ast.WalkContinue
 }
 
+// renderFigure 将仅包含一张已编号图片 image 的段落 node 渲染为带有标题的 figure。
+func (r *HtmlRenderer) renderFigure(node, image *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Newline()
+		r.handleKramdownBlockIAL(node)
+		var attrs [][]string
+		if id := crossRefID(image); "" != id {
+			attrs = append(attrs, []string{"id", id})
+		}
+		attrs = append(attrs, node.KramdownIAL...)
+		r.renderSourcePos(node, &attrs)
+		r.Tag("figure", attrs, false)
+		r.Newline()
+	} else {
+		r.Newline()
+		r.Tag("figcaption", nil, false)
+		r.WriteString(html.EscapeHTMLStr(r.crossRefCaption(image, image.Text())))
+		r.Tag("/figcaption", nil, false)
+		r.Newline()
+		r.Tag("/figure", nil, false)
+		r.Newline()
+	}
+	return ast.WalkContinue
+}
+
 func (r *HtmlRenderer) renderText(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		var tokens []byte
@@ -1127,6 +1361,9 @@ func (r *HtmlRenderer) renderText(node *ast.Node, entering bool) ast.WalkStatus
 		if r.Options.FixTermTypo {
 			tokens = r.FixTermTypo(tokens)
 		}
+		if r.Options.SmartPunct {
+			tokens = r.SmartPunct(node, tokens)
+		}
 		r.Write(html.EscapeHTML(tokens))
 	}
 	return ast.WalkContinue
@@ -1250,11 +1487,60 @@ func (r *HtmlRenderer) renderStrongU8eCloseMarker(node *ast.Node, entering bool)
 	return ast.WalkContinue
 }
 
+// renderCalloutTitle 渲染提示块 node 的标题，标题作为行级 Markdown 内容解析，没有设置标题时使用类型。
+func (r *HtmlRenderer) renderCalloutTitle(node *ast.Node) {
+	if "" == node.CalloutTitle || nil == r.Tree.Context {
+		r.WriteString(html.EscapeHTMLStr(calloutTitle(node)))
+		return
+	}
+
+	options := *r.Tree.Context.ParseOption
+	options.SourcePos = false
+	tree := parse.Inline("", []byte(node.CalloutTitle), &options)
+	titleRenderer := NewHtmlRenderer(tree, r.Options)
+	for c := tree.Root.FirstChild.FirstChild; nil != c; c = c.Next {
+		r.Write(titleRenderer.RenderNode(c))
+	}
+}
+
+func (r *HtmlRenderer) renderCallout(node *ast.Node, entering bool) ast.WalkStatus {
+	// 可折叠的提示块使用 <details> 渲染，- 表示默认折叠
+	tag, titleTag := "div", "p"
+	if "" != node.CalloutFold {
+		tag, titleTag = "details", "summary"
+	}
+	if entering {
+		r.Newline()
+		r.handleKramdownBlockIAL(node)
+		attrs := [][]string{{"class", "callout callout-" + strings.ToLower(node.CalloutType)}}
+		attrs = append(attrs, calloutAttrs(node)...)
+		if "+" == node.CalloutFold {
+			attrs = append(attrs, []string{"open", ""})
+		}
+		attrs = append(attrs, node.KramdownIAL...)
+		r.renderSourcePos(node, &attrs)
+		r.Tag(tag, attrs, false)
+		r.Newline()
+		r.Tag(titleTag, [][]string{{"class", "callout-title"}}, false)
+		r.renderCalloutTitle(node)
+		r.Tag("/"+titleTag, nil, false)
+		r.Newline()
+	} else {
+		r.Newline()
+		r.Tag("/"+tag, nil, false)
+		r.Newline()
+	}
+	return ast.WalkContinue
+}
+
 func (r *HtmlRenderer) renderBlockquote(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.Newline()
 		r.handleKramdownBlockIAL(node)
-		r.Tag("blockquote", node.KramdownIAL, false)
+		var attrs [][]string
+		attrs = append(attrs, node.KramdownIAL...)
+		r.renderSourcePos(node, &attrs)
+		r.Tag("blockquote", attrs, false)
 		r.Newline()
 	} else {
 		r.Newline()
@@ -1275,8 +1561,9 @@ func (r *HtmlRenderer) renderHeading(node *ast.Node, entering bool) ast.WalkStat
 		r.Newline()
 		level := headingLevel[node.HeadingLevel : node.HeadingLevel+1]
 		r.WriteString("<h" + level)
-		id := HeadingID(node)
-		if r.Options.ToC || r.Options.HeadingID || r.Options.KramdownBlockIAL {
+		id := r.headingID(node)
+		pandocAttrs := nil != parse.PandocAttributes(node)
+		if r.Options.ToC || r.Options.HeadingID || r.Options.KramdownBlockIAL || (pandocAttrs && "" != node.IALAttr("id")) {
 			r.WriteString(" id=\"" + id + "\"")
 			if r.Options.KramdownBlockIAL {
 				if "id" != r.Options.KramdownIALIDRenderName && 0 < len(node.KramdownIAL) {
@@ -1290,10 +1577,18 @@ func (r *HtmlRenderer) renderHeading(node *ast.Node, entering bool) ast.WalkStat
 				}
 			}
 		}
+		if pandocAttrs && !r.Options.KramdownBlockIAL {
+			for _, attr := range r.NodeAttrs(node) {
+				r.WriteString(" " + attr[0] + "=\"" + attr[1] + "\"")
+			}
+		}
+		if r.Options.SourcePos && nil != node.SourcePos {
+			r.WriteString(" data-sourcepos=\"" + node.SourcePos.String() + "\"")
+		}
 		r.WriteString(">")
 	} else {
 		if r.Options.HeadingAnchor {
-			id := HeadingID(node)
+			id := r.headingID(node)
 			r.Tag("a", [][]string{{"id", "vditorAnchor-" + id}, {"class", "vditor-anchor"}, {"href", "#" + id}}, false)
 			r.WriteString(`<svg viewBox="0 0 16 16" version="1.1" width="16" height="16"><path fill-rule="evenodd" d="M4 9h1v1H4c-1.5 0-3-1.69-3-3.5S2.55 3 4 3h4c1.45 0 3 1.69 3 3.5 0 1.41-.91 2.72-2 3.25V8.59c.58-.45 1-1.27 1-2.09C10 5.22 8.98 4 8 4H4c-.98 0-2 1.22-2 2.5S3 9 4 9zm9-3h-1v1h1c1 0 2 1.22 2 2.5S13.98 12 13 12H9c-.98 0-2-1.22-2-2.5 0-.83.42-1.64 1-2.09V6.25c-1.09.53-2 1.84-2 3.25C6 11.31 7.55 13 9 13h4c1.45 0 3-1.69 3-3.5S14.5 6 13 6z"></path></svg>`)
 			r.Tag("/a", nil, false)
@@ -1326,6 +1621,7 @@ func (r *HtmlRenderer) renderList(node *ast.Node, entering bool) ast.WalkStatus
 		}
 		r.handleKramdownBlockIAL(node)
 		attrs = append(attrs, node.KramdownIAL...)
+		r.renderSourcePos(node, &attrs)
 		r.Tag(tag, attrs, false)
 		r.Newline()
 	} else {
@@ -1351,9 +1647,14 @@ func (r *HtmlRenderer) renderListItem(node *ast.Node, entering bool) ast.WalkSta
 			taskClass := r.Options.GFMTaskListItemClass
 			if taskListItemMarker.TaskListItemChecked {
 				taskClass += " vditor-task--done"
+			} else if state := taskListItemMarker.TaskState(); parse.IsExtendedTaskState(state) {
+				if name := parse.TaskStateName(state); "" != name {
+					taskClass += " vditor-task--" + name
+				}
 			}
 			attrs = append(attrs, []string{"class", taskClass})
 		}
+		r.renderSourcePos(node, &attrs)
 		r.Tag("li", attrs, false)
 	} else {
 		r.Tag("/li", nil, false)
@@ -1362,11 +1663,56 @@ func (r *HtmlRenderer) renderListItem(node *ast.Node, entering bool) ast.WalkSta
 	return ast.WalkContinue
 }
 
+func (r *HtmlRenderer) renderDefinitionList(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Newline()
+		var attrs [][]string
+		r.handleKramdownBlockIAL(node)
+		attrs = append(attrs, node.KramdownIAL...)
+		r.renderSourcePos(node, &attrs)
+		r.Tag("dl", attrs, false)
+		r.Newline()
+	} else {
+		r.Newline()
+		r.Tag("/dl", nil, false)
+		r.Newline()
+	}
+	return ast.WalkContinue
+}
+
+func (r *HtmlRenderer) renderDefinitionTerm(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Newline()
+		var attrs [][]string
+		r.renderSourcePos(node, &attrs)
+		r.Tag("dt", attrs, false)
+	} else {
+		r.Tag("/dt", nil, false)
+		r.Newline()
+	}
+	return ast.WalkContinue
+}
+
+func (r *HtmlRenderer) renderDefinitionDesc(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Newline()
+		var attrs [][]string
+		r.renderSourcePos(node, &attrs)
+		r.Tag("dd", attrs, false)
+	} else {
+		r.Tag("/dd", nil, false)
+		r.Newline()
+	}
+	return ast.WalkContinue
+}
+
 func (r *HtmlRenderer) renderTaskListItemMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		var attrs [][]string
 		if node.TaskListItemChecked {
 			attrs = append(attrs, []string{"checked", ""})
+		} else if state := node.TaskState(); parse.IsExtendedTaskState(state) {
+			attrs = append(attrs, []string{"data-task", string(state)})
 		}
 		attrs = append(attrs, []string{"disabled", ""}, []string{"type", "checkbox"})
 		r.Tag("input", attrs, true)
@@ -1377,7 +1723,9 @@ func (r *HtmlRenderer) renderTaskListItemMarker(node *ast.Node, entering bool) a
 func (r *HtmlRenderer) renderThematicBreak(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.Newline()
-		r.Tag("hr", nil, true)
+		var attrs [][]string
+		r.renderSourcePos(node, &attrs)
+		r.Tag("hr", attrs, true)
 		r.Newline()
 	}
 	return ast.WalkContinue
@@ -34,6 +34,7 @@ func NewKityMinderJSONRenderer(tree *parse.Tree, options *Options) Renderer {
 	ret.RendererFuncs[ast.NodeCodeBlock] = ret.renderCodeBlock
 	ret.RendererFuncs[ast.NodeMathBlock] = ret.renderMathBlock
 	ret.RendererFuncs[ast.NodeBlockquote] = ret.renderBlockquote
+	ret.RendererFuncs[ast.NodeCallout] = ret.renderBlockquote
 	ret.RendererFuncs[ast.NodeSuperBlock] = ret.renderSuperBlock
 	ret.RendererFuncs[ast.NodeHeading] = ret.renderHeading
 	ret.RendererFuncs[ast.NodeList] = ret.renderList
@@ -285,7 +286,7 @@ func (r *KityMinderJSONRenderer) data(node *ast.Node) {
 		} else {
 			r.WriteString("\"priority\": \"iconCheck\",")
 		}
-	case ast.NodeBlockquote:
+	case ast.NodeBlockquote, ast.NodeCallout:
 		r.WriteString("\"priority\": \"iconQuote\",")
 	case ast.NodeSuperBlock:
 		r.WriteString("\"priority\": \"iconSuper\",")
@@ -0,0 +1,51 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package render
+
+import (
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/parse"
+	"github.com/Dofingert/lute-for-ficus/util"
+)
+
+// pandocAttributesOwner 返回 Pandoc 通用属性节点 node 所属的节点，node 不是 Pandoc 通用属性节点时返回 nil。
+func pandocAttributesOwner(node *ast.Node) *ast.Node {
+	if !parse.IsPandocAttributes(node.Tokens) {
+		return nil
+	}
+	if nil != node.Parent && node == node.Parent.LastChild && (ast.NodeHeading == node.Parent.Type || ast.NodeCodeBlock == node.Parent.Type) {
+		return node.Parent
+	}
+	return node.Previous
+}
+
+// pandocAttributesMarkdown 返回节点 node 的属性 Markdown，pandoc 为 true 时使用 Pandoc 通用属性 {#id .class}，否则使用 kramdown IAL {: id="id"}。
+func pandocAttributesMarkdown(node *ast.Node, pandoc bool) string {
+	if pandoc {
+		return util.BytesToStr(parse.IAL2PandocTokens(node.KramdownIAL))
+	}
+	return util.BytesToStr(parse.IAL2Tokens(node.KramdownIAL))
+}
+
+// pandocAttributesStyle 判断格式化时节点 owner 的属性是否使用 Pandoc 通用属性输出。只有打开了对应的 kramdown IAL 选项时，
+// kramdown IAL 才能被解析回来；标题的锚点 id 不使用 kramdown IAL，链接和 [text] 没有对应的 kramdown IAL 语法，所以这些情况下总是使用 Pandoc 通用属性。
+func (r *FormatRenderer) pandocAttributesStyle(owner *ast.Node) bool {
+	if r.Options.PandocAttributes {
+		return true
+	}
+	switch owner.Type {
+	case ast.NodeCodeBlock:
+		return !r.Options.KramdownBlockIAL
+	case ast.NodeImage, ast.NodeCodeSpan:
+		return !r.Options.KramdownSpanIAL
+	}
+	return true
+}
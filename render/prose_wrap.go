@@ -0,0 +1,283 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package render
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/parse"
+	"github.com/Dofingert/lute-for-ficus/util"
+)
+
+const (
+	ProseWrapPreserve = ""         // 保留段落中原有的换行
+	ProseWrapAlways   = "always"   // 按宽度 ProseWrapWidth 折行
+	ProseWrapNever    = "never"    // 将段落合并为一行
+	ProseWrapSentence = "sentence" // 每个句子一行
+)
+
+// 折行时段落输出中使用的控制字符，完成折行后会被移除。
+const (
+	proseSoftBreak = '\x1f' // 软换行
+	proseAtomStart = '\x02' // 不可断开的行级节点开始
+	proseAtomEnd   = '\x03' // 不可断开的行级节点结束
+)
+
+// proseWrapAtoms 定义了折行时不能在内部断开的行级节点。
+var proseWrapAtoms = []ast.NodeType{
+	ast.NodeCodeSpan, ast.NodeInlineMath, ast.NodeLink, ast.NodeImage, ast.NodeInlineHTML, ast.NodeBackslash,
+	ast.NodeHTMLEntity, ast.NodeEmoji, ast.NodeFootnotesRef, ast.NodeTaskListItemMarker, ast.NodeKbd, ast.NodeTag,
+	ast.NodeBlockRef, ast.NodeFileAnnotationRef, ast.NodeWikiLink, ast.NodeCrossRef, ast.NodeCitation,
+	ast.NodeBracketedSpan, ast.NodeTextMark, ast.NodeKramdownSpanIAL,
+}
+
+// 避头尾规则：不能出现在行首的标点和不能出现在行尾的标点。
+const (
+	proseNoBreakBefore = "，。、；：！？）」』》〉】〕］｝’”…‥・ー々ゝゞぁぃぅぇぉっゃゅょゎァィゥェォッャュョヮヵヶ"
+	proseNoBreakAfter  = "（「『《〈【〔［｛‘“"
+)
+
+// proseWrapAtom 包装行级节点的渲染函数 f，在节点输出的前后标记不可断开的区间。
+func (r *FormatRenderer) proseWrapAtom(f RendererFunc) RendererFunc {
+	return func(n *ast.Node, entering bool) ast.WalkStatus {
+		if r.proseWrapping && entering {
+			r.Writer.WriteByte(proseAtomStart)
+		}
+		ret := f(n, entering)
+		if r.proseWrapping && !entering {
+			r.Writer.WriteByte(proseAtomEnd)
+		}
+		return ret
+	}
+}
+
+// proseWrappable 判断段落 node 是否需要折行。软换行转硬换行时段落中的每个换行都会被渲染为 <br />，折行会改变渲染结果，所以不折行。
+func (r *FormatRenderer) proseWrappable(node *ast.Node) bool {
+	return ProseWrapPreserve != r.Options.ProseWrap && !r.Options.SoftBreak2HardBreak && !node.ParentIs(ast.NodeTableCell)
+}
+
+// proseWrapPrefixWidth 返回段落 node 每行前由列表项缩进和引述块 > 占用的宽度。
+func proseWrapPrefixWidth(node *ast.Node) (ret int) {
+	for p := node.Parent; nil != p; p = p.Parent {
+		switch p.Type {
+		case ast.NodeListItem:
+			ret += len(p.ListData.Marker) + 1
+			if 1 == p.ListData.Typ || (3 == p.ListData.Typ && 0 == p.ListData.BulletChar) {
+				ret++
+			}
+		case ast.NodeBlockquote, ast.NodeCallout:
+			ret += 2
+		}
+	}
+	return
+}
+
+// proseItem 描述了折行的最小单元：不可断开的词、可断开的间隔或者强制换行。
+type proseItem struct {
+	text    string // 词或者间隔的原文
+	gap     bool   // 是否是可断开的间隔
+	newline bool   // 是否是强制换行
+}
+
+// proseWrap 按照折行选项重排段落 node 的输出 text。
+func (r *FormatRenderer) proseWrap(node *ast.Node, text string) string {
+	items := proseItems(text)
+	buf := strings.Builder{}
+	switch r.Options.ProseWrap {
+	case ProseWrapAlways:
+		limit := r.Options.ProseWrapWidth
+		if 1 > limit {
+			limit = 80
+		}
+		limit -= proseWrapPrefixWidth(node)
+		width := 0
+		for i, item := range items {
+			switch {
+			case item.newline:
+				buf.WriteByte('\n')
+				width = 0
+			case item.gap:
+				if 0 < width && i+1 < len(items) && !items[i+1].gap && !items[i+1].newline && limit < width+proseWidth(item.text)+proseWidth(items[i+1].text) && r.proseBreakable(items, i) {
+					buf.WriteByte('\n')
+					width = 0
+					continue
+				}
+				buf.WriteString(item.text)
+				width += proseWidth(item.text)
+			default:
+				buf.WriteString(item.text)
+				width += proseWidth(item.text)
+			}
+		}
+	case ProseWrapSentence:
+		for i, item := range items {
+			if item.gap && 0 < i && proseSentenceEnd(items[i-1].text) && i+1 < len(items) && !items[i+1].newline && r.proseBreakable(items, i) {
+				if next := []rune(items[i+1].text); !unicode.IsLower(next[0]) {
+					buf.WriteByte('\n')
+					continue
+				}
+			}
+			if item.newline {
+				buf.WriteByte('\n')
+			} else {
+				buf.WriteString(item.text)
+			}
+		}
+	default:
+		for _, item := range items {
+			if item.newline {
+				buf.WriteByte('\n')
+			} else {
+				buf.WriteString(item.text)
+			}
+		}
+	}
+	return buf.String()
+}
+
+// proseItems 将段落输出 text 切分为词、间隔和强制换行。间隔包括不可断开区间外的空格、软换行，以及中日文字符之间符合避头尾规则的位置。
+func proseItems(text string) (ret []*proseItem) {
+	runes := []rune(text)
+	word := strings.Builder{}
+	flush := func() {
+		if 0 < word.Len() {
+			ret = append(ret, &proseItem{text: word.String()})
+			word.Reset()
+		}
+	}
+
+	depth := 0
+	var prev rune
+	for i, c := range runes {
+		switch c {
+		case proseAtomStart:
+			depth++
+			continue
+		case proseAtomEnd:
+			depth--
+			continue
+		case '\n':
+			flush()
+			ret = append(ret, &proseItem{newline: true})
+			prev = c
+			continue
+		case proseSoftBreak:
+			// 中日文字符之间的软换行直接合并，其他使用空格合并
+			gap := " "
+			if next := proseNextRune(runes, i+1); isProseCJK(prev) && isProseCJK(next) {
+				gap = ""
+			}
+			if 0 < depth {
+				word.WriteString(gap)
+			} else {
+				flush()
+				ret = append(ret, &proseItem{text: gap, gap: true})
+			}
+			continue
+		case ' ':
+			if 0 == depth {
+				flush()
+				if n := len(ret); 0 < n && ret[n-1].gap {
+					ret[n-1].text += " "
+				} else {
+					ret = append(ret, &proseItem{text: " ", gap: true})
+				}
+				prev = c
+				continue
+			}
+		}
+
+		if 0 == depth && 0 < word.Len() && proseCJKPair(prev, c) &&
+			!strings.ContainsRune(proseNoBreakBefore, c) && !strings.ContainsRune(proseNoBreakAfter, prev) {
+			flush()
+			ret = append(ret, &proseItem{gap: true})
+		}
+		word.WriteRune(c)
+		prev = c
+	}
+	flush()
+
+	// 强制换行前的间隔需要保留，比如硬换行的两个空格，其他多余的空格合并为一个
+	for i, item := range ret {
+		if item.gap && 1 < len(item.text) && (i+1 == len(ret) || !ret[i+1].newline) {
+			item.text = " "
+		}
+	}
+	return
+}
+
+func proseNextRune(runes []rune, i int) rune {
+	for ; i < len(runes); i++ {
+		if proseAtomStart != runes[i] && proseAtomEnd != runes[i] {
+			return runes[i]
+		}
+	}
+	return 0
+}
+
+// proseBreakable 判断是否可以在间隔 items[i] 处换行：前一个词不能以 \ 结尾（会变为硬换行），换行后的行首不能被解析为块级元素的开头。
+func (r *FormatRenderer) proseBreakable(items []*proseItem, i int) bool {
+	if 1 > i || i+1 >= len(items) {
+		return false
+	}
+	prev, next := items[i-1], items[i+1]
+	if prev.gap || prev.newline || next.gap || next.newline || strings.HasSuffix(prev.text, "\\") {
+		return false
+	}
+
+	// 换行后的行可能只有一个词，也可能一直到下一个强制换行，分别检查
+	line := strings.Builder{}
+	for _, item := range items[i+1:] {
+		if item.newline {
+			break
+		}
+		line.WriteString(item.text)
+	}
+	return r.proseContinuation(next.text) && r.proseContinuation(line.String())
+}
+
+// proseContinuation 使用当前的解析选项重新解析，判断 line 作为段落的后续行时是否仍然是段落内容，而不会开始或者打断为其他块级元素。
+func (r *FormatRenderer) proseContinuation(line string) bool {
+	options := parse.NewOptions()
+	if nil != r.Tree.Context && nil != r.Tree.Context.ParseOption {
+		*options = *r.Tree.Context.ParseOption
+	}
+	options.SourcePos = false
+	tree := parse.Parse("", []byte("x\n"+line), options)
+	paragraph := tree.Root.FirstChild
+	return nil != paragraph && ast.NodeParagraph == paragraph.Type && nil == paragraph.Next
+}
+
+// proseSentenceEnd 判断词 word 是否以句末标点结尾，句末标点后可以有右引号和右括号。
+func proseSentenceEnd(word string) bool {
+	word = strings.TrimRight(word, "\"')]”’」』）")
+	return strings.HasSuffix(word, ".") || strings.HasSuffix(word, "!") || strings.HasSuffix(word, "?") ||
+		strings.HasSuffix(word, "。") || strings.HasSuffix(word, "！") || strings.HasSuffix(word, "？")
+}
+
+// isProseCJK 判断 r 是否是词间不使用空格分隔的中日文字符或者全角标点。
+func isProseCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r) ||
+		unicode.Is(unicode.Bopomofo, r) || (0x3000 <= r && 0x303F >= r) || (0xFF00 <= r && 0xFFEF >= r)
+}
+
+// proseCJKPair 判断相邻的 prev 和 c 是否都是中日文字符，中文中使用的弯引号也视为中日文字符。
+func proseCJKPair(prev, c rune) bool {
+	quote := func(r rune) bool { return '“' == r || '”' == r || '‘' == r || '’' == r }
+	return (isProseCJK(prev) || isProseCJK(c)) && (isProseCJK(prev) || quote(prev)) && (isProseCJK(c) || quote(c))
+}
+
+// proseWidth 返回 s 的显示宽度。
+func proseWidth(s string) int {
+	return util.DisplayWidth(strings.NewReplacer(string(proseAtomStart), "", string(proseAtomEnd), "").Replace(s))
+}
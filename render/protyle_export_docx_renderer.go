@@ -62,6 +62,8 @@ func NewProtyleExportDocxRenderer(tree *parse.Tree, options *Options) *ProtyleEx
 	ret.RendererFuncs[ast.NodeStrongU8eOpenMarker] = ret.renderStrongU8eOpenMarker
 	ret.RendererFuncs[ast.NodeStrongU8eCloseMarker] = ret.renderStrongU8eCloseMarker
 	ret.RendererFuncs[ast.NodeBlockquote] = ret.renderBlockquote
+	ret.RendererFuncs[ast.NodeCallout] = ret.renderBlockquote
+	ret.RendererFuncs[ast.NodeFencedDiv] = ret.renderFencedDiv
 	ret.RendererFuncs[ast.NodeBlockquoteMarker] = ret.renderBlockquoteMarker
 	ret.RendererFuncs[ast.NodeHeading] = ret.renderHeading
 	ret.RendererFuncs[ast.NodeHeadingC8hMarker] = ret.renderHeadingC8hMarker
@@ -74,6 +76,9 @@ func NewProtyleExportDocxRenderer(tree *parse.Tree, options *Options) *ProtyleEx
 	ret.RendererFuncs[ast.NodeHTMLBlock] = ret.renderHTML
 	ret.RendererFuncs[ast.NodeInlineHTML] = ret.renderInlineHTML
 	ret.RendererFuncs[ast.NodeLink] = ret.renderLink
+	ret.RendererFuncs[ast.NodeCrossRef] = ret.renderCrossRef
+	ret.RendererFuncs[ast.NodeCitation] = ret.renderCitation
+	ret.RendererFuncs[ast.NodeBracketedSpan] = ret.renderBracketedSpan
 	ret.RendererFuncs[ast.NodeImage] = ret.renderImage
 	// ret.RendererFuncs[ast.NodeMDlink] = ret.renderMDlink
 	ret.RendererFuncs[ast.NodeBang] = ret.renderBang
@@ -378,6 +383,15 @@ func (r *ProtyleExportDocxRenderer) renderGitConflict(node *ast.Node, entering b
 	return ast.WalkContinue
 }
 
+func (r *ProtyleExportDocxRenderer) renderFencedDiv(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Tag("div", node.KramdownIAL, false)
+	} else {
+		r.Tag("/div", nil, false)
+	}
+	return ast.WalkContinue
+}
+
 func (r *ProtyleExportDocxRenderer) renderSuperBlock(node *ast.Node, entering bool) ast.WalkStatus {
 	return ast.WalkContinue
 }
@@ -801,7 +815,14 @@ func (r *ProtyleExportDocxRenderer) renderMathBlock(node *ast.Node, entering boo
 		tokens = bytes.ReplaceAll(tokens, editor.CaretTokens, nil)
 		tokens = bytes.TrimSpace(tokens)
 		content := util.BytesToStr(tokens)
-		r.Tag("div", nil, false)
+		if 0 < node.CrossRefNum {
+			content += " \\tag{" + strconv.Itoa(node.CrossRefNum) + "}"
+		}
+		var attrs [][]string
+		if id := crossRefID(node); "" != id {
+			attrs = append(attrs, []string{"id", id})
+		}
+		r.Tag("div", attrs, false)
 		r.WriteString("$$\n" + content + "\n$$")
 		r.Tag("/div", nil, false)
 		r.Newline()
@@ -861,8 +882,19 @@ func (r *ProtyleExportDocxRenderer) renderTableHead(node *ast.Node, entering boo
 func (r *ProtyleExportDocxRenderer) renderTable(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.handleKramdownBlockIAL(node)
-		r.Tag("table", node.KramdownIAL, false)
+		var attrs [][]string
+		attrs = append(attrs, node.KramdownIAL...)
+		if id := crossRefID(node); "" != id {
+			attrs = append(attrs, []string{"id", id})
+		}
+		r.Tag("table", attrs, false)
 		r.Newline()
+		if 0 < node.CrossRefNum {
+			r.Tag("caption", nil, false)
+			r.WriteString(html.EscapeHTMLStr(r.crossRefCaption(node, node.TableCaption)))
+			r.Tag("/caption", nil, false)
+			r.Newline()
+		}
 	} else {
 		if nil != node.FirstChild.Next {
 			r.Tag("/tbody", nil, false)
@@ -979,6 +1011,9 @@ func (r *ProtyleExportDocxRenderer) renderImage(node *ast.Node, entering bool) a
 			if style := node.IALAttr("parent-style"); "" != style {
 				attrs = append(attrs, []string{"style", style})
 			}
+			if id := crossRefID(node); "" != id {
+				attrs = append(attrs, []string{"id", id})
+			}
 			r.Tag("span", attrs, false)
 			r.WriteString("<img src=\"")
 			destTokens := node.ChildByType(ast.NodeLinkDest).Tokens
@@ -1010,6 +1045,10 @@ func (r *ProtyleExportDocxRenderer) renderImage(node *ast.Node, entering bool) a
 			r.WriteString(" " + ial)
 		}
 		r.WriteString(" />")
+		if 0 < node.CrossRefNum {
+			// 已编号的图片使用编号和替代文本作为标题
+			titleTokens = html.EscapeHTML([]byte(r.crossRefCaption(node, node.Text())))
+		}
 		if 0 < len(titleTokens) {
 			r.Tag("span", [][]string{{"class", "protyle-action__title"}}, false)
 			r.Write(titleTokens)
@@ -1029,6 +1068,35 @@ func (r *ProtyleExportDocxRenderer) renderImage(node *ast.Node, entering bool) a
 	return ast.WalkContinue
 }
 
+func (r *ProtyleExportDocxRenderer) renderBracketedSpan(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Tag("span", node.KramdownIAL, false)
+	} else {
+		r.Tag("/span", nil, false)
+	}
+	return ast.WalkContinue
+}
+
+func (r *ProtyleExportDocxRenderer) renderCitation(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.WriteString(html.EscapeHTMLStr(r.citationText(node)))
+	}
+	return ast.WalkContinue
+}
+
+func (r *ProtyleExportDocxRenderer) renderCrossRef(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		if text := r.crossRefText(node); "" != text {
+			r.Tag("a", [][]string{{"href", "#" + node.CrossRefLabel}}, false)
+			r.WriteString(html.EscapeHTMLStr(text))
+			r.Tag("/a", nil, false)
+		} else {
+			r.Write(html.EscapeHTML(node.Tokens))
+		}
+	}
+	return ast.WalkContinue
+}
+
 func (r *ProtyleExportDocxRenderer) renderLink(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.LinkTextAutoSpacePrevious(node)
@@ -1283,7 +1351,7 @@ func (r *ProtyleExportDocxRenderer) renderHeading(node *ast.Node, entering bool)
 		r.Newline()
 		level := headingLevel[node.HeadingLevel : node.HeadingLevel+1]
 		r.WriteString("<h" + level)
-		id := HeadingID(node)
+		id := r.headingID(node)
 		if r.Options.ToC || r.Options.HeadingID || r.Options.KramdownBlockIAL {
 			r.WriteString(" id=\"" + id + "\"")
 			if r.Options.KramdownBlockIAL {
@@ -1301,7 +1369,7 @@ func (r *ProtyleExportDocxRenderer) renderHeading(node *ast.Node, entering bool)
 		r.WriteString(">")
 	} else {
 		if r.Options.HeadingAnchor {
-			id := HeadingID(node)
+			id := r.headingID(node)
 			r.Tag("a", [][]string{{"id", "vditorAnchor-" + id}, {"class", "vditor-anchor"}, {"href", "#" + id}}, false)
 			r.WriteString(`<svg viewBox="0 0 16 16" version="1.1" width="16" height="16"><path fill-rule="evenodd" d="M4 9h1v1H4c-1.5 0-3-1.69-3-3.5S2.55 3 4 3h4c1.45 0 3 1.69 3 3.5 0 1.41-.91 2.72-2 3.25V8.59c.58-.45 1-1.27 1-2.09C10 5.22 8.98 4 8 4H4c-.98 0-2 1.22-2 2.5S3 9 4 9zm9-3h-1v1h1c1 0 2 1.22 2 2.5S13.98 12 13 12H9c-.98 0-2-1.22-2-2.5 0-.83.42-1.64 1-2.09V6.25c-1.09.53-2 1.84-2 3.25C6 11.31 7.55 13 9 13h4c1.45 0 3-1.69 3-3.5S14.5 6 13 6z"></path></svg>`)
 			r.Tag("/a", nil, false)
@@ -63,12 +63,17 @@ func NewProtyleExportMdRenderer(tree *parse.Tree, options *Options) *ProtyleExpo
 	ret.RendererFuncs[ast.NodeStrongU8eOpenMarker] = ret.renderStrongU8eOpenMarker
 	ret.RendererFuncs[ast.NodeStrongU8eCloseMarker] = ret.renderStrongU8eCloseMarker
 	ret.RendererFuncs[ast.NodeBlockquote] = ret.renderBlockquote
+	ret.RendererFuncs[ast.NodeCallout] = ret.renderBlockquote
+	ret.RendererFuncs[ast.NodeFencedDiv] = ret.renderFencedDiv
 	ret.RendererFuncs[ast.NodeBlockquoteMarker] = ret.renderBlockquoteMarker
 	ret.RendererFuncs[ast.NodeHeading] = ret.renderHeading
 	ret.RendererFuncs[ast.NodeHeadingC8hMarker] = ret.renderHeadingC8hMarker
 	ret.RendererFuncs[ast.NodeHeadingID] = ret.renderHeadingID
 	ret.RendererFuncs[ast.NodeList] = ret.renderList
 	ret.RendererFuncs[ast.NodeListItem] = ret.renderListItem
+	ret.RendererFuncs[ast.NodeDefinitionList] = ret.renderList
+	ret.RendererFuncs[ast.NodeDefinitionTerm] = ret.renderDefinitionTerm
+	ret.RendererFuncs[ast.NodeDefinitionDesc] = ret.renderDefinitionDesc
 	ret.RendererFuncs[ast.NodeThematicBreak] = ret.renderThematicBreak
 	ret.RendererFuncs[ast.NodeHardBreak] = ret.renderHardBreak
 	ret.RendererFuncs[ast.NodeSoftBreak] = ret.renderSoftBreak
@@ -116,6 +121,10 @@ func NewProtyleExportMdRenderer(tree *parse.Tree, options *Options) *ProtyleExpo
 	ret.RendererFuncs[ast.NodeYamlFrontMatterContent] = ret.renderYamlFrontMatterContent
 	ret.RendererFuncs[ast.NodeYamlFrontMatterCloseMarker] = ret.renderYamlFrontMatterCloseMarker
 	ret.RendererFuncs[ast.NodeBlockRef] = ret.renderBlockRef
+	ret.RendererFuncs[ast.NodeWikiLink] = ret.renderWikiLink
+	ret.RendererFuncs[ast.NodeCrossRef] = ret.renderCrossRef
+	ret.RendererFuncs[ast.NodeCitation] = ret.renderCitation
+	ret.RendererFuncs[ast.NodeBracketedSpan] = ret.renderBracketedSpan
 	ret.RendererFuncs[ast.NodeBlockRefID] = ret.renderBlockRefID
 	ret.RendererFuncs[ast.NodeBlockRefSpace] = ret.renderBlockRefSpace
 	ret.RendererFuncs[ast.NodeBlockRefText] = ret.renderBlockRefText
@@ -546,6 +555,26 @@ func (r *ProtyleExportMdRenderer) renderGitConflict(node *ast.Node, entering boo
 	return ast.WalkContinue
 }
 
+func (r *ProtyleExportMdRenderer) renderFencedDiv(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Newline()
+		r.Writer = &bytes.Buffer{}
+		r.NodeWriterStack = append(r.NodeWriterStack, r.Writer)
+	} else {
+		writer := r.NodeWriterStack[len(r.NodeWriterStack)-1]
+		r.NodeWriterStack = r.NodeWriterStack[:len(r.NodeWriterStack)-1]
+		r.Writer = r.NodeWriterStack[len(r.NodeWriterStack)-1]
+		r.Write(fencedDivMarkdown(node, writer.Bytes()))
+		r.Newline()
+		if !r.isLastNode(r.Tree.Root, node) {
+			if r.withoutKramdownBlockIAL(node) {
+				r.WriteByte(lex.ItemNewline)
+			}
+		}
+	}
+	return ast.WalkContinue
+}
+
 func (r *ProtyleExportMdRenderer) renderSuperBlock(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.Newline()
@@ -652,6 +681,20 @@ func (r *ProtyleExportMdRenderer) renderKramdownBlockIAL(node *ast.Node, enterin
 }
 
 func (r *ProtyleExportMdRenderer) renderKramdownSpanIAL(node *ast.Node, entering bool) ast.WalkStatus {
+	if owner := pandocAttributesOwner(node); nil != owner {
+		if entering {
+			switch owner.Type {
+			case ast.NodeCodeBlock:
+				// 围栏代码块的属性在信息后输出
+			case ast.NodeHeading:
+				r.WriteString(" " + pandocAttributesMarkdown(owner, true))
+			default:
+				r.WriteString(pandocAttributesMarkdown(owner, true))
+			}
+		}
+		return ast.WalkContinue
+	}
+
 	if !r.Options.KramdownSpanIAL {
 		return ast.WalkContinue
 	}
@@ -751,6 +794,60 @@ func (r *ProtyleExportMdRenderer) renderBlockQueryEmbed(node *ast.Node, entering
 	return ast.WalkContinue
 }
 
+func (r *ProtyleExportMdRenderer) renderWikiLink(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.WriteString(wikiLinkMarkdown(node))
+	}
+	return ast.WalkContinue
+}
+
+func (r *ProtyleExportMdRenderer) renderBracketedSpan(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.WriteByte(lex.ItemOpenBracket)
+	} else {
+		r.WriteByte(lex.ItemCloseBracket)
+	}
+	return ast.WalkContinue
+}
+
+func (r *ProtyleExportMdRenderer) renderCitation(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.WriteString(r.citationText(node))
+	}
+	return ast.WalkContinue
+}
+
+// renderBibliography 输出已引用文献的参考文献列表，没有设置文献库或者没有引用时不输出。
+func (r *ProtyleExportMdRenderer) renderBibliography() {
+	entries := r.citedBibliography()
+	if 1 > len(entries) {
+		return
+	}
+
+	escape := func(text string) string { return text }
+	emph := func(text string) string { return "*" + text + "*" }
+	r.Writer.Truncate(len(bytes.TrimRight(r.Writer.Bytes(), " \t\n")))
+	r.WriteString("\n\n## " + r.bibliographyTitle() + "\n")
+	for _, entry := range entries {
+		r.WriteString("\n")
+		if r.citationNumeric() {
+			r.WriteString("\\[" + strconv.Itoa(r.citationNums[entry.ID]) + "\\] ")
+		}
+		r.WriteString(r.bibEntryText(entry, escape, emph) + "\n")
+	}
+}
+
+func (r *ProtyleExportMdRenderer) renderCrossRef(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		if text := r.crossRefText(node); "" != text {
+			r.WriteString("[" + text + "](#" + node.CrossRefLabel + ")")
+		} else {
+			r.Write(node.Tokens)
+		}
+	}
+	return ast.WalkContinue
+}
+
 func (r *ProtyleExportMdRenderer) renderBlockRef(node *ast.Node, entering bool) ast.WalkStatus {
 	return ast.WalkContinue
 }
@@ -1074,6 +1171,9 @@ func (r *ProtyleExportMdRenderer) renderTable(node *ast.Node, entering bool) ast
 		}
 	} else {
 		r.Newline()
+		if label := crossRefLabelMarkdown(node); "" != label {
+			r.WriteString(label + "\n")
+		}
 		if !r.isLastNode(r.Tree.Root, node) {
 			if r.withoutKramdownBlockIAL(node) {
 				r.WriteByte(lex.ItemNewline)
@@ -1299,6 +1399,7 @@ func (r *ProtyleExportMdRenderer) renderDocument(node *ast.Node, entering bool)
 		r.Writer = &bytes.Buffer{}
 		r.NodeWriterStack = append(r.NodeWriterStack, r.Writer)
 	} else {
+		r.renderBibliography()
 		r.NodeWriterStack = r.NodeWriterStack[:len(r.NodeWriterStack)-1]
 		var buf []byte
 		if r.Options.KeepParagraphBeginningSpace {
@@ -1352,6 +1453,8 @@ func (r *ProtyleExportMdRenderer) renderParagraph(node *ast.Node, entering bool)
 				} else {
 					inTightList = true
 				}
+			} else if ast.NodeDefinitionDesc == parent.Type && nil != parent.Parent { // DefinitionDesc.Paragraph
+				inTightList = parent.Parent.ListData.Tight
 			}
 		}
 
@@ -1504,6 +1607,9 @@ func (r *ProtyleExportMdRenderer) renderInlineMathCloseMarker(node *ast.Node, en
 func (r *ProtyleExportMdRenderer) renderMathBlockCloseMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.Write(parse.MathBlockMarker)
+		if label := crossRefLabelMarkdown(node.Parent); "" != label {
+			r.WriteString(" " + label)
+		}
 		r.WriteByte(lex.ItemNewline)
 	}
 	return ast.WalkContinue
@@ -1565,6 +1671,12 @@ func (r *ProtyleExportMdRenderer) renderCodeBlockCode(node *ast.Node, entering b
 func (r *ProtyleExportMdRenderer) renderCodeBlockInfoMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.Write(node.CodeBlockInfo)
+		if nil != parse.PandocAttributes(node.Parent) {
+			if 0 < len(node.CodeBlockInfo) {
+				r.WriteByte(lex.ItemSpace)
+			}
+			r.WriteString(pandocAttributesMarkdown(node.Parent, true))
+		}
 		r.WriteByte(lex.ItemNewline)
 	}
 	return ast.WalkContinue
@@ -1675,6 +1787,9 @@ func (r *ProtyleExportMdRenderer) renderBlockquote(node *ast.Node, entering bool
 	if entering {
 		r.Writer = &bytes.Buffer{}
 		r.NodeWriterStack = append(r.NodeWriterStack, r.Writer)
+		if ast.NodeCallout == node.Type {
+			r.WriteString(calloutMarker(node) + "\n")
+		}
 	} else {
 		writer := r.NodeWriterStack[len(r.NodeWriterStack)-1]
 		r.NodeWriterStack = r.NodeWriterStack[:len(r.NodeWriterStack)-1]
@@ -1857,13 +1972,52 @@ func (r *ProtyleExportMdRenderer) renderListItem(node *ast.Node, entering bool)
 	return ast.WalkContinue
 }
 
+func (r *ProtyleExportMdRenderer) renderDefinitionTerm(node *ast.Node, entering bool) ast.WalkStatus {
+	if !entering {
+		r.WriteByte(lex.ItemNewline)
+		if next := node.Next; nil != next && ast.NodeDefinitionDesc == next.Type && !node.Parent.ListData.Tight {
+			r.WriteByte(lex.ItemNewline)
+		}
+	}
+	return ast.WalkContinue
+}
+
+func (r *ProtyleExportMdRenderer) renderDefinitionDesc(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Writer = &bytes.Buffer{}
+		r.NodeWriterStack = append(r.NodeWriterStack, r.Writer)
+	} else {
+		writer := r.NodeWriterStack[len(r.NodeWriterStack)-1]
+		r.NodeWriterStack = r.NodeWriterStack[:len(r.NodeWriterStack)-1]
+		r.Writer = r.NodeWriterStack[len(r.NodeWriterStack)-1]
+
+		// 描述内容缩进两个空格对齐到 : 后面
+		buf := bytes.TrimLeft(bytes.TrimRight(writer.Bytes(), " \t\n"), "\n")
+		r.WriteString(": ")
+		for i, line := range bytes.Split(buf, []byte{lex.ItemNewline}) {
+			if 0 < i {
+				r.WriteByte(lex.ItemNewline)
+				if 0 < len(line) {
+					r.WriteString("  ")
+				}
+			}
+			r.Write(line)
+		}
+		r.WriteByte(lex.ItemNewline)
+		if next := node.Next; nil != next && (ast.NodeDefinitionTerm == next.Type || !node.Parent.ListData.Tight) {
+			r.WriteByte(lex.ItemNewline)
+		}
+	}
+	return ast.WalkContinue
+}
+
 func (r *ProtyleExportMdRenderer) renderTaskListItemMarker(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.WriteByte(lex.ItemOpenBracket)
 		if node.TaskListItemChecked {
 			r.WriteByte('X')
 		} else {
-			r.WriteByte(lex.ItemSpace)
+			r.WriteByte(node.TaskState())
 		}
 		r.WriteByte(lex.ItemCloseBracket)
 	} else {
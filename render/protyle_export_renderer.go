@@ -62,6 +62,8 @@ func NewProtyleExportRenderer(tree *parse.Tree, options *Options) *ProtyleExport
 	ret.RendererFuncs[ast.NodeStrongU8eOpenMarker] = ret.renderStrongU8eOpenMarker
 	ret.RendererFuncs[ast.NodeStrongU8eCloseMarker] = ret.renderStrongU8eCloseMarker
 	ret.RendererFuncs[ast.NodeBlockquote] = ret.renderBlockquote
+	ret.RendererFuncs[ast.NodeCallout] = ret.renderBlockquote
+	ret.RendererFuncs[ast.NodeFencedDiv] = ret.renderFencedDiv
 	ret.RendererFuncs[ast.NodeBlockquoteMarker] = ret.renderBlockquoteMarker
 	ret.RendererFuncs[ast.NodeHeading] = ret.renderHeading
 	ret.RendererFuncs[ast.NodeHeadingC8hMarker] = ret.renderHeadingC8hMarker
@@ -74,6 +76,9 @@ func NewProtyleExportRenderer(tree *parse.Tree, options *Options) *ProtyleExport
 	ret.RendererFuncs[ast.NodeHTMLBlock] = ret.renderHTML
 	ret.RendererFuncs[ast.NodeInlineHTML] = ret.renderInlineHTML
 	ret.RendererFuncs[ast.NodeLink] = ret.renderLink
+	ret.RendererFuncs[ast.NodeCrossRef] = ret.renderCrossRef
+	ret.RendererFuncs[ast.NodeCitation] = ret.renderCitation
+	ret.RendererFuncs[ast.NodeBracketedSpan] = ret.renderBracketedSpan
 	ret.RendererFuncs[ast.NodeImage] = ret.renderImage
 	// ret.RendererFuncs[ast.NodeMDlink] = ret.renderMDlink
 	ret.RendererFuncs[ast.NodeBang] = ret.renderBang
@@ -542,6 +547,33 @@ func (r *ProtyleExportRenderer) renderTagCloseMarker(node *ast.Node, entering bo
 	return ast.WalkContinue
 }
 
+func (r *ProtyleExportRenderer) renderFencedDiv(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		// 围栏 div 导出为超级块，class 合并到超级块的 class 中
+		id := node.ID
+		if "" == id {
+			id = ast.NewNodeID()
+		}
+		attrs := [][]string{{"data-node-id", id}}
+		r.nodeDataType(node, &attrs)
+		class := "sb"
+		if divClass := node.IALAttr("class"); "" != divClass {
+			class += " " + divClass
+		}
+		r.nodeClass(node, &attrs, class)
+		attrs = append(attrs, []string{"data-sb-layout", "row"})
+		for _, kv := range node.KramdownIAL {
+			if "class" != kv[0] {
+				attrs = append(attrs, kv)
+			}
+		}
+		r.Tag("div", attrs, false)
+	} else {
+		r.Tag("/div", nil, false)
+	}
+	return ast.WalkContinue
+}
+
 func (r *ProtyleExportRenderer) renderSuperBlock(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		if nil == node.FirstChild {
@@ -1003,9 +1035,15 @@ func (r *ProtyleExportRenderer) renderMathBlock(node *ast.Node, entering bool) a
 
 	var attrs [][]string
 	r.blockNodeAttrs(node, &attrs, "render-node")
+	if id := crossRefID(node); "" != id {
+		attrs = append(attrs, []string{"id", id})
+	}
 	tokens := html.EscapeHTML(node.FirstChild.Next.Tokens)
 	tokens = bytes.ReplaceAll(tokens, editor.CaretTokens, nil)
 	tokens = bytes.TrimSpace(tokens)
+	if 0 < node.CrossRefNum {
+		tokens = append(tokens, " \\tag{"+strconv.Itoa(node.CrossRefNum)+"}"...)
+	}
 	attrs = append(attrs, []string{"data-content", util.BytesToStr(tokens)})
 	attrs = append(attrs, []string{"data-subtype", "math"})
 	r.Tag("div", attrs, false)
@@ -1107,7 +1145,15 @@ func (r *ProtyleExportRenderer) renderTable(node *ast.Node, entering bool) ast.W
 		attrs = [][]string{}
 		r.contenteditable(node, &attrs)
 		r.spellcheck(&attrs)
+		if id := crossRefID(node); "" != id {
+			attrs = append(attrs, []string{"id", id})
+		}
 		r.Tag("table", attrs, false)
+		if 0 < node.CrossRefNum {
+			r.Tag("caption", nil, false)
+			r.WriteString(html.EscapeHTMLStr(r.crossRefCaption(node, node.TableCaption)))
+			r.Tag("/caption", nil, false)
+		}
 	} else {
 		r.Tag("/tbody", nil, false)
 		r.Tag("/table", nil, false)
@@ -1220,6 +1266,9 @@ func (r *ProtyleExportRenderer) renderImage(node *ast.Node, entering bool) ast.W
 		if "" != parentStyle { // 手动设置了位置
 			attrs = append(attrs, []string{"style", parentStyle})
 		}
+		if id := crossRefID(node); "" != id {
+			attrs = append(attrs, []string{"id", id})
+		}
 		r.Tag("span", attrs, false)
 		r.Tag("span", nil, false)
 		r.WriteString(" ")
@@ -1272,6 +1321,10 @@ func (r *ProtyleExportRenderer) renderImage(node *ast.Node, entering bool) ast.W
 			r.WriteString("<span class=\"img__net\"><svg><use xlink:href=\"#iconLanguage\"></use></svg></span>")
 		}
 
+		if 0 < node.CrossRefNum {
+			// 已编号的图片使用编号和替代文本作为标题
+			titleTokens = []byte(r.crossRefCaption(node, node.Text()))
+		}
 		attrs = [][]string{{"class", "protyle-action__title"}}
 		r.Tag("span", attrs, false)
 		r.Writer.Write(html.EscapeHTML(titleTokens))
@@ -1312,6 +1365,39 @@ func (r *ProtyleExportRenderer) renderLink(node *ast.Node, entering bool) ast.Wa
 	return ast.WalkContinue
 }
 
+func (r *ProtyleExportRenderer) renderBracketedSpan(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Tag("span", node.KramdownIAL, false)
+	} else {
+		r.Tag("/span", nil, false)
+	}
+	return ast.WalkContinue
+}
+
+func (r *ProtyleExportRenderer) renderCitation(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Tag("span", [][]string{{"class", "citation"}}, false)
+		r.WriteString(html.EscapeHTMLStr(r.citationText(node)))
+		r.Tag("/span", nil, false)
+	}
+	return ast.WalkContinue
+}
+
+func (r *ProtyleExportRenderer) renderCrossRef(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		if text := r.crossRefText(node); "" != text {
+			r.Tag("a", [][]string{{"href", "#" + node.CrossRefLabel}, {"class", "crossref"}}, false)
+			r.WriteString(html.EscapeHTMLStr(text))
+			r.Tag("/a", nil, false)
+		} else {
+			r.Tag("span", [][]string{{"class", "crossref crossref-unresolved"}}, false)
+			r.Write(html.EscapeHTML(node.Tokens))
+			r.Tag("/span", nil, false)
+		}
+	}
+	return ast.WalkContinue
+}
+
 func (r *ProtyleExportRenderer) renderHTML(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.Newline()
@@ -65,6 +65,9 @@ func NewProtyleRenderer(tree *parse.Tree, options *Options) *ProtyleRenderer {
 	ret.RendererFuncs[ast.NodeStrongU8eOpenMarker] = ret.renderStrongU8eOpenMarker
 	ret.RendererFuncs[ast.NodeStrongU8eCloseMarker] = ret.renderStrongU8eCloseMarker
 	ret.RendererFuncs[ast.NodeBlockquote] = ret.renderBlockquote
+	ret.RendererFuncs[ast.NodeCallout] = ret.renderCallout
+	ret.RendererFuncs[ast.NodeDefinitionList] = ret.renderDefinitionList
+	ret.RendererFuncs[ast.NodeFencedDiv] = ret.renderFencedDiv
 	ret.RendererFuncs[ast.NodeBlockquoteMarker] = ret.renderBlockquoteMarker
 	ret.RendererFuncs[ast.NodeHeading] = ret.renderHeading
 	ret.RendererFuncs[ast.NodeHeadingC8hMarker] = ret.renderHeadingC8hMarker
@@ -119,6 +122,10 @@ func NewProtyleRenderer(tree *parse.Tree, options *Options) *ProtyleRenderer {
 	ret.RendererFuncs[ast.NodeYamlFrontMatterContent] = ret.renderYamlFrontMatterContent
 	ret.RendererFuncs[ast.NodeYamlFrontMatterCloseMarker] = ret.renderYamlFrontMatterCloseMarker
 	ret.RendererFuncs[ast.NodeBlockRef] = ret.renderBlockRef
+	ret.RendererFuncs[ast.NodeWikiLink] = ret.renderWikiLink
+	ret.RendererFuncs[ast.NodeBracketedSpan] = ret.renderBracketedSpan
+	ret.RendererFuncs[ast.NodeCrossRef] = ret.renderCrossRef
+	ret.RendererFuncs[ast.NodeCitation] = ret.renderCitation
 	ret.RendererFuncs[ast.NodeBlockRefID] = ret.renderBlockRefID
 	ret.RendererFuncs[ast.NodeBlockRefSpace] = ret.renderBlockRefSpace
 	ret.RendererFuncs[ast.NodeBlockRefText] = ret.renderBlockRefText
@@ -469,6 +476,66 @@ func (r *ProtyleRenderer) renderBlockRef(node *ast.Node, entering bool) ast.Walk
 	return ast.WalkContinue
 }
 
+func (r *ProtyleRenderer) renderWikiLink(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		href, exists := r.wikiLinkHref(node)
+		attrs := [][]string{{"data-type", "wiki-link"}, {"data-href", html.EscapeHTMLStr(href)}, {"data-target", html.EscapeHTMLStr(node.WikiLinkTarget)}}
+		if "" != node.WikiLinkHeading {
+			attrs = append(attrs, []string{"data-heading", html.EscapeHTMLStr(node.WikiLinkHeading)})
+		}
+		if "" != node.WikiLinkBlock {
+			attrs = append(attrs, []string{"data-block", html.EscapeHTMLStr(node.WikiLinkBlock)})
+		}
+		if "" != node.WikiLinkAlias {
+			attrs = append(attrs, []string{"data-alias", html.EscapeHTMLStr(node.WikiLinkAlias)})
+		}
+		if !exists {
+			attrs = append(attrs, []string{"data-missing", "true"})
+		}
+		r.Tag("span", attrs, false)
+		r.WriteString(r.escapeRefText(wikiLinkText(node)))
+		r.Tag("/span", nil, false)
+	}
+	return ast.WalkContinue
+}
+
+func (r *ProtyleRenderer) renderCrossRef(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		// 交叉引用在编辑器中显示原文，转换回 Markdown 时使用 span 的文本重新生成
+		r.Tag("span", [][]string{{"data-type", "crossref"}, {"data-label", html.EscapeHTMLStr(node.CrossRefLabel)}}, false)
+		r.Write(html.EscapeHTML(node.Tokens))
+		r.Tag("/span", nil, false)
+	}
+	return ast.WalkContinue
+}
+
+func (r *ProtyleRenderer) renderCitation(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		var keys []string
+		for _, citation := range node.Citations {
+			keys = append(keys, citation.Key)
+		}
+		// 文献引用在编辑器中显示原文，转换回 Markdown 时使用 span 的文本重新生成
+		r.Tag("span", [][]string{{"data-type", "citation"}, {"data-cites", html.EscapeHTMLStr(strings.Join(keys, " "))}}, false)
+		r.Write(html.EscapeHTML(node.Tokens))
+		r.Tag("/span", nil, false)
+	}
+	return ast.WalkContinue
+}
+
+func (r *ProtyleRenderer) renderBracketedSpan(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.WriteByte(lex.ItemOpenBracket)
+	} else {
+		r.WriteByte(lex.ItemCloseBracket)
+		// 编辑器中不渲染行级属性，和原文一样保留在 ] 后面，转换回 Markdown 时才不会丢失
+		if attrs := parse.PandocAttributes(node); nil != attrs {
+			r.Write(html.EscapeHTML(attrs.Tokens))
+		}
+	}
+	return ast.WalkContinue
+}
+
 func (r *ProtyleRenderer) escapeRefText(refText string) string {
 	refText = strings.ReplaceAll(refText, ">", "&gt;")
 	refText = strings.ReplaceAll(refText, "<", "&lt;")
@@ -584,6 +651,25 @@ func (r *ProtyleRenderer) renderTagCloseMarker(node *ast.Node, entering bool) as
 	return ast.WalkContinue
 }
 
+func (r *ProtyleRenderer) renderFencedDiv(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		// 围栏 div 渲染为超级块，属性保存在 data-fenced-div 中，不作为块属性
+		id := node.ID
+		if "" == id {
+			id = ast.NewNodeID()
+		}
+		attrs := [][]string{{"data-node-id", id}}
+		r.nodeIndex(node, &attrs)
+		r.nodeDataType(node, &attrs)
+		r.nodeClass(node, &attrs, "sb")
+		attrs = append(attrs, []string{"data-sb-layout", "row"}, []string{"data-fenced-div", html.EscapeAttrVal(fencedDivAttrs(node))})
+		r.Tag("div", attrs, false)
+	} else {
+		r.Tag("/div", nil, false)
+	}
+	return ast.WalkContinue
+}
+
 func (r *ProtyleRenderer) renderSuperBlock(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		if nil == node.FirstChild {
@@ -1609,6 +1695,41 @@ func (r *ProtyleRenderer) renderStrongU8eCloseMarker(node *ast.Node, entering bo
 	return ast.WalkContinue
 }
 
+func (r *ProtyleRenderer) renderCallout(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		var attrs [][]string
+		r.blockNodeAttrs(node, &attrs, "callout")
+		attrs = append(attrs, calloutAttrs(node)...)
+		r.Tag("div", attrs, false)
+	} else {
+		r.renderIAL(node)
+		r.Tag("/div", nil, false)
+	}
+	return ast.WalkContinue
+}
+
+func (r *ProtyleRenderer) renderDefinitionList(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		var attrs [][]string
+		r.blockNodeAttrs(node, &attrs, "p")
+		for _, attr := range attrs {
+			if "data-type" == attr[0] {
+				attr[1] = ast.NodeParagraph.String()
+			}
+		}
+		r.Tag("div", attrs, false)
+		attrs = [][]string{}
+		r.contenteditable(node, &attrs)
+		r.spellcheck(&attrs)
+		r.Tag("div", attrs, false)
+		r.Write(html.EscapeHTML(protyleDefinitionListMarkdown(r.Tree, node, r.Options)))
+		r.Tag("/div", nil, false)
+		r.renderIAL(node)
+		r.Tag("/div", nil, false)
+	}
+	return ast.WalkSkipChildren
+}
+
 func (r *ProtyleRenderer) renderBlockquote(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		var attrs [][]string
@@ -1691,6 +1812,12 @@ func (r *ProtyleRenderer) renderListItem(node *ast.Node, entering bool) ast.Walk
 			attrs = append(attrs, []string{"data-subtype", "t"})
 			if node.FirstChild != nil && node.FirstChild.TaskListItemChecked {
 				class += " protyle-task--done"
+			} else if node.FirstChild != nil && parse.IsExtendedTaskState(node.FirstChild.TaskState()) {
+				state := node.FirstChild.TaskState()
+				if name := parse.TaskStateName(state); "" != name {
+					class += " protyle-task--" + name
+				}
+				attrs = append(attrs, []string{"data-task", string(state)})
 			}
 		}
 		r.blockNodeAttrs(node, &attrs, class)
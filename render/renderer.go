@@ -83,6 +83,17 @@ type Options struct {
 	// https://github.com/sparanoid/chinese-copywriting-guidelines
 	// 注意：开启术语修正的话会默认在中西文之间插入空格。
 	FixTermTypo bool
+	// ProseWrap 设置格式化时段落的折行方式：ProseWrapPreserve 保留原有换行，ProseWrapAlways 按宽度折行，ProseWrapNever 合并为一行，ProseWrapSentence 每个句子一行。
+	// 打开 SoftBreak2HardBreak 时换行都是硬换行，该选项不生效。注意 NewOptions 默认打开了 SoftBreak2HardBreak。
+	ProseWrap string
+	// ProseWrapWidth 设置 ProseWrapAlways 折行的宽度，中日韩文字占两列，默认为 80。
+	ProseWrapWidth int
+	// FormatStyle 设置格式化输出的 Markdown 风格，比如列表项标记符、强调标记符和代码块围栏，为 nil 时保留原文风格。
+	FormatStyle *FormatOptions
+	// SmartPunct 设置是否对普通文本进行智能标点替换（SmartyPants）：弯引号、连接号、破折号和省略号。
+	SmartPunct bool
+	// SmartPunctLang 设置智能标点替换使用的引号语言，比如 en、de、fr、zh，为空时使用 en，块级节点上的 lang 属性优先。
+	SmartPunctLang string
 	// Terms 将传入的 terms 合并覆盖到已有的 Terms 字典。
 	Terms map[string]string
 	// ToC 设置是否打开“目录”支持。
@@ -119,6 +130,25 @@ type Options struct {
 	ProtyleMarkNetImg bool
 	// Spellcheck 设置是否启用拼写检查
 	Spellcheck bool
+	// SourcePos 设置是否在块级元素标签上渲染 data-sourcepos 属性（需要同时打开解析选项 SourcePos）。
+	SourcePos bool
+	// WikiLinkResolver 设置 Wiki 链接 [[target]] 的链接地址解析器，为 nil 时直接使用链接目标作为链接地址。
+	WikiLinkResolver WikiLinkResolver
+	// CrossRefNames 设置交叉引用类型的显示名称，比如 fig 对应 Figure，没有设置的类型使用 DefaultCrossRefNames 中的名称。
+	CrossRefNames map[string]string
+	// EmojiUnicode2Alias 设置格式化时是否将 Emoji Unicode 字符转换为别名 :alias:，别名使用解析选项 EmojiAlias 中的映射。
+	EmojiUnicode2Alias bool
+	// Bibliography 设置文献引用使用的文献库，为 nil 时引用使用引用键显示并且不生成参考文献列表。
+	Bibliography *Bibliography
+	// CitationStyle 设置文献引用样式，支持作者-年份 CitationStyleAuthorDate 和数字编号 CitationStyleNumeric，为空时使用作者-年份。
+	CitationStyle string
+	// BibliographyTitle 设置生成的参考文献列表标题，为空时使用 References。
+	BibliographyTitle string
+	// PandocAttributes 设置格式化时是否使用 Pandoc 通用属性 {#id .class key=val} 输出通过解析选项 PandocAttributes 解析得到的属性，否则在打开了对应的 kramdown IAL 选项时使用 kramdown IAL {: key="val"} 输出，
+	// 没有打开时 kramdown IAL 无法被解析回来，仍然使用 Pandoc 通用属性输出。
+	PandocAttributes bool
+	// Slugger 设置标题锚点 id 生成器，比如 GitHubSlugger，重复的 id 添加 -1、-2 等数字后缀；为 nil 时将字母和数字以外的字符替换为 -，重复的 id 追加 -。
+	Slugger Slugger
 }
 
 func NewOptions() *Options {
@@ -137,6 +167,7 @@ func NewOptions() *Options {
 		KramdownBlockIAL:               false,
 		ChineseParagraphBeginningSpace: false,
 		FixTermTypo:                    false,
+		ProseWrapWidth:                 80,
 		ToC:                            false,
 		HeadingID:                      false,
 		KramdownIALIDRenderName:        "id",
@@ -166,6 +197,11 @@ type BaseRenderer struct {
 	DisableTags         int                              // 标签嵌套计数器，用于判断不可能出现标签嵌套的情况，比如语法树允许图片节点包含链接节点，但是 HTML <img> 不能包含 <a>
 	FootnotesDefs       []*ast.Node                      // 脚注定义集
 	RenderingFootnotes  bool                             // 是否正在渲染脚注定义
+	citedEntries        []*BibEntry                      // 已渲染的文献引用对应的文献，按首次引用的顺序排列
+	citationNums        map[string]int                   // 引用键到文献编号的映射
+	smartBlock          *ast.Node                        // 智能标点正在处理的块级节点
+	smartSingles        int                              // 智能标点正在处理的块级节点中未闭合的单引号数
+	headingSlugs        map[*ast.Node]string             // 使用渲染选项 Slugger 生成的标题锚点 id
 }
 
 // NewBaseRenderer 构造一个 BaseRenderer。
@@ -181,8 +217,24 @@ func (r *BaseRenderer) Render() (output []byte) {
 	r.LastOut = lex.ItemNewline
 	r.Writer = &bytes.Buffer{}
 	r.Writer.Grow(4096)
+	r.renderNode(r.Tree.Root)
+	output = r.Writer.Bytes()
+	return
+}
+
+// RenderNode 单独渲染节点 node 及其子节点，渲染结果不会写入 r.Writer。
+func (r *BaseRenderer) RenderNode(node *ast.Node) (output []byte) {
+	writer, lastOut := r.Writer, r.LastOut
+	r.Writer, r.LastOut = &bytes.Buffer{}, lex.ItemNewline
+	r.renderNode(node)
+	output = r.Writer.Bytes()
+	r.Writer, r.LastOut = writer, lastOut
+	return
+}
 
-	ast.Walk(r.Tree.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+// renderNode 从节点 node 开始遍历并渲染到 r.Writer 中。
+func (r *BaseRenderer) renderNode(node *ast.Node) {
+	ast.Walk(node, func(n *ast.Node, entering bool) ast.WalkStatus {
 		extRender := r.ExtRendererFuncs[n.Type]
 		if nil != extRender {
 			output, status := extRender(n, entering)
@@ -190,18 +242,19 @@ func (r *BaseRenderer) Render() (output []byte) {
 			return status
 		}
 
-		render := r.RendererFuncs[n.Type]
-		if nil == render {
-			if nil != r.DefaultRendererFunc {
-				return r.DefaultRendererFunc(n, entering)
-			}
-			return r.renderDefault(n, entering)
-		}
-		return render(n, entering)
+		return r.rendererFunc(n.Type)(n, entering)
 	})
+}
 
-	output = r.Writer.Bytes()
-	return
+// rendererFunc 返回节点类型 typ 的渲染器，RendererFuncs 中找不到时返回默认渲染器。
+func (r *BaseRenderer) rendererFunc(typ ast.NodeType) RendererFunc {
+	if render := r.RendererFuncs[typ]; nil != render {
+		return render
+	}
+	if nil != r.DefaultRendererFunc {
+		return r.DefaultRendererFunc
+	}
+	return r.renderDefault
 }
 
 func (r *BaseRenderer) renderDefault(n *ast.Node, entering bool) ast.WalkStatus {
@@ -350,33 +403,100 @@ func SubStr(str string, length int) (ret string) {
 }
 
 func HeadingID(heading *ast.Node) (ret string) {
+	return HeadingSlug(heading, nil)
+}
+
+// HeadingSlug 使用 slugger 返回标题 heading 在文档内不重复的锚点 id，slugger 为 nil 时和 HeadingID 一致。
+//
+// 只有 HeadingID 的结果会缓存在标题节点上，其他 slugger 每次调用都会重新计算整个文档的锚点 id，需要多次获取时使用 HeadingSlugs。
+func HeadingSlug(heading *ast.Node, slugger Slugger) (ret string) {
+	if nil != slugger {
+		return HeadingSlugs(headingRoot(heading), slugger)[heading]
+	}
 	if 0 == len(util.StrToBytes(heading.HeadingNormalizedID)) {
-		headingID0(heading)
+		for n, id := range HeadingSlugs(headingRoot(heading), nil) {
+			n.HeadingNormalizedID = id
+		}
 	}
 	return heading.HeadingNormalizedID
 }
 
-func headingID0(heading *ast.Node) {
-	var root *ast.Node
-	for root = heading.Parent; ast.NodeDocument != root.Type; root = root.Parent {
-	}
-
+// HeadingSlugs 使用 slugger 返回文档 root 中所有标题在文档内不重复的锚点 id。
+func HeadingSlugs(root *ast.Node, slugger Slugger) (ret map[*ast.Node]string) {
+	ret = map[*ast.Node]string{}
 	idOccurs := map[string]int{}
+	slugs := headingSlugs{}
 	ast.Walk(root, func(n *ast.Node, entering bool) ast.WalkStatus {
 		if entering {
 			if ast.NodeHeading == n.Type {
+				if nil != slugger {
+					ret[n] = slugs.unique(slugHeadingID(n, slugger))
+					return ast.WalkContinue
+				}
+
 				id := normalizeHeadingID(n)
 				for ; 0 < idOccurs[id]; id += "-" {
 				}
-				n.HeadingNormalizedID = id
+				ret[n] = id
 				idOccurs[id] = 1
 			}
 		}
 		return ast.WalkContinue
 	})
+	return
+}
+
+// HeadingBaseSlug 使用 slugger 返回标题 heading 未去重的锚点 id，用于检查重复的锚点 id。
+func HeadingBaseSlug(heading *ast.Node, slugger Slugger) string {
+	if nil == slugger {
+		return normalizeHeadingID(heading)
+	}
+	return slugHeadingID(heading, slugger)
+}
+
+// headingID 使用渲染选项 Slugger 返回标题 heading 的锚点 id。
+func (r *BaseRenderer) headingID(heading *ast.Node) string {
+	if nil == r.Options.Slugger {
+		return HeadingID(heading)
+	}
+	id, ok := r.headingSlugs[heading]
+	if !ok {
+		r.headingSlugs = HeadingSlugs(headingRoot(heading), r.Options.Slugger)
+		id = r.headingSlugs[heading]
+	}
+	return id
+}
+
+// headingRoot 返回标题 heading 所在的文档节点。
+func headingRoot(heading *ast.Node) (ret *ast.Node) {
+	for ret = heading.Parent; ast.NodeDocument != ret.Type; ret = ret.Parent {
+	}
+	return
+}
+
+// slugHeadingID 使用 slugger 生成标题 heading 的锚点 id，显式指定的 id 原样使用。
+func slugHeadingID(heading *ast.Node, slugger Slugger) string {
+	if nil != parse.PandocAttributes(heading) {
+		if id := heading.IALAttr("id"); "" != id {
+			return id
+		}
+	}
+	if headingID := heading.ChildByType(ast.NodeHeadingID); nil != headingID {
+		if id := util.BytesToStr(headingID.Tokens); "" != id {
+			return strings.ReplaceAll(strings.TrimLeft(id, "#"), editor.Caret, "")
+		}
+	}
+	return slugger.Slug(strings.ReplaceAll(heading.Content(), editor.Caret, ""))
 }
 
 func normalizeHeadingID(heading *ast.Node) (ret string) {
+	if nil != parse.PandocAttributes(heading) {
+		// # Heading {#id} 显式指定的 id 原样使用
+		if id := heading.IALAttr("id"); "" != id {
+			return id
+		}
+	}
+
 	headingID := heading.ChildByType(ast.NodeHeadingID)
 	var id string
 	if nil != headingID {
@@ -469,7 +589,7 @@ func (r *BaseRenderer) headings() (ret []*Heading) {
 			continue
 		}
 
-		id := HeadingID(heading)
+		id := r.headingID(heading)
 		if r.Options.VditorWYSIWYG {
 			id = "wysiwyg-" + id
 		} else if r.Options.VditorIR {
@@ -584,14 +704,7 @@ func (r *BaseRenderer) setextHeadingLen(node *ast.Node) (ret int) {
 	content := buf.String()
 	content = strings.ReplaceAll(content, editor.Caret, "")
 	lines := strings.Split(content, "\n")
-	lastLine := lines[len(lines)-1]
-	for _, r := range lastLine {
-		if utf8.RuneSelf <= r {
-			ret += 2
-		} else {
-			ret++
-		}
-	}
+	ret = util.DisplayWidth(lines[len(lines)-1])
 	if 0 == ret {
 		ret = 3
 	}
@@ -615,6 +728,12 @@ func (r *BaseRenderer) renderListStyle(node *ast.Node, attrs *[][]string) {
 	}
 }
 
+func (r *BaseRenderer) renderSourcePos(node *ast.Node, attrs *[][]string) {
+	if r.Options.SourcePos && nil != node.SourcePos {
+		*attrs = append(*attrs, []string{"data-sourcepos", node.SourcePos.String()})
+	}
+}
+
 func (r *BaseRenderer) tagSrc(tokens []byte) []byte {
 	if srcIndex := bytes.Index(tokens, []byte("src=\"")); 0 > srcIndex {
 		return nil
@@ -0,0 +1,148 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package render
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Slugger 描述了标题锚点 id 生成器。
+type Slugger interface {
+	// Slug 返回标题文本 text 对应的锚点 id，重复的 id 由调用方添加 -1、-2 等数字后缀。
+	Slug(text string) string
+}
+
+// SluggerFunc 将普通函数适配为 Slugger。
+type SluggerFunc func(text string) string
+
+// Slug 调用 f(text)。
+func (f SluggerFunc) Slug(text string) string {
+	return f(text)
+}
+
+var (
+	// GitHubSlugger 使用 GitHub 的规则生成锚点：转为小写，删除标点和符号，每个空白替换为 -。
+	GitHubSlugger Slugger = SluggerFunc(githubSlug)
+	// GitLabSlugger 使用 GitLab 的规则生成锚点：在 GitHub 规则的基础上删除下划线以外的非单词字符，并合并连续的 -。
+	GitLabSlugger Slugger = SluggerFunc(gitlabSlug)
+	// GoldmarkSlugger 使用 Hugo/Goldmark 的规则生成锚点：只保留 ASCII 字母和数字，空白、- 和 _ 替换为 -，结果为空时使用 heading。
+	GoldmarkSlugger Slugger = SluggerFunc(goldmarkSlug)
+	// PinyinSlugger 将汉字转换为不带声调的拼音，其他字符使用 GitLab 规则，比如 “中文 Title” 生成 zhong-wen-title。
+	PinyinSlugger Slugger = SluggerFunc(pinyinSlug)
+)
+
+// Sluggers 定义了内置的标题锚点 id 生成器，可以通过名称选择。
+var Sluggers = map[string]Slugger{
+	"github":   GitHubSlugger,
+	"gitlab":   GitLabSlugger,
+	"goldmark": GoldmarkSlugger,
+	"hugo":     GoldmarkSlugger,
+	"pinyin":   PinyinSlugger,
+}
+
+func githubSlug(text string) string {
+	buf := strings.Builder{}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || '_' == r || '-' == r:
+			buf.WriteRune(r)
+		case ' ' == r:
+			buf.WriteByte('-')
+		}
+	}
+	return buf.String()
+}
+
+func gitlabSlug(text string) string {
+	buf := strings.Builder{}
+	for _, r := range strings.ToLower(strings.TrimSpace(text)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || '_' == r:
+			buf.WriteRune(r)
+		case unicode.IsSpace(r) || '-' == r:
+			buf.WriteByte('-')
+		}
+	}
+	return collapseSlugDashes(buf.String())
+}
+
+func goldmarkSlug(text string) string {
+	buf := strings.Builder{}
+	text = strings.TrimSpace(text)
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case 'A' <= c && 'Z' >= c:
+			buf.WriteByte(c + 'a' - 'A')
+		case 'a' <= c && 'z' >= c || '0' <= c && '9' >= c:
+			buf.WriteByte(c)
+		case ' ' == c || '\t' == c || '\n' == c || '-' == c || '_' == c:
+			buf.WriteByte('-')
+		}
+	}
+	if 0 == buf.Len() {
+		return "heading"
+	}
+	return buf.String()
+}
+
+func pinyinSlug(text string) string {
+	buf := strings.Builder{}
+	for _, r := range text {
+		if syllable := pinyin(r); "" != syllable {
+			buf.WriteByte(' ')
+			buf.WriteString(syllable)
+			buf.WriteByte(' ')
+			continue
+		}
+		buf.WriteRune(r)
+	}
+	return strings.Trim(gitlabSlug(buf.String()), "-")
+}
+
+// collapseSlugDashes 将连续的 - 合并为一个。
+func collapseSlugDashes(slug string) string {
+	for strings.Contains(slug, "--") {
+		slug = strings.ReplaceAll(slug, "--", "-")
+	}
+	return slug
+}
+
+// pinyinAlphabet 和 slug_pinyin_gen.go 中编码拼音音节序号使用的字符一致。
+const pinyinAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz-_"
+
+// pinyin 返回汉字 r 不带声调的拼音，多音字使用最常用的读音，不是汉字时返回空字符串。
+func pinyin(r rune) string {
+	if 0x4E00 > r || 0x9FFF < r {
+		return ""
+	}
+	i := int(r-0x4E00) * 2
+	n := strings.IndexByte(pinyinAlphabet, pinyinIndex[i])*len(pinyinAlphabet) + strings.IndexByte(pinyinAlphabet, pinyinIndex[i+1])
+	return pinyinSyllables[n]
+}
+
+// headingSlugs 用于生成文档内不重复的标题锚点 id，重复时依次添加 -1、-2 等数字后缀。
+type headingSlugs map[string]int
+
+func (slugs headingSlugs) unique(slug string) string {
+	ret := slug
+	for {
+		if _, ok := slugs[ret]; !ok {
+			break
+		}
+		slugs[slug]++
+		ret = slug + "-" + strconv.Itoa(slugs[slug])
+	}
+	slugs[ret] = 0
+	return ret
+}
@@ -0,0 +1,125 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+//go:build ignore
+
+// 使用 ICU 的 Han-Latin 音译规则生成 slug_pinyin_data.go，需要安装 ICU 命令行工具 uconv：
+//
+//	go run slug_pinyin_gen.go
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+)
+
+const (
+	first = 0x4E00 // CJK 统一表意文字区块
+	last  = 0x9FFF
+)
+
+// alphabet 用于将拼音音节序号编码为两个字符，序号 0 表示没有读音。
+const alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz-_"
+
+func main() {
+	input := &bytes.Buffer{}
+	for r := first; r <= last; r++ {
+		input.WriteRune(rune(r))
+		input.WriteByte('\n')
+	}
+	cmd := exec.Command("uconv", "-x", "Han-Latin; Latin-ASCII; Lower")
+	cmd.Stdin = input
+	output, err := cmd.Output()
+	if nil != err {
+		panic(err)
+	}
+	version, err := exec.Command("uconv", "--version").Output()
+	if nil != err {
+		panic(err)
+	}
+
+	var readings []string
+	syllables := map[string]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		reading := strings.TrimSpace(scanner.Text())
+		if !isPinyin(reading) {
+			reading = ""
+		} else {
+			syllables[reading] = true
+		}
+		readings = append(readings, reading)
+	}
+	if last-first+1 != len(readings) {
+		panic(fmt.Sprintf("unexpected readings count [%d]", len(readings)))
+	}
+
+	var list []string
+	for syllable := range syllables {
+		list = append(list, syllable)
+	}
+	sort.Strings(list)
+	index := map[string]int{}
+	for i, syllable := range list {
+		index[syllable] = i + 1
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteString("// Code generated by go run slug_pinyin_gen.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "// 数据来源 %s\n\n", strings.TrimSpace(string(version)))
+	buf.WriteString("package render\n\n")
+	buf.WriteString("// pinyinSyllables 定义了不带声调的拼音音节，序号从 1 开始。\n")
+	buf.WriteString("var pinyinSyllables = []string{\"\",\n")
+	for _, syllable := range list {
+		fmt.Fprintf(buf, "%q,\n", syllable)
+	}
+	buf.WriteString("}\n\n")
+	fmt.Fprintf(buf, "// pinyinIndex 按码点顺序记录了 U+%04X 到 U+%04X 每个汉字的拼音音节序号，每个序号使用 pinyinAlphabet 中的两个字符编码。\n", first, last)
+	buf.WriteString("const pinyinIndex = \"\" +\n")
+	for i := 0; i < len(readings); i += 64 {
+		line := &strings.Builder{}
+		for j := i; j < i+64 && j < len(readings); j++ {
+			n := index[readings[j]]
+			line.WriteByte(alphabet[n/len(alphabet)])
+			line.WriteByte(alphabet[n%len(alphabet)])
+		}
+		fmt.Fprintf(buf, "%q", line.String())
+		if i+64 < len(readings) {
+			buf.WriteString(" +")
+		}
+		buf.WriteString("\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if nil != err {
+		panic(err)
+	}
+	if err = os.WriteFile("slug_pinyin_data.go", src, 0644); nil != err {
+		panic(err)
+	}
+}
+
+func isPinyin(s string) bool {
+	if "" == s {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if 'a' > s[i] || 'z' < s[i] {
+			return false
+		}
+	}
+	return true
+}
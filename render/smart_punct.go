@@ -0,0 +1,205 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package render
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Dofingert/lute-for-ficus/ast"
+)
+
+// SmartQuotes 描述了一种语言使用的引号：双引号开闭和单引号开闭。
+type SmartQuotes struct {
+	DoubleOpen, DoubleClose string
+	SingleOpen, SingleClose string
+}
+
+// SmartQuotesStyles 定义了各语言使用的引号，键为语言主标签，比如 zh-TW 使用 zh。
+var SmartQuotesStyles = map[string]*SmartQuotes{
+	"en": {"“", "”", "‘", "’"},
+	"de": {"„", "“", "‚", "‘"},
+	"fr": {"« ", " »", "‹ ", " ›"},
+	"ru": {"«", "»", "„", "“"},
+	"zh": {"「", "」", "『", "』"},
+	"ja": {"「", "」", "『", "』"},
+}
+
+// smartQuotes 返回文本节点 node 使用的引号，优先使用所在块级节点上的 lang 属性，其次使用选项 SmartPunctLang。
+func (r *BaseRenderer) smartQuotes(node *ast.Node) *SmartQuotes {
+	lang := r.Options.SmartPunctLang
+	for p := node.Parent; nil != p; p = p.Parent {
+		if l := p.IALAttr("lang"); "" != l {
+			lang = l
+			break
+		}
+	}
+
+	lang = strings.ToLower(lang)
+	if i := strings.IndexAny(lang, "-_"); 0 < i {
+		lang = lang[:i]
+	}
+	if quotes := SmartQuotesStyles[lang]; nil != quotes {
+		return quotes
+	}
+	return SmartQuotesStyles["en"]
+}
+
+// SmartPunct 将文本节点 node 的 tokens 中的直引号替换为弯引号，-- 和 --- 替换为连接号和破折号，... 替换为省略号，跳过其中的网址。
+func (r *BaseRenderer) SmartPunct(node *ast.Node, tokens []byte) []byte {
+	if !bytes.ContainsAny(tokens, "\"'-.") {
+		return tokens
+	}
+
+	quotes := r.smartQuotes(node)
+	// 未闭合的单引号数按块级节点统计，单引号中间可能有强调等行级节点
+	block := node.Parent
+	for ; nil != block && !block.IsBlock(); block = block.Parent {
+	}
+	if block != r.smartBlock {
+		r.smartBlock, r.smartSingles = block, 0
+	}
+	opened := -1 // 上一个左引号的位置
+	buf := bytes.Buffer{}
+	length := len(tokens)
+	for i := 0; i < length; {
+		c := tokens[i]
+		if isSmartPunctURL(tokens, i) {
+			j := i
+			for ; j < length && !unicode.IsSpace(rune(tokens[j])); j++ {
+			}
+			buf.Write(tokens[i:j])
+			i = j
+			continue
+		}
+
+		switch {
+		case '"' == c || '\'' == c:
+			prev, next := smartPunctPrev(node, tokens, i), smartPunctNext(node, tokens, i+1)
+			// 紧跟在左引号后的引号也是左引号，比如 "'a'"
+			open := smartQuoteOpen(prev, next) || (opened == i-1 && !unicode.IsSpace(next))
+			if '"' == c {
+				if open {
+					buf.WriteString(quotes.DoubleOpen)
+					opened = i
+				} else {
+					buf.WriteString(quotes.DoubleClose)
+				}
+			} else if 0 < r.smartSingles && !unicode.IsLetter(next) && !unicode.IsDigit(next) && !open {
+				buf.WriteString(quotes.SingleClose)
+				r.smartSingles--
+			} else if unicode.IsLetter(prev) || unicode.IsDigit(prev) {
+				// 单词中的撇号，比如 don't
+				buf.WriteString("’")
+			} else if unicode.IsSpace(prev) && unicode.IsDigit(next) {
+				// 年代的缩写，比如 '90s
+				buf.WriteString("’")
+			} else if open {
+				buf.WriteString(quotes.SingleOpen)
+				r.smartSingles++
+				opened = i
+			} else {
+				buf.WriteString(quotes.SingleClose)
+			}
+			i++
+		case '-' == c && i+1 < length && '-' == tokens[i+1]:
+			j := i
+			for ; j < length && '-' == tokens[j]; j++ {
+			}
+			buf.WriteString(smartDashes(j - i))
+			i = j
+		case '.' == c && i+2 < length && '.' == tokens[i+1] && '.' == tokens[i+2]:
+			buf.WriteString("…")
+			i += 3
+		default:
+			buf.WriteByte(c)
+			i++
+		}
+	}
+	return buf.Bytes()
+}
+
+// smartDashes 返回 n 个连续 - 对应的连接号和破折号，--- 为破折号 —，-- 为连接号 –，更长时尽量使用相同的符号。
+func smartDashes(n int) string {
+	var em, en int
+	switch {
+	case 0 == n%3:
+		em = n / 3
+	case 0 == n%2:
+		en = n / 2
+	case 2 == n%3:
+		em, en = n/3, 1
+	default:
+		em, en = (n-4)/3, 2
+	}
+	return strings.Repeat("—", em) + strings.Repeat("–", en)
+}
+
+// smartQuoteOpen 判断前一个字符为 prev、后一个字符为 next 的引号是否是左引号。
+func smartQuoteOpen(prev, next rune) bool {
+	if unicode.IsSpace(next) {
+		return false
+	}
+	return unicode.IsSpace(prev) || strings.ContainsRune("([{<-–—“‘«„‚", prev)
+}
+
+// smartPunctPrev 返回 tokens[i] 前的字符，位于文本节点开头时使用前一个兄弟节点的文本，没有字符时返回空格。
+func smartPunctPrev(node *ast.Node, tokens []byte, i int) rune {
+	if 0 < i {
+		ret, _ := utf8.DecodeLastRune(tokens[:i])
+		return ret
+	}
+	if nil == node.Previous {
+		return ' '
+	}
+	if text := node.PreviousNodeText(); "" != text {
+		ret, _ := utf8.DecodeLastRuneInString(text)
+		return ret
+	}
+	return smartPunctSibling(node.Previous)
+}
+
+// smartPunctNext 返回 tokens[i] 处的字符，超出文本节点时使用后一个兄弟节点的文本，没有字符时返回空格。
+func smartPunctNext(node *ast.Node, tokens []byte, i int) rune {
+	if i < len(tokens) {
+		ret, _ := utf8.DecodeRune(tokens[i:])
+		return ret
+	}
+	if nil == node.Next {
+		return ' '
+	}
+	if text := node.NextNodeText(); "" != text {
+		ret, _ := utf8.DecodeRuneInString(text)
+		return ret
+	}
+	return smartPunctSibling(node.Next)
+}
+
+// smartPunctSibling 返回没有文本的兄弟节点 sibling 对应的字符，代码和公式当作单词，其他节点（比如换行和标记符）当作空格。
+func smartPunctSibling(sibling *ast.Node) rune {
+	switch sibling.Type {
+	case ast.NodeCodeSpan, ast.NodeInlineMath, ast.NodeInlineHTML, ast.NodeEmoji, ast.NodeHTMLEntity:
+		return 'a'
+	}
+	return ' '
+}
+
+// isSmartPunctURL 判断 tokens[i:] 是否以网址开头。
+func isSmartPunctURL(tokens []byte, i int) bool {
+	if 0 < i && !unicode.IsSpace(rune(tokens[i-1])) && !bytes.ContainsAny(tokens[i-1:i], "([<\"'") {
+		return false
+	}
+	rest := tokens[i:]
+	return bytes.HasPrefix(rest, []byte("http://")) || bytes.HasPrefix(rest, []byte("https://")) ||
+		bytes.HasPrefix(rest, []byte("ftp://")) || bytes.HasPrefix(rest, []byte("www."))
+}
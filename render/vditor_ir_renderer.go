@@ -63,6 +63,9 @@ func NewVditorIRRenderer(tree *parse.Tree, options *Options) *VditorIRRenderer {
 	ret.RendererFuncs[ast.NodeStrongU8eOpenMarker] = ret.renderStrongU8eOpenMarker
 	ret.RendererFuncs[ast.NodeStrongU8eCloseMarker] = ret.renderStrongU8eCloseMarker
 	ret.RendererFuncs[ast.NodeBlockquote] = ret.renderBlockquote
+	ret.RendererFuncs[ast.NodeCallout] = ret.renderCallout
+	ret.RendererFuncs[ast.NodeFencedDiv] = ret.renderFencedDiv
+	ret.RendererFuncs[ast.NodeDefinitionList] = ret.renderDefinitionList
 	ret.RendererFuncs[ast.NodeBlockquoteMarker] = ret.renderBlockquoteMarker
 	ret.RendererFuncs[ast.NodeHeading] = ret.renderHeading
 	ret.RendererFuncs[ast.NodeHeadingC8hMarker] = ret.renderHeadingC8hMarker
@@ -128,6 +131,11 @@ func NewVditorIRRenderer(tree *parse.Tree, options *Options) *VditorIRRenderer {
 	ret.RendererFuncs[ast.NodeKramdownBlockIAL] = ret.renderKramdownBlockIAL
 	ret.RendererFuncs[ast.NodeLinkRefDefBlock] = ret.renderLinkRefDefBlock
 	ret.RendererFuncs[ast.NodeLinkRefDef] = ret.renderLinkRefDef
+	ret.RendererFuncs[ast.NodeWikiLink] = ret.renderWikiLink
+	ret.RendererFuncs[ast.NodeCrossRef] = ret.renderCrossRef
+	ret.RendererFuncs[ast.NodeCitation] = ret.renderCitation
+	ret.RendererFuncs[ast.NodeBracketedSpan] = ret.renderBracketedSpan
+	ret.RendererFuncs[ast.NodeKramdownSpanIAL] = ret.renderKramdownSpanIAL
 	return ret
 }
 
@@ -366,7 +374,7 @@ func (r *VditorIRRenderer) renderFootnotesDef(node *ast.Node, entering bool) ast
 		r.WriteString("[" + string(node.Tokens) + "]: ")
 		for c := node.FirstChild; nil != c; c = c.Next {
 			ast.Walk(c, func(n *ast.Node, entering bool) ast.WalkStatus {
-				return r.RendererFuncs[n.Type](n, entering)
+				return r.rendererFunc(n.Type)(n, entering)
 			})
 		}
 		r.WriteString("</div>")
@@ -1103,6 +1111,43 @@ func (r *VditorIRRenderer) renderParagraph(node *ast.Node, entering bool) ast.Wa
 	return ast.WalkContinue
 }
 
+func (r *VditorIRRenderer) renderBracketedSpan(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.WriteByte(lex.ItemOpenBracket)
+	} else {
+		r.WriteByte(lex.ItemCloseBracket)
+	}
+	return ast.WalkContinue
+}
+
+func (r *VditorIRRenderer) renderKramdownSpanIAL(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Write(html.EscapeHTML(node.Tokens))
+	}
+	return ast.WalkContinue
+}
+
+func (r *VditorIRRenderer) renderCrossRef(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Write(html.EscapeHTML(node.Tokens))
+	}
+	return ast.WalkContinue
+}
+
+func (r *VditorIRRenderer) renderCitation(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Write(html.EscapeHTML(node.Tokens))
+	}
+	return ast.WalkContinue
+}
+
+func (r *VditorIRRenderer) renderWikiLink(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.WriteString(html.EscapeHTMLStr(wikiLinkMarkdown(node)))
+	}
+	return ast.WalkContinue
+}
+
 func (r *VditorIRRenderer) renderText(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		tokens := node.Tokens
@@ -1259,6 +1304,33 @@ func (r *VditorIRRenderer) renderStrongU8eCloseMarker(node *ast.Node, entering b
 	return ast.WalkContinue
 }
 
+func (r *VditorIRRenderer) renderCallout(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Tag("blockquote", append([][]string{{"data-block", "0"}}, calloutAttrs(node)...), false)
+	} else {
+		r.WriteString("</blockquote>")
+	}
+	return ast.WalkContinue
+}
+
+func (r *VditorIRRenderer) renderDefinitionList(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Tag("p", [][]string{{"data-block", "0"}}, false)
+		r.Write(html.EscapeHTML(definitionListMarkdown(r.Tree, node, r.Options)))
+		r.Tag("/p", nil, false)
+	}
+	return ast.WalkSkipChildren
+}
+
+func (r *VditorIRRenderer) renderFencedDiv(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		r.Tag("div", [][]string{{"data-block", "0"}, {"data-type", "fenced-div"}, {"data-fenced-div", html.EscapeAttrVal(fencedDivAttrs(node))}}, false)
+	} else {
+		r.WriteString("</div>")
+	}
+	return ast.WalkContinue
+}
+
 func (r *VditorIRRenderer) renderBlockquote(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.WriteString(`<blockquote data-block="0">`)
@@ -1287,7 +1359,7 @@ func (r *VditorIRRenderer) renderHeading(node *ast.Node, entering bool) ast.Walk
 			id = string(headingID.Tokens)
 		}
 		if "" == id {
-			id = HeadingID(node)
+			id = r.headingID(node)
 		}
 		r.WriteString(" id=\"ir-" + id + "\"")
 		if !node.HeadingSetext {
@@ -1300,7 +1372,7 @@ func (r *VditorIRRenderer) renderHeading(node *ast.Node, entering bool) ast.Walk
 			}
 		}
 		if r.Options.HeadingAnchor {
-			id := HeadingID(node)
+			id := r.headingID(node)
 			r.Tag("a", [][]string{{"id", "vditorAnchor-" + id}, {"class", "vditor-anchor"}, {"href", "#" + id}}, false)
 			r.WriteString(`<svg viewBox="0 0 16 16" version="1.1" width="16" height="16"><path fill-rule="evenodd" d="M4 9h1v1H4c-1.5 0-3-1.69-3-3.5S2.55 3 4 3h4c1.45 0 3 1.69 3 3.5 0 1.41-.91 2.72-2 3.25V8.59c.58-.45 1-1.27 1-2.09C10 5.22 8.98 4 8 4H4c-.98 0-2 1.22-2 2.5S3 9 4 9zm9-3h-1v1h1c1 0 2 1.22 2 2.5S13.98 12 13 12H9c-.98 0-2-1.22-2-2.5 0-.83.42-1.64 1-2.09V6.25c-1.09.53-2 1.84-2 3.25C6 11.31 7.55 13 9 13h4c1.45 0 3-1.69 3-3.5S14.5 6 13 6z"></path></svg>`)
 			r.Tag("/a", nil, false)
@@ -1407,6 +1479,8 @@ func (r *VditorIRRenderer) renderTaskListItemMarker(node *ast.Node, entering boo
 		var attrs [][]string
 		if node.TaskListItemChecked {
 			attrs = append(attrs, []string{"checked", ""})
+		} else if state := node.TaskState(); parse.IsExtendedTaskState(state) {
+			attrs = append(attrs, []string{"data-task", string(state)})
 		}
 		attrs = append(attrs, []string{"type", "checkbox"})
 		r.Tag("input", attrs, true)
@@ -101,6 +101,9 @@ func NewVditorSVRenderer(tree *parse.Tree, options *Options) *VditorSVRenderer {
 	ret.RendererFuncs[ast.NodeStrongU8eOpenMarker] = ret.renderStrongU8eOpenMarker
 	ret.RendererFuncs[ast.NodeStrongU8eCloseMarker] = ret.renderStrongU8eCloseMarker
 	ret.RendererFuncs[ast.NodeBlockquote] = ret.renderBlockquote
+	ret.RendererFuncs[ast.NodeCallout] = ret.renderBlockquote
+	ret.RendererFuncs[ast.NodeFencedDiv] = ret.renderFencedDiv
+	ret.RendererFuncs[ast.NodeDefinitionList] = ret.renderDefinitionList
 	ret.RendererFuncs[ast.NodeBlockquoteMarker] = ret.renderBlockquoteMarker
 	ret.RendererFuncs[ast.NodeHeading] = ret.renderHeading
 	ret.RendererFuncs[ast.NodeHeadingC8hMarker] = ret.renderHeadingC8hMarker
@@ -166,6 +169,11 @@ func NewVditorSVRenderer(tree *parse.Tree, options *Options) *VditorSVRenderer {
 	ret.RendererFuncs[ast.NodeKramdownBlockIAL] = ret.renderKramdownBlockIAL
 	ret.RendererFuncs[ast.NodeLinkRefDefBlock] = ret.renderLinkRefDefBlock
 	ret.RendererFuncs[ast.NodeLinkRefDef] = ret.renderLinkRefDef
+	ret.RendererFuncs[ast.NodeWikiLink] = ret.renderWikiLink
+	ret.RendererFuncs[ast.NodeCrossRef] = ret.renderCrossRef
+	ret.RendererFuncs[ast.NodeCitation] = ret.renderCitation
+	ret.RendererFuncs[ast.NodeBracketedSpan] = ret.renderBracketedSpan
+	ret.RendererFuncs[ast.NodeKramdownSpanIAL] = ret.renderKramdownSpanIAL
 	return ret
 }
 
@@ -418,7 +426,7 @@ func (r *VditorSVRenderer) renderFootnotesDef(node *ast.Node, entering bool) ast
 						r.WriteString(indentSpacesStr)
 					}
 				}
-				return r.RendererFuncs[n.Type](n, entering)
+				return r.rendererFunc(n.Type)(n, entering)
 			})
 		}
 		return ast.WalkSkipChildren
@@ -913,6 +921,73 @@ func (r *VditorSVRenderer) inListItem(node *ast.Node) bool {
 	return nil != grandparent && ast.NodeList == grandparent.Type
 }
 
+func (r *VditorSVRenderer) renderBracketedSpan(node *ast.Node, entering bool) ast.WalkStatus {
+	if node.ParentIs(ast.NodeTableCell) {
+		return ast.WalkContinue
+	}
+
+	r.Tag("span", [][]string{{"class", "vditor-sv__marker--bracket"}}, false)
+	if entering {
+		r.WriteByte(lex.ItemOpenBracket)
+	} else {
+		r.WriteByte(lex.ItemCloseBracket)
+	}
+	r.Tag("/span", nil, false)
+	return ast.WalkContinue
+}
+
+func (r *VditorSVRenderer) renderKramdownSpanIAL(node *ast.Node, entering bool) ast.WalkStatus {
+	if node.ParentIs(ast.NodeTableCell) {
+		return ast.WalkContinue
+	}
+
+	if entering {
+		r.Tag("span", [][]string{{"data-type", "text"}}, false)
+		r.Write(html.EscapeHTML(node.Tokens))
+		r.Tag("/span", nil, false)
+	}
+	return ast.WalkContinue
+}
+
+func (r *VditorSVRenderer) renderCrossRef(node *ast.Node, entering bool) ast.WalkStatus {
+	if node.ParentIs(ast.NodeTableCell) {
+		return ast.WalkContinue
+	}
+
+	if entering {
+		r.Tag("span", [][]string{{"data-type", "text"}}, false)
+		r.Write(html.EscapeHTML(node.Tokens))
+		r.Tag("/span", nil, false)
+	}
+	return ast.WalkContinue
+}
+
+func (r *VditorSVRenderer) renderCitation(node *ast.Node, entering bool) ast.WalkStatus {
+	if node.ParentIs(ast.NodeTableCell) {
+		return ast.WalkContinue
+	}
+
+	if entering {
+		r.Tag("span", [][]string{{"data-type", "text"}}, false)
+		r.Write(html.EscapeHTML(node.Tokens))
+		r.Tag("/span", nil, false)
+	}
+	return ast.WalkContinue
+}
+
+func (r *VditorSVRenderer) renderWikiLink(node *ast.Node, entering bool) ast.WalkStatus {
+	if node.ParentIs(ast.NodeTableCell) {
+		return ast.WalkContinue
+	}
+
+	if entering {
+		r.Tag("span", [][]string{{"data-type", "text"}}, false)
+		r.WriteString(html.EscapeHTMLStr(wikiLinkMarkdown(node)))
+		r.Tag("/span", nil, false)
+	}
+	return ast.WalkContinue
+}
+
 func (r *VditorSVRenderer) renderText(node *ast.Node, entering bool) ast.WalkStatus {
 	if node.ParentIs(ast.NodeTableCell) {
 		return ast.WalkContinue
@@ -1074,10 +1149,61 @@ func (r *VditorSVRenderer) renderStrongU8eCloseMarker(node *ast.Node, entering b
 	return ast.WalkContinue
 }
 
+func (r *VditorSVRenderer) renderDefinitionList(node *ast.Node, entering bool) ast.WalkStatus {
+	if entering {
+		inFootnotesDef := node.ParentIs(ast.NodeFootnotesDef)
+		for i, line := range bytes.Split(definitionListMarkdown(r.Tree, node, r.Options), []byte{lex.ItemNewline}) {
+			if 0 < i {
+				r.Write(NewlineSV)
+			}
+			if 1 > len(line) {
+				continue
+			}
+			if 0 < i && inFootnotesDef {
+				r.WriteString(`<span data-type="padding">    </span>`)
+			}
+			r.Tag("span", [][]string{{"data-type", "text"}}, false)
+			r.Write(html.EscapeHTML(line))
+			r.Tag("/span", nil, false)
+		}
+		r.Newline()
+		r.Write(NewlineSV)
+	}
+	return ast.WalkSkipChildren
+}
+
+func (r *VditorSVRenderer) renderFencedDiv(node *ast.Node, entering bool) ast.WalkStatus {
+	fence := fencedDivFence(node)
+	if entering {
+		r.Tag("span", [][]string{{"class", "vditor-sv__marker"}}, false)
+		r.WriteString(fence + " " + html.EscapeHTMLStr(fencedDivAttrs(node)))
+		r.Tag("/span", nil, false)
+		r.Newline()
+	} else {
+		if node.ParentIs(ast.NodeFootnotesDef) {
+			// 和脚注定义中的其他块一样缩进闭合标记符
+			r.WriteString(`<span data-type="padding">    </span>`)
+		}
+		r.Tag("span", [][]string{{"class", "vditor-sv__marker"}}, false)
+		r.WriteString(fence)
+		r.Tag("/span", nil, false)
+		r.Newline()
+		r.Write(NewlineSV)
+	}
+	return ast.WalkContinue
+}
+
 func (r *VditorSVRenderer) renderBlockquote(node *ast.Node, entering bool) ast.WalkStatus {
 	if entering {
 		r.Writer = &bytes.Buffer{}
 		r.nodeWriterStack = append(r.nodeWriterStack, r.Writer)
+		if ast.NodeCallout == node.Type {
+			r.Tag("span", [][]string{{"data-type", "callout-marker"}, {"class", "vditor-sv__marker--info"}}, false)
+			r.WriteString(html.EscapeHTMLStr(calloutMarker(node)))
+			r.Tag("/span", nil, false)
+			r.Newline()
+			r.Write(NewlineSV)
+		}
 	} else {
 		writer := r.nodeWriterStack[len(r.nodeWriterStack)-1]
 		r.nodeWriterStack = r.nodeWriterStack[:len(r.nodeWriterStack)-1]
@@ -1252,6 +1378,10 @@ func (r *VditorSVRenderer) renderTaskListItemMarker(node *ast.Node, entering boo
 		r.Tag("span", [][]string{{"data-type", "task-marker"}, {"class", "vditor-sv__marker--strong"}}, false)
 		r.WriteByte('x')
 		r.Tag("/span", nil, false)
+	} else if state := node.TaskState(); parse.IsExtendedTaskState(state) {
+		r.Tag("span", [][]string{{"data-type", "task-marker"}, {"class", "vditor-sv__marker--strong"}}, false)
+		r.WriteByte(state)
+		r.Tag("/span", nil, false)
 	} else {
 		r.Tag("span", [][]string{{"data-type", "task-marker"}, {"class", "vditor-sv__marker--bi"}}, false)
 		r.WriteByte(lex.ItemSpace)
@@ -0,0 +1,84 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package render
+
+import (
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/html"
+	"github.com/Dofingert/lute-for-ficus/util"
+)
+
+// WikiLinkResolver 描述了 Wiki 链接地址解析器。
+type WikiLinkResolver interface {
+	// ResolveWikiLink 返回 Wiki 链接节点 node 的链接地址 href，目标页面不存在时 exists 返回 false。
+	ResolveWikiLink(node *ast.Node) (href string, exists bool)
+}
+
+// WikiLinkResolverFunc 将普通函数适配为 WikiLinkResolver。
+type WikiLinkResolverFunc func(node *ast.Node) (href string, exists bool)
+
+// ResolveWikiLink 调用 f(node)。
+func (f WikiLinkResolverFunc) ResolveWikiLink(node *ast.Node) (href string, exists bool) {
+	return f(node)
+}
+
+// wikiLinkHref 返回 Wiki 链接节点 node 的链接地址，没有设置解析器时使用 target#heading 或者 target#^block。
+func (r *BaseRenderer) wikiLinkHref(node *ast.Node) (href string, exists bool) {
+	if nil != r.Options.WikiLinkResolver {
+		return r.Options.WikiLinkResolver.ResolveWikiLink(node)
+	}
+
+	href = node.WikiLinkTarget
+	if "" != node.WikiLinkHeading {
+		href += "#" + node.WikiLinkHeading
+	} else if "" != node.WikiLinkBlock {
+		href += "#^" + node.WikiLinkBlock
+	}
+	href = util.BytesToStr(r.LinkPath(html.EncodeDestination([]byte(href))))
+	return href, true
+}
+
+// wikiLinkText 返回 Wiki 链接节点 node 的锚文本，没有设置别名时使用 target > heading 的形式。
+func wikiLinkText(node *ast.Node) string {
+	if "" != node.WikiLinkAlias {
+		return node.WikiLinkAlias
+	}
+
+	ret := node.WikiLinkTarget
+	anchor := node.WikiLinkHeading
+	if "" == anchor && "" != node.WikiLinkBlock {
+		anchor = "^" + node.WikiLinkBlock
+	}
+	if "" != anchor {
+		if "" != ret {
+			ret += " > "
+		}
+		ret += anchor
+	}
+	return ret
+}
+
+// wikiLinkMarkdown 返回 Wiki 链接节点 node 的 Markdown 形式 [[target#heading|alias]]，表格中的别名分隔符使用 \|。
+func wikiLinkMarkdown(node *ast.Node) string {
+	ret := "[[" + node.WikiLinkTarget
+	if "" != node.WikiLinkHeading {
+		ret += "#" + node.WikiLinkHeading
+	} else if "" != node.WikiLinkBlock {
+		ret += "^" + node.WikiLinkBlock
+	}
+	if "" != node.WikiLinkAlias {
+		if node.ParentIs(ast.NodeTableCell) {
+			ret += "\\"
+		}
+		ret += "|" + node.WikiLinkAlias
+	}
+	return ret + "]]"
+}
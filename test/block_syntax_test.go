@@ -0,0 +1,146 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/parse"
+)
+
+const (
+	nodeAdmonition ast.NodeType = ast.NodeTypeMaxVal + 1 + iota
+	nodeComment
+)
+
+var blockSyntaxTests = []parseTest{
+
+	{"5", "%%\n> foo\n%%\n", ""},
+	{"4", "%%\nfoo\n\nbar\n%%\nbaz\n", "<p>baz</p>\n"},
+	{"3", "- !!! tip\n      foo\n", "<ul>\n<li><div class=\"admonition tip\">\n<p>foo</p>\n</div>\n</li>\n</ul>\n"},
+	{"2", "!!! note\n    > foo\n\n    bar\n\nbaz\n", "<div class=\"admonition note\">\n<blockquote>\n<p>foo</p>\n</blockquote>\n<p>bar</p>\n</div>\n<p>baz</p>\n"},
+	{"1", "!!! warning\n    *foo*\n", "<div class=\"admonition warning\">\n<p><em>foo</em></p>\n</div>\n"},
+	{"0", "!!!\n", "<p>!!!</p>\n"},
+}
+
+func TestBlockSyntax(t *testing.T) {
+	luteEngine := newBlockSyntaxLute(t)
+
+	for _, test := range blockSyntaxTests {
+		html := luteEngine.MarkdownStr(test.name, test.from)
+		if test.to != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\nmarkdown text\n\t%q", test.name, test.to, html, test.from)
+		}
+	}
+}
+
+func TestRegisterBlockSyntaxErrors(t *testing.T) {
+	luteEngine := lute.New()
+	start := func(t *parse.Tree, container *ast.Node) int { return 0 }
+	cont := func(n *ast.Node, context *parse.Context) int { return 1 }
+	if err := luteEngine.RegisterBlockSyntax(&parse.BlockSyntax{Type: ast.NodeCustomBlock, Start: start, Continue: cont}); nil == err {
+		t.Fatal("registering a built-in node type should fail")
+	}
+	if err := luteEngine.RegisterBlockSyntax(&parse.BlockSyntax{Type: nodeComment, Start: start}); nil == err {
+		t.Fatal("registering a block syntax without continue function should fail")
+	}
+	if err := luteEngine.RegisterBlockSyntax(&parse.BlockSyntax{Type: nodeComment, Start: start, Continue: cont}); nil != err {
+		t.Fatal(err)
+	}
+	if err := luteEngine.RegisterBlockSyntax(&parse.BlockSyntax{Type: nodeComment, Start: start, Continue: cont}); nil == err {
+		t.Fatal("registering a node type twice should fail")
+	}
+}
+
+// newBlockSyntaxLute 创建一个注册了两种扩展块级语法的引擎：
+//
+//   - 容器块 !!! kind，内容需要缩进 4 个空格
+//   - 叶子块 %%，直到下一个 %% 为止的内容作为注释不进行渲染
+func newBlockSyntaxLute(t *testing.T) *lute.Lute {
+	ret := lute.New()
+	err := ret.RegisterBlockSyntax(&parse.BlockSyntax{
+		Type:      nodeAdmonition,
+		Priority:  0,
+		Container: true,
+		Start: func(t *parse.Tree, container *ast.Node) int {
+			ln := t.Context.CurrentLine()[t.Context.NextNonspace():]
+			if t.Context.Indented() || !bytes.HasPrefix(ln, []byte("!!! ")) {
+				return 0
+			}
+			kind := bytes.TrimSpace(ln[4:])
+			if 1 > len(kind) {
+				return 0
+			}
+			t.Context.CloseUnmatchedBlocks()
+			admonition := t.Context.AddChild(nodeAdmonition)
+			admonition.Tokens = kind
+			t.Context.AdvanceOffset(len(t.Context.CurrentLine())-1-t.Context.Offset(), false) // 保留行尾换行符
+			return 1
+		},
+		Continue: func(n *ast.Node, context *parse.Context) int {
+			if context.Indented() {
+				context.AdvanceOffset(4, true)
+				return 0
+			}
+			if context.Blank() {
+				context.AdvanceNextNonspace()
+				return 0
+			}
+			return 1
+		},
+	})
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	err = ret.RegisterBlockSyntax(&parse.BlockSyntax{
+		Type:     nodeComment,
+		Priority: 100,
+		Start: func(t *parse.Tree, container *ast.Node) int {
+			ln := t.Context.CurrentLine()[t.Context.NextNonspace():]
+			if t.Context.Indented() || !bytes.Equal(bytes.TrimSpace(ln), []byte("%%")) {
+				return 0
+			}
+			t.Context.CloseUnmatchedBlocks()
+			t.Context.AddChild(nodeComment)
+			t.Context.AdvanceOffset(len(t.Context.CurrentLine())-1-t.Context.Offset(), false) // 保留行尾换行符
+			return 2
+		},
+		Continue: func(n *ast.Node, context *parse.Context) int {
+			ln := context.CurrentLine()[context.NextNonspace():]
+			if bytes.Equal(bytes.TrimSpace(ln), []byte("%%")) {
+				context.Finalize(n)
+				return 2
+			}
+			return 0
+		},
+		Finalize: func(n *ast.Node, context *parse.Context) {
+			n.Tokens = bytes.TrimSpace(n.Tokens)
+		},
+	})
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	ret.Md2HTMLRendererFuncs[nodeAdmonition] = func(n *ast.Node, entering bool) (string, ast.WalkStatus) {
+		if entering {
+			return "<div class=\"admonition " + string(n.Tokens) + "\">\n", ast.WalkContinue
+		}
+		return "</div>\n", ast.WalkContinue
+	}
+	ret.Md2HTMLRendererFuncs[nodeComment] = func(n *ast.Node, entering bool) (string, ast.WalkStatus) {
+		return "", ast.WalkSkipChildren
+	}
+	return ret
+}
@@ -0,0 +1,111 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+)
+
+var calloutTests = []parseTest{
+
+	{"6", "> [!NOTE] **Bold** `c` title\n> foo\n", "<div class=\"callout callout-note\" data-callout=\"NOTE\" data-callout-title=\"**Bold** `c` title\">\n<p class=\"callout-title\"><strong>Bold</strong> <code>c</code> title</p>\n<p>foo</p>\n</div>\n"},
+	{"5", "> [!NOTE]\nlazy\n", "<div class=\"callout callout-note\" data-callout=\"NOTE\">\n<p class=\"callout-title\">Note</p>\n</div>\n<p>lazy</p>\n"},
+	{"4", "> [!NOTE]x\n", "<blockquote>\n<p>[!NOTE]x</p>\n</blockquote>\n"},
+	{"3", "> [!warning]+\n> a\n>\n> b\n", "<details class=\"callout callout-warning\" data-callout=\"warning\" data-callout-fold=\"+\" open=\"\">\n<summary class=\"callout-title\">Warning</summary>\n<p>a</p>\n<p>b</p>\n</details>\n"},
+	{"2", "> [!tip]- My 1 < 2 & 3\n> body\n", "<details class=\"callout callout-tip\" data-callout=\"tip\" data-callout-title=\"My 1 &lt; 2 &amp; 3\" data-callout-fold=\"-\">\n<summary class=\"callout-title\">My 1 &lt; 2 &amp; 3</summary>\n<p>body</p>\n</details>\n"},
+	{"1", "- > [!TIP] Hint\n  > foo\n", "<ul>\n<li>\n<div class=\"callout callout-tip\" data-callout=\"TIP\" data-callout-title=\"Hint\">\n<p class=\"callout-title\">Hint</p>\n<p>foo</p>\n</div>\n</li>\n</ul>\n"},
+	{"0", "> [!NOTE]\n> foo\n", "<div class=\"callout callout-note\" data-callout=\"NOTE\">\n<p class=\"callout-title\">Note</p>\n<p>foo</p>\n</div>\n"},
+}
+
+func TestCallout(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCallout(true)
+
+	for _, test := range calloutTests {
+		html := luteEngine.MarkdownStr(test.name, test.from)
+		if test.to != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, html, test.from)
+		}
+	}
+}
+
+func TestCalloutDisabled(t *testing.T) {
+	luteEngine := lute.New()
+
+	html := luteEngine.MarkdownStr("", "> [!NOTE]\n> foo\n")
+	if expected := "<blockquote>\n<p>[!NOTE]<br />\nfoo</p>\n</blockquote>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+var calloutFormatTests = []parseTest{
+
+	{"4", "> [!x](u)\n", "> [!x](u)\n"},
+	{"3", ">\n> [!NOTE]\n", "> \\[!NOTE]\n"},
+
+	{"2", "> [!warning]+\n> a\n>\n> b\n", "> [!warning]+\n> a\n>\n> b\n"},
+	{"1", ">[!tip]-   My title  \n>body\n", "> [!tip]- My title\n> body\n"},
+	{"0", "> [!NOTE]\n> foo\n", "> [!NOTE]\n> foo\n"},
+}
+
+func TestCalloutFormat(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCallout(true)
+
+	for _, test := range calloutFormatTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+		if html, expected := luteEngine.MarkdownStr(test.name, formatted), luteEngine.MarkdownStr(test.name, test.from); expected != html {
+			t.Fatalf("test case [%s] round trip failed\nexpected\n\t%q\ngot\n\t%q", test.name, expected, html)
+		}
+	}
+}
+
+var calloutVditorTests = []parseTest{
+
+	{"1", "> [!tip]- My *title*\n> body\n", "> [!tip]- My *title*\n> body\n"},
+	{"0", "> [!NOTE]\n> foo\n", "> [!NOTE]\n> foo\n"},
+}
+
+func TestCalloutVditorDOM(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCallout(true)
+
+	for _, test := range calloutVditorTests {
+		md := luteEngine.VditorDOM2Md(luteEngine.Md2VditorDOM(test.from))
+		if test.to != md {
+			t.Fatalf("test case [%s] wysiwyg failed\nexpected\n\t%q\ngot\n\t%q", test.name, test.to, md)
+		}
+		md = luteEngine.VditorIRDOM2Md(luteEngine.Md2VditorIRDOM(test.from))
+		if test.to != md {
+			t.Fatalf("test case [%s] ir failed\nexpected\n\t%q\ngot\n\t%q", test.name, test.to, md)
+		}
+	}
+}
+
+func TestCalloutBlockDOM(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCallout(true)
+
+	dom := luteEngine.Md2BlockDOM("> [!tip]- Title\n> body\n", false)
+	if !strings.Contains(dom, "data-type=\"NodeCallout\" class=\"callout\" data-callout=\"tip\" data-callout-title=\"Title\" data-callout-fold=\"-\"") {
+		t.Fatalf("unexpected block DOM %q", dom)
+	}
+	md := luteEngine.BlockDOM2Md(dom)
+	if expected := "> [!tip]- Title\n> body\n"; !strings.HasPrefix(md, expected) {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, md)
+	}
+}
@@ -0,0 +1,247 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/parse"
+	"github.com/Dofingert/lute-for-ficus/render"
+	"github.com/Dofingert/lute-for-ficus/util"
+)
+
+var citationBibTeX = `@string{jml = "Journal of Machine Learning"}
+@article{smith2020,
+  author = {Smith, John and Doe, Jane},
+  title = {Deep {Learning} for M\"{u}sic},
+  journal = jml,
+  year = 2020,
+  volume = {12}, number = {3}, pages = {33--45},
+  doi = {10.1000/xyz}
+}
+@comment{ignored}
+@book{roe2019,
+  author = "Bob Roe and {World Health Organization} and Ann Lee",
+  title = "A Book",
+  publisher = {Press},
+  year = {2019}
+}
+`
+
+var citationCSLJSON = `[{"id": "wu2021", "type": "book", "title": "Go", "author": [{"family": "Wu", "given": "Li"}], "issued": {"date-parts": [[2021, 3]]}, "publisher": "P"}]`
+
+func newCitationBibliography(t *testing.T) *render.Bibliography {
+	bibTeX, err := render.ParseBibTeX([]byte(citationBibTeX))
+	if nil != err {
+		t.Fatalf("parse BibTeX failed: %s", err)
+	}
+	cslJSON, err := render.ParseCSLJSON([]byte(citationCSLJSON))
+	if nil != err {
+		t.Fatalf("parse CSL-JSON failed: %s", err)
+	}
+	return render.NewBibliography(append(bibTeX, cslJSON...)...)
+}
+
+var citationTests = []parseTest{
+
+	{"5", "foo@smith2020 and @smith2020.\n", "<p><a href=\"mailto:foo@smith2020\">foo@smith2020</a> and <span class=\"citation\" data-cites=\"smith2020\"><a href=\"#ref-smith2020\">Smith and Doe (2020)</a></span>.</p>\n<div id=\"refs\" class=\"references\">\n<h2>References</h2>\n<div id=\"ref-smith2020\" class=\"csl-entry\">Smith, John, and Jane Doe. 2020. “Deep Learning for Müsic.” <em>Journal of Machine Learning</em> 12 (3): 33–45. https://doi.org/10.1000/xyz</div>\n</div>\n"},
+	{"4", "[@missing]\n", "<p><span class=\"citation citation-unresolved\" data-cites=\"missing\">(missing?)</span></p>\n"},
+	{"3", "[@roe2019](url) [foo@bar.com]\n", "<p><a href=\"url\"><span class=\"citation\" data-cites=\"roe2019\">Roe et al. (2019)</span></a> [foo@bar.com]</p>\n<div id=\"refs\" class=\"references\">\n<h2>References</h2>\n<div id=\"ref-roe2019\" class=\"csl-entry\">Roe, Bob, World Health Organization, and Ann Lee. 2019. <em>A Book</em>. Press.</div>\n</div>\n"},
+	{"2", "[-@wu2021, chap. 2]\n", "<p><span class=\"citation\" data-cites=\"wu2021\">(<a href=\"#ref-wu2021\">2021, chap. 2</a>)</span></p>\n<div id=\"refs\" class=\"references\">\n<h2>References</h2>\n<div id=\"ref-wu2021\" class=\"csl-entry\">Wu, Li. 2021. <em>Go</em>. P.</div>\n</div>\n"},
+	{"1", "[see @smith2020, p. 33; @roe2019]\n", "<p><span class=\"citation\" data-cites=\"smith2020 roe2019\">(see <a href=\"#ref-smith2020\">Smith and Doe 2020, p. 33</a>; <a href=\"#ref-roe2019\">Roe et al. 2019</a>)</span></p>\n<div id=\"refs\" class=\"references\">\n<h2>References</h2>\n<div id=\"ref-roe2019\" class=\"csl-entry\">Roe, Bob, World Health Organization, and Ann Lee. 2019. <em>A Book</em>. Press.</div>\n<div id=\"ref-smith2020\" class=\"csl-entry\">Smith, John, and Jane Doe. 2020. “Deep Learning for Müsic.” <em>Journal of Machine Learning</em> 12 (3): 33–45. https://doi.org/10.1000/xyz</div>\n</div>\n"},
+	{"0", "[@smith2020, p. 33]\n", "<p><span class=\"citation\" data-cites=\"smith2020\">(<a href=\"#ref-smith2020\">Smith and Doe 2020, p. 33</a>)</span></p>\n<div id=\"refs\" class=\"references\">\n<h2>References</h2>\n<div id=\"ref-smith2020\" class=\"csl-entry\">Smith, John, and Jane Doe. 2020. “Deep Learning for Müsic.” <em>Journal of Machine Learning</em> 12 (3): 33–45. https://doi.org/10.1000/xyz</div>\n</div>\n"},
+}
+
+func TestCitation(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCitation(true)
+	luteEngine.SetBibliography(newCitationBibliography(t))
+
+	for _, test := range citationTests {
+		html := luteEngine.MarkdownStr(test.name, test.from)
+		if test.to != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, html, test.from)
+		}
+	}
+}
+
+func TestCitationNoAuthor(t *testing.T) {
+	entries, err := render.ParseCSLJSON([]byte(`[null, {"id": "anon", "title": "Anon Work", "author": [null, {}], "publisher": "P"}, {"id": "bare"}]`))
+	if nil != err {
+		t.Fatalf("parse CSL-JSON failed: %s", err)
+	}
+	if 2 != len(entries) || 0 != len(entries[0].Authors) {
+		t.Fatalf("unexpected entries %v", entries)
+	}
+
+	luteEngine := lute.New()
+	luteEngine.SetCitation(true)
+	luteEngine.SetBibliography(render.NewBibliography(entries...))
+
+	html := luteEngine.MarkdownStr("", "@anon [@bare]\n")
+	if expected := "<p><span class=\"citation\" data-cites=\"anon\"><a href=\"#ref-anon\">Anon Work (n.d.)</a></span> <span class=\"citation\" data-cites=\"bare\">(<a href=\"#ref-bare\">bare n.d.</a>)</span></p>\n<div id=\"refs\" class=\"references\">\n<h2>References</h2>\n<div id=\"ref-anon\" class=\"csl-entry\"><em>Anon Work</em>. n.d. P.</div>\n<div id=\"ref-bare\" class=\"csl-entry\">bare. n.d.</div>\n</div>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+func TestCitationDisabled(t *testing.T) {
+	luteEngine := lute.New()
+
+	html := luteEngine.MarkdownStr("", "[@smith2020, p. 33] @smith2020\n")
+	if expected := "<p>[@smith2020, p. 33] @smith2020</p>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+func TestCitationNumeric(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCitation(true)
+	luteEngine.SetBibliography(newCitationBibliography(t))
+	luteEngine.SetCitationStyle(render.CitationStyleNumeric)
+	luteEngine.SetBibliographyTitle("参考文献")
+
+	html := luteEngine.MarkdownStr("", "@roe2019 and [@smith2020; @roe2019, p. 3]\n")
+	if expected := "<p><span class=\"citation\" data-cites=\"roe2019\"><a href=\"#ref-roe2019\">Roe et al. [1]</a></span> and <span class=\"citation\" data-cites=\"smith2020 roe2019\">[<a href=\"#ref-smith2020\">2</a>; <a href=\"#ref-roe2019\">1, p. 3</a>]</span></p>\n<div id=\"refs\" class=\"references\">\n<h2>参考文献</h2>\n<div id=\"ref-roe2019\" class=\"csl-entry\">[1] Roe, Bob, World Health Organization, and Ann Lee, <em>A Book</em>, Press, 2019.</div>\n<div id=\"ref-smith2020\" class=\"csl-entry\">[2] Smith, John, and Jane Doe, “Deep Learning for Müsic,” <em>Journal of Machine Learning</em> 12 (3): 33–45, 2020. https://doi.org/10.1000/xyz</div>\n</div>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+func TestCitationBibTeXCommands(t *testing.T) {
+	entries, err := render.ParseBibTeX([]byte(`@book{knuth1984, author = {Knuth, Donald E.}, title = "The \TeX book, with \emph{exercises} in \LaTeXe", publisher = {AW}, year = 1984}`))
+	if nil != err {
+		t.Fatalf("parse BibTeX failed: %s", err)
+	}
+
+	luteEngine := lute.New()
+	luteEngine.SetCitation(true)
+	luteEngine.SetBibliography(render.NewBibliography(entries...))
+
+	html := luteEngine.MarkdownStr("", "[@knuth1984]\n")
+	if expected := "<p><span class=\"citation\" data-cites=\"knuth1984\">(<a href=\"#ref-knuth1984\">Knuth 1984</a>)</span></p>\n<div id=\"refs\" class=\"references\">\n<h2>References</h2>\n<div id=\"ref-knuth1984\" class=\"csl-entry\">Knuth, Donald E. 1984. <em>The TeX book, with exercises in LaTeX2ε</em>. AW.</div>\n</div>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+
+	// 数字编号样式中作者后的分隔符是 ,，需要保留缩写名后的 .
+	luteEngine.SetCitationStyle(render.CitationStyleNumeric)
+	html = luteEngine.MarkdownStr("", "[@knuth1984]\n")
+	if expected := "<p><span class=\"citation\" data-cites=\"knuth1984\">[<a href=\"#ref-knuth1984\">1</a>]</span></p>\n<div id=\"refs\" class=\"references\">\n<h2>References</h2>\n<div id=\"ref-knuth1984\" class=\"csl-entry\">[1] Knuth, Donald E., <em>The TeX book, with exercises in LaTeX2ε</em>, AW, 1984.</div>\n</div>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+func TestCitationCrossRef(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCitation(true)
+	luteEngine.SetCrossRef(true)
+
+	tree := parse.Parse("", []byte("@fig:a @smith2020\n"), luteEngine.ParseOptions)
+	paragraph := tree.Root.FirstChild
+	if ref := paragraph.FirstChild; "NodeCrossRef" != ref.Type.String() {
+		t.Fatalf("unexpected node [%s]", ref.Type)
+	}
+	if citation := paragraph.LastChild; "NodeCitation" != citation.Type.String() || !citation.CitationInText || "smith2020" != citation.Citations[0].Key {
+		t.Fatalf("unexpected node [%s]", citation.Type)
+	}
+}
+
+func TestCitationFormat(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCitation(true)
+
+	md := "As @smith2020 shows [see -@smith2020, p. 33;\n@roe2019].\n"
+	if formatted := luteEngine.FormatStr("", md); md != formatted {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", md, formatted)
+	}
+}
+
+func TestCitationProtyleExportMd(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCitation(true)
+	luteEngine.SetBibliography(newCitationBibliography(t))
+
+	tree := parse.Parse("", []byte("As @smith2020 shows [see @wu2021, p. 3; @nope].\n"), luteEngine.ParseOptions)
+	md := util.BytesToStr(render.NewProtyleExportMdRenderer(tree, luteEngine.RenderOptions).Render())
+	if expected := "As Smith and Doe (2020) shows (see Wu 2021, p. 3; nope?).\n\n## References\n\nSmith, John, and Jane Doe. 2020. “Deep Learning for Müsic.” *Journal of Machine Learning* 12 (3): 33–45. https://doi.org/10.1000/xyz\n\nWu, Li. 2021. *Go*. P.\n"; expected != md {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, md)
+	}
+}
+
+func TestCitationBlockDOM(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCitation(true)
+
+	dom := luteEngine.Md2BlockDOM("a [@smith2020, p. 3] b @doe\n", false)
+	if !strings.Contains(dom, "<span data-type=\"citation\" data-cites=\"smith2020\">[@smith2020, p. 3]</span>") {
+		t.Fatalf("unexpected block DOM %q", dom)
+	}
+	if md := luteEngine.BlockDOM2Md(dom); !strings.HasPrefix(md, "a [@smith2020, p. 3] b @doe\n") {
+		t.Fatalf("unexpected markdown %q", md)
+	}
+}
+
+func TestCitationVditorDOM(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCitation(true)
+
+	md := "a [@smith2020, p. 3] b @doe\n"
+	if ret := luteEngine.VditorDOM2Md(luteEngine.Md2VditorDOM(md)); md != ret {
+		t.Fatalf("wysiwyg expected\n\t%q\ngot\n\t%q", md, ret)
+	}
+	if ret := luteEngine.VditorIRDOM2Md(luteEngine.Md2VditorIRDOM(md)); md != ret {
+		t.Fatalf("ir expected\n\t%q\ngot\n\t%q", md, ret)
+	}
+
+	// 脚注定义中的文献引用
+	md = "[^1]: fn\n[@smith2020, p. 3]\n"
+	for _, dom := range []string{luteEngine.Md2VditorDOM(md), luteEngine.Md2VditorIRDOM(md), luteEngine.Md2VditorSVDOM(md)} {
+		if !strings.Contains(dom, "[@smith2020, p. 3]") || strings.Contains(dom, "not found render function") {
+			t.Fatalf("unexpected vditor DOM %q", dom)
+		}
+	}
+}
+
+func TestLoadBibliography(t *testing.T) {
+	dir := t.TempDir()
+	bib, json := filepath.Join(dir, "refs.bib"), filepath.Join(dir, "refs.json")
+	if err := os.WriteFile(bib, []byte(citationBibTeX), 0644); nil != err {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(json, []byte(citationCSLJSON), 0644); nil != err {
+		t.Fatal(err)
+	}
+
+	luteEngine := lute.New()
+	if err := luteEngine.LoadBibliography(bib, json); nil != err {
+		t.Fatalf("load bibliography failed: %s", err)
+	}
+	bibliography := luteEngine.RenderOptions.Bibliography
+	if 3 != len(bibliography.Entries) {
+		t.Fatalf("unexpected entries count [%d]", len(bibliography.Entries))
+	}
+	entry := bibliography.Get("smith2020")
+	if "Deep Learning for Müsic" != entry.Title || "33–45" != entry.Page || "3" != entry.Issue || "article-journal" != entry.Type || "Doe" != entry.Authors[1].Family {
+		t.Fatalf("unexpected entry %+v", entry)
+	}
+	if entry = bibliography.Get("roe2019"); "World Health Organization" != entry.Authors[1].Literal || "Roe" != entry.Authors[0].Family {
+		t.Fatalf("unexpected entry %+v", entry)
+	}
+	if entry = bibliography.Get("wu2021"); "2021" != entry.Year {
+		t.Fatalf("unexpected entry %+v", entry)
+	}
+
+	if err := luteEngine.LoadBibliography(filepath.Join(dir, "refs.txt")); nil == err {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,168 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/parse"
+	"github.com/Dofingert/lute-for-ficus/render"
+	"github.com/Dofingert/lute-for-ficus/util"
+)
+
+var crossRefTests = []parseTest{
+
+	{"12", "http://x.y/@fig:a\n\n![A](a.png) {#fig:a}\n", "<p>http://x.y/@fig:a</p>\n<figure id=\"fig:a\">\n<img src=\"a.png\" alt=\"A\" />\n<figcaption>Figure 1: A</figcaption>\n</figure>\n"},
+	{"11", "[@fig:a](x) [http://x/@fig:a](http://x/@fig:a)\n\n![A](a.png) {#fig:a}\n", "<p><a href=\"x\">@fig:a</a> <a href=\"http://x/@fig:a\">http://x/@fig:a</a></p>\n<figure id=\"fig:a\">\n<img src=\"a.png\" alt=\"A\" />\n<figcaption>Figure 1: A</figcaption>\n</figure>\n"},
+	{"10", "see ![i](i.png) {#fig:i} inline @fig:i\n\n![A](a.png) {#fig:a}\n", "<p>see <img src=\"i.png\" alt=\"i\" id=\"fig:i\" /> inline <span class=\"crossref crossref-unresolved\">@fig:i</span></p>\n<figure id=\"fig:a\">\n<img src=\"a.png\" alt=\"A\" />\n<figcaption>Figure 1: A</figcaption>\n</figure>\n"},
+
+	{"9", "@eq:a\n\n$$\nx\n$$ {#tbl:a}\n", "<p><span class=\"crossref crossref-unresolved\">@eq:a</span></p>\n<div class=\"language-math\">x\n$$ {#tbl:a}</div>\n"},
+	{"8", "foo@fig:a and @foo:bar and @fig:\n", "<p>foo@fig:a and @foo:bar and @fig:</p>\n"},
+	{"7", "@fig:a.\n\n![A](a.png) {#fig:a}\n\n![B](b.png) {#fig:a}\n", "<p><a href=\"#fig:a\" class=\"crossref\">Figure 1</a>.</p>\n<figure id=\"fig:a\">\n<img src=\"a.png\" alt=\"A\" />\n<figcaption>Figure 1: A</figcaption>\n</figure>\n<p><img src=\"b.png\" alt=\"B\" /></p>\n"},
+	{"6", "@fig:missing\n", "<p><span class=\"crossref crossref-unresolved\">@fig:missing</span></p>\n"},
+	{"5", "$$\nE=mc^2\n$$ {#eq:energy}\n\n@eq:energy\n", "<div class=\"language-math\" id=\"eq:energy\">E=mc^2 \\tag{1}</div>\n<p><a href=\"#eq:energy\" class=\"crossref\">Equation 1</a></p>\n"},
+	{"4", "| a |\n| - |\n| 1 |\n{#tbl:r}\n\n@tbl:r\n", "<table id=\"tbl:r\">\n<caption>Table 1</caption>\n<thead>\n<tr>\n<th>a</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>1</td>\n</tr>\n</tbody>\n</table>\n<p><a href=\"#tbl:r\" class=\"crossref\">Table 1</a></p>\n"},
+	{"3", "see ![i](i.png) {#fig:i} inline\n", "<p>see <img src=\"i.png\" alt=\"i\" id=\"fig:i\" /> inline</p>\n"},
+	{"2", "![*A* b](a.png) {#fig:a}\n", "<figure id=\"fig:a\">\n<img src=\"a.png\" alt=\"A b\" />\n<figcaption>Figure 1: A b</figcaption>\n</figure>\n"},
+	{"1", "![A](a.png){#fig:a}\n\n![B](b.png){#fig:b}\n\nSee @fig:b and @fig:a.\n", "<figure id=\"fig:a\">\n<img src=\"a.png\" alt=\"A\" />\n<figcaption>Figure 1: A</figcaption>\n</figure>\n<figure id=\"fig:b\">\n<img src=\"b.png\" alt=\"B\" />\n<figcaption>Figure 2: B</figcaption>\n</figure>\n<p>See <a href=\"#fig:b\" class=\"crossref\">Figure 2</a> and <a href=\"#fig:a\" class=\"crossref\">Figure 1</a>.</p>\n"},
+	{"0", "See @fig:arch.\n\n![Arch](arch.png) {#fig:arch}\n", "<p>See <a href=\"#fig:arch\" class=\"crossref\">Figure 1</a>.</p>\n<figure id=\"fig:arch\">\n<img src=\"arch.png\" alt=\"Arch\" />\n<figcaption>Figure 1: Arch</figcaption>\n</figure>\n"},
+}
+
+func TestCrossRef(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCrossRef(true)
+
+	for _, test := range crossRefTests {
+		html := luteEngine.MarkdownStr(test.name, test.from)
+		if test.to != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, html, test.from)
+		}
+	}
+}
+
+func TestCrossRefDisabled(t *testing.T) {
+	luteEngine := lute.New()
+
+	html := luteEngine.MarkdownStr("", "@fig:a\n\n![A](a.png) {#fig:a}\n")
+	if expected := "<p>@fig:a</p>\n<p><img src=\"a.png\" alt=\"A\" /> {#fig:a}</p>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+func TestCrossRefNames(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCrossRef(true)
+	luteEngine.SetExtendedTable(true)
+	luteEngine.SetCrossRefNames(map[string]string{"tbl": "表"})
+
+	html := luteEngine.MarkdownStr("", "| a |\n| - |\n| 1 |\n[结果]\n{#tbl:r}\n\n@tbl:r\n")
+	if expected := "<table id=\"tbl:r\">\n<caption>表 1: 结果</caption>\n<thead>\n<tr>\n<th>a</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>1</td>\n</tr>\n</tbody>\n</table>\n<p><a href=\"#tbl:r\" class=\"crossref\">表 1</a></p>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+func TestCrossRefKramdownIAL(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCrossRef(true)
+	luteEngine.SetKramdownIAL(true)
+
+	tree := parse.Parse("", []byte("@tbl:k\n\n| a |\n| - |\n| 1 |\n{: id=\"tbl:k\"}\n"), luteEngine.ParseOptions)
+	table := tree.Root.FirstChild.Next
+	if "tbl:k" != table.CrossRefLabel || 1 != table.CrossRefNum {
+		t.Fatalf("unexpected table label [%s] num [%d]", table.CrossRefLabel, table.CrossRefNum)
+	}
+	if ref := tree.Root.FirstChild.FirstChild; 1 != ref.CrossRefNum {
+		t.Fatalf("unexpected ref num [%d]", ref.CrossRefNum)
+	}
+}
+
+func TestUnresolvedCrossRefs(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCrossRef(true)
+
+	unresolved := luteEngine.UnresolvedCrossRefs("", "@fig:x @eq:a @fig:x @tbl:y\n\n$$\na\n$$ {#eq:a}\n")
+	if expected := []string{"fig:x", "tbl:y"}; !reflect.DeepEqual(expected, unresolved) {
+		t.Fatalf("expected %v, got %v", expected, unresolved)
+	}
+}
+
+var crossRefFormatTests = []parseTest{
+
+	{"3", "[@fig:a](x) <http://x/@fig:a>\n", "[@fig:a](x) [http://x/@fig:a](http://x/@fig:a)\n"},
+
+	{"2", "$$\nx\n$$   {#eq:a}\n", "$$\nx\n$$ {#eq:a}\n"},
+	{"1", "|a|\n|-|\n|1|\n{#tbl:r}\n", "| a |\n| - |\n| 1 |\n{#tbl:r}\n"},
+	{"0", "See @fig:a.\n\n![A](a.png)   {#fig:a}\n", "See @fig:a.\n\n![A](a.png) {#fig:a}\n"},
+}
+
+func TestCrossRefFormat(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCrossRef(true)
+
+	for _, test := range crossRefFormatTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+		if html, expected := luteEngine.MarkdownStr(test.name, formatted), luteEngine.MarkdownStr(test.name, test.from); expected != html {
+			t.Fatalf("test case [%s] round trip failed\nexpected\n\t%q\ngot\n\t%q", test.name, expected, html)
+		}
+	}
+}
+
+func TestCrossRefProtyleExport(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCrossRef(true)
+
+	tree := parse.Parse("", []byte("See @fig:a and @tbl:b.\n\n![A](a.png) {#fig:a}\n"), luteEngine.ParseOptions)
+	md := util.BytesToStr(render.NewProtyleExportMdRenderer(tree, luteEngine.RenderOptions).Render())
+	if expected := "See [Figure 1](#fig:a) and @tbl:b.\n\n![A](a.png) {#fig:a}\n"; expected != md {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, md)
+	}
+
+	tree = parse.Parse("", []byte("See @fig:a and @tbl:b.\n\n![A](a.png) {#fig:a}\n"), luteEngine.ParseOptions)
+	docx := util.BytesToStr(render.NewProtyleExportDocxRenderer(tree, luteEngine.RenderOptions).Render())
+	if expected := "<p>See <a href=\"#fig:a\">Figure 1</a> and @tbl:b.</p>\n<p><span class=\"img\" id=\"fig:a\"><img src=\"a.png\" alt=\"A\" /><span class=\"protyle-action__title\">Figure 1: A</span></span></p>\n"; expected != docx {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, docx)
+	}
+}
+
+func TestCrossRefBlockDOM(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCrossRef(true)
+
+	dom := luteEngine.Md2BlockDOM("See @fig:a.\n", false)
+	if !strings.Contains(dom, "<span data-type=\"crossref\" data-label=\"fig:a\">@fig:a</span>") {
+		t.Fatalf("unexpected block DOM %q", dom)
+	}
+	if md := luteEngine.BlockDOM2Md(dom); !strings.HasPrefix(md, "See @fig:a.\n") {
+		t.Fatalf("unexpected markdown %q", md)
+	}
+}
+
+func TestCrossRefVditorDOM(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCrossRef(true)
+
+	md := "See @fig:a.\n"
+	if ret := luteEngine.VditorDOM2Md(luteEngine.Md2VditorDOM(md)); md != ret {
+		t.Fatalf("wysiwyg expected\n\t%q\ngot\n\t%q", md, ret)
+	}
+	if ret := luteEngine.VditorIRDOM2Md(luteEngine.Md2VditorIRDOM(md)); md != ret {
+		t.Fatalf("ir expected\n\t%q\ngot\n\t%q", md, ret)
+	}
+	if dom := luteEngine.Md2VditorSVDOM(md); !strings.Contains(dom, "@fig:a") || strings.Contains(dom, "not found render function") {
+		t.Fatalf("unexpected vditor DOM %q", dom)
+	}
+}
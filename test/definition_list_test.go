@@ -0,0 +1,180 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/parse"
+)
+
+var definitionListTests = []parseTest{
+
+	{"11", "> T\n> : d\n", "<blockquote>\n<dl>\n<dt>T</dt>\n<dd>d</dd>\n</dl>\n</blockquote>\n"},
+	{"10", "- item\n: x\n", "<ul>\n<li>item<br />\n: x</li>\n</ul>\n"},
+	{"9", ": no term\n", "<p>: no term</p>\n"},
+	{"8", "foo\n:bar\n", "<p>foo<br />\n:bar</p>\n"},
+	{"7", "Term\n:   - a\n    - b\n", "<dl>\n<dt>Term</dt>\n<dd>\n<ul>\n<li>a</li>\n<li>b</li>\n</ul>\n</dd>\n</dl>\n"},
+	{"6", "*Term*\n: `code` and **b**\n", "<dl>\n<dt><em>Term</em></dt>\n<dd><code>code</code> and <strong>b</strong></dd>\n</dl>\n"},
+	{"5", "Term\n: d1\n\nNot\n", "<dl>\n<dt>Term</dt>\n<dd>d1</dd>\n</dl>\n<p>Not</p>\n"},
+	{"4", "Term\n: para1\n\n  para2\n", "<dl>\n<dt>Term</dt>\n<dd>\n<p>para1</p>\n<p>para2</p>\n</dd>\n</dl>\n"},
+	{"3", "Term\n: d1\n\nTerm2\n: d2\n", "<dl>\n<dt>Term</dt>\n<dd>d1</dd>\n<dt>Term2</dt>\n<dd>d2</dd>\n</dl>\n"},
+	{"2", "Term\n\n: def\n", "<dl>\n<dt>Term</dt>\n<dd>\n<p>def</p>\n</dd>\n</dl>\n"},
+	{"1", "Term 1\nTerm 2\n: def a\n: def b\n", "<dl>\n<dt>Term 1</dt>\n<dt>Term 2</dt>\n<dd>def a</dd>\n<dd>def b</dd>\n</dl>\n"},
+	{"0", "Term\n: def\n", "<dl>\n<dt>Term</dt>\n<dd>def</dd>\n</dl>\n"},
+}
+
+func TestDefinitionList(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetDefinitionList(true)
+
+	for _, test := range definitionListTests {
+		html := luteEngine.MarkdownStr(test.name, test.from)
+		if test.to != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, html, test.from)
+		}
+	}
+}
+
+func TestDefinitionListDisabled(t *testing.T) {
+	luteEngine := lute.New()
+
+	html := luteEngine.MarkdownStr("", "Term\n: def\n")
+	if expected := "<p>Term<br />\n: def</p>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+var definitionListFormatTests = []parseTest{
+
+	{"7", "- b Term\n:   def2\n\nT\n: a\n:   def2\n", "- b Term\n  \\:   def2\n\nT\n: a\n: def2\n"},
+	{"6", "- b Term\n: def\n\n: no term\n", "- b Term\n  \\: def\n\n\\: no term\n"},
+
+	{"5", "Term\n: ```go\n  code\n  ```\n", "Term\n: ```go\n  code\n  ```\n"},
+	{"4", "Term\n:   - a\n    - b\n", "Term\n: - a\n  - b\n"},
+	{"3", "Term\n:   d1\n    lazy\n", "Term\n: d1\n  lazy\n"},
+	{"2", "Term\n: para1\n\n  para2\n", "Term\n\n: para1\n\n  para2\n"},
+	{"1", "Term\n: d1\nTerm2\n\n: d2\n", "Term\n\n: d1\n  Term2\n\n: d2\n"},
+	{"0", "Term 1\nTerm 2\n: def a\n: def b\n\nTerm 3\n: def c\n", "Term 1\nTerm 2\n: def a\n: def b\n\nTerm 3\n: def c\n"},
+}
+
+func TestDefinitionListFormat(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetDefinitionList(true)
+
+	for _, test := range definitionListFormatTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+		if html, expected := luteEngine.MarkdownStr(test.name, formatted), luteEngine.MarkdownStr(test.name, test.from); expected != html {
+			t.Fatalf("test case [%s] round trip failed\nexpected\n\t%q\ngot\n\t%q", test.name, expected, html)
+		}
+		if again := luteEngine.FormatStr(test.name, formatted); formatted != again {
+			t.Fatalf("test case [%s] format is not idempotent\nexpected\n\t%q\ngot\n\t%q", test.name, formatted, again)
+		}
+	}
+}
+
+var definitionListHTML2MdTests = []parseTest{
+
+	{"4", "<dl><dd>orphan</dd></dl>", "orphan\n"},
+	{"3", "<p>x</p><dl><dd>a</dd><dt>T</dt><dd>b</dd></dl>", "x\n\na\n\nT\n: b\n"},
+	{"2", "<dt>stray</dt>", "stray\n"},
+	{"1", "<dl>\n  <dt>A</dt>\n  <dt>B</dt>\n  <dd>x</dd>\n  <dt>C</dt>\n  <dd><p>p1</p><p>p2</p></dd>\n</dl>\n<p>after</p>", "A\nB\n\n: x\n\nC\n\n: p1\n\n  p2\n\nafter\n"},
+	{"0", "<dl><dt>Term</dt><dd>Def <b>bold</b></dd></dl>", "Term\n: Def **bold**\n"},
+}
+
+func TestDefinitionListHTML2Md(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetDefinitionList(true)
+
+	for _, test := range definitionListHTML2MdTests {
+		md, err := luteEngine.HTML2Markdown(test.from)
+		if nil != err {
+			t.Fatalf("test case [%s] unexpected error: %s", test.name, err)
+		}
+		if test.to != md {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal html\n\t%q", test.name, test.to, md, test.from)
+		}
+	}
+}
+
+func TestDefinitionListSourcePos(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetDefinitionList(true)
+	luteEngine.SetSourcePos(true)
+
+	tree := parse.Parse("", []byte("para\n\nTerm *a*\n: def\n"), luteEngine.ParseOptions)
+	dl := tree.Root.LastChild
+	if ast.NodeDefinitionList != dl.Type || "3:1-4:5" != dl.SourcePos.String() {
+		t.Fatalf("unexpected definition list %s %+v", dl.Type, dl.SourcePos)
+	}
+	term := dl.FirstChild
+	if ast.NodeDefinitionTerm != term.Type || "3:1-3:8" != term.SourcePos.String() {
+		t.Fatalf("unexpected term %+v", term.SourcePos)
+	}
+	if em := term.LastChild; ast.NodeEmphasis != em.Type || "3:6-3:8" != em.SourcePos.String() {
+		t.Fatalf("unexpected emphasis %+v", em.SourcePos)
+	}
+	if desc := dl.LastChild; ast.NodeDefinitionDesc != desc.Type || "4:1-4:5" != desc.SourcePos.String() {
+		t.Fatalf("unexpected desc %+v", desc.SourcePos)
+	}
+}
+
+var definitionListVditorTests = []parseTest{
+
+	{"1", "Term\n: def\n", "Term\n: def\n"},
+	{"0", "a\n\nTerm *x*\n: def\n\n  more\n: two\n\nb\n", "a\n\nTerm *x*\n\n: def\n\n  more\n\n: two\n\nb\n"},
+}
+
+func TestDefinitionListVditorDOM(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetDefinitionList(true)
+
+	for _, test := range definitionListVditorTests {
+		md := luteEngine.VditorDOM2Md(luteEngine.Md2VditorDOM(test.from))
+		if test.to != md {
+			t.Fatalf("test case [%s] wysiwyg failed\nexpected\n\t%q\ngot\n\t%q", test.name, test.to, md)
+		}
+		md = luteEngine.VditorIRDOM2Md(luteEngine.Md2VditorIRDOM(test.from))
+		if test.to != md {
+			t.Fatalf("test case [%s] ir failed\nexpected\n\t%q\ngot\n\t%q", test.name, test.to, md)
+		}
+		if dom := luteEngine.Md2VditorSVDOM(test.from); strings.Contains(dom, "not found render function") {
+			t.Fatalf("test case [%s] sv failed\ngot\n\t%q", test.name, dom)
+		}
+	}
+
+	// 脚注定义中的定义列表每行都需要缩进
+	dom := luteEngine.Md2VditorSVDOM("[^1]: fn\n\n    Term\n    : def\n")
+	if !strings.Contains(dom, "<span data-type=\"padding\">    </span><span data-type=\"text\">: def</span>") {
+		t.Fatalf("unexpected sv dom %q", dom)
+	}
+}
+
+func TestDefinitionListBlockDOM(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetDefinitionList(true)
+
+	// 编辑器中定义列表作为段落显示原文
+	dom := luteEngine.Md2BlockDOM("Term *x*\n: def\n", false)
+	if !strings.Contains(dom, "data-type=\"NodeParagraph\"") || !strings.Contains(dom, "Term *x*\n: def</div>") {
+		t.Fatalf("unexpected dom %s", dom)
+	}
+	md := luteEngine.BlockDOM2Md(dom)
+	if !strings.HasPrefix(md, "Term *x*\n: def\n") {
+		t.Fatalf("unexpected markdown %q", md)
+	}
+}
@@ -0,0 +1,114 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/diff"
+	"github.com/Dofingert/lute-for-ficus/parse"
+)
+
+type diffTest struct {
+	name string
+	a, b string
+	ops  string // 差异操作的类型和新版本（删除时为旧版本）块的文本，使用 | 分隔
+}
+
+var diffTests = []diffTest{
+
+	{"5", "| a | b |\n| - | - |\n| 1 | 2 |\n\nfoo\n", "| a | b |\n| - | - |\n| 1 | 3 |\n\nfoo\n", "update:ab13|equal:foo"},
+
+	{"4", "Alpha beta gamma.\n{: id=\"20230101000000-aaaaaaa\"}\n\nDelta.\n{: id=\"20230101000000-bbbbbbb\"}\n", "Something else entirely.\n{: id=\"20230101000000-aaaaaaa\"}\n\nDelta.\n{: id=\"20230101000000-bbbbbbb\"}\n", "update:Something else entirely.|equal:Delta."},
+	{"3", "```go\nfmt.Println(1)\n```\n", "```go\nfmt.Println(2)\n```\n", "update:fmt.Println(2)"},
+	{"2", "# A\n\nfoo\n\nbar\n\nbaz\n", "# A\n\nbaz\n\nfoo\n\nbar\n", "equal:A|move:baz|equal:foo|equal:bar"},
+	{"1", "# A\n\nkeep\n\ngone\n", "# A\n\nkeep\n\n- new\n", "equal:A|equal:keep|insert:new|delete:gone"},
+	{"0", "# A\n\nfoo *bar*\n", "## A\n\nfoo **bar**\n", "update:A|update:foo bar"},
+}
+
+func TestDiffTrees(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.ParseOptions.KramdownBlockIAL = true
+
+	for _, test := range diffTests {
+		a := parse.Parse("", []byte(test.a), luteEngine.ParseOptions)
+		b := parse.Parse("", []byte(test.b), luteEngine.ParseOptions)
+		var got []string
+		for _, op := range diff.Trees(a, b) {
+			n := op.B
+			if nil == n {
+				n = op.A
+			}
+			got = append(got, op.Kind.String()+":"+strings.TrimSpace(n.Content()))
+		}
+		if test.ops != strings.Join(got, "|") {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q", test.name, test.ops, strings.Join(got, "|"))
+		}
+	}
+}
+
+func TestDiffText(t *testing.T) {
+	var got []string
+	for _, edit := range diff.Text("我们 run fast 了", "我们 ran fast 呀") {
+		got = append(got, []string{"=", "+", "-"}[edit.Kind]+edit.Text)
+	}
+	expected := "=我们 |-run|+ran|= fast |-了|+呀"
+	if expected != strings.Join(got, "|") {
+		t.Fatalf("text diff failed\nexpected\n\t%q\ngot\n\t%q", expected, strings.Join(got, "|"))
+	}
+}
+
+func TestDiffRenderHTML(t *testing.T) {
+	luteEngine := lute.New()
+	a := parse.Parse("", []byte("# Title\n\nFirst paragraph here.\n\nMoved para.\n\n- item one\n- item two\n\nDeleted block.\n\nLast one.\n"), luteEngine.ParseOptions)
+	b := parse.Parse("", []byte("# Title\n\nMoved para.\n\nFirst paragraph there.\n\n- item one\n- item 2\n- item three\n\nLast one.\n\nNew tail.\n"), luteEngine.ParseOptions)
+
+	expected := `<h1>Title</h1>
+<ins class="diff-move">
+<p>Moved para.</p>
+</ins>
+<div class="diff-update"><p>First paragraph <del>here</del><ins>there</ins>.</p></div>
+<del class="diff-move">
+<p>Moved para.</p>
+</del>
+<ul>
+<li>item one</li>
+<li><div class="diff-update"><p>item <del>two</del><ins>2</ins></p></div>
+</li>
+<li><ins class="diff-block">
+item three</ins>
+</li>
+</ul>
+<del class="diff-block">
+<p>Deleted block.</p>
+</del>
+<p>Last one.</p>
+<ins class="diff-block">
+<p>New tail.</p>
+</ins>
+`
+	if html := string(diff.RenderHTML(a, b, luteEngine.RenderOptions)); expected != html {
+		t.Fatalf("render diff html failed\nexpected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+func TestDiffRenderHTMLTable(t *testing.T) {
+	luteEngine := lute.New()
+	a := parse.Parse("", []byte("| a | b |\n| - | - |\n| 1 | 2 |\n"), luteEngine.ParseOptions)
+	b := parse.Parse("", []byte("| a | b |\n| - | - |\n| 1 | 3 |\n"), luteEngine.ParseOptions)
+
+	expected := "<div class=\"diff-update\">\n<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>1</td>\n<td>3</td>\n</tr>\n</tbody>\n</table>\n</div>\n"
+	if html := string(diff.RenderHTML(a, b, luteEngine.RenderOptions)); expected != html {
+		t.Fatalf("render diff html failed\nexpected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
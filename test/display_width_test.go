@@ -0,0 +1,60 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/util"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	cases := []struct {
+		text     string
+		expected int
+	}{
+		{"abc", 3},
+		{"中文", 4},
+		{"ＡＢ", 4},
+		{"ｶﾀｶﾅ", 4},
+		{"한국어", 6},
+		{"é", 1},
+		{"​", 0},
+		{"👨‍👩‍👧", 2},
+		{"👍🏽", 2},
+		{"🇨🇳", 2},
+		{"❤️", 2},
+	}
+	for _, c := range cases {
+		if width := util.DisplayWidth(c.text); c.expected != width {
+			t.Fatalf("text [%q]: expected [%d], got [%d]", c.text, c.expected, width)
+		}
+	}
+}
+
+var displayWidthFormatTests = []parseTest{
+
+	{"2", "中文 👍🏽\n===\n", "中文 👍🏽\n=======\n"},
+	{"1", "|a|b|\n|-|-|\n|👨‍👩‍👧|é|\n|中文|ｶﾀ|\n", "| a    | b  |\n| ---- | -- |\n| 👨‍👩‍👧   | é  |\n| 中文 | ｶﾀ |\n"},
+	{"0", "|名称|说明|\n|:-:|-:|\n|a|中文说明|\n", "| 名称 |     说明 |\n| :--: | -------: |\n|  a   | 中文说明 |\n"},
+}
+
+func TestDisplayWidthFormat(t *testing.T) {
+	luteEngine := lute.New()
+
+	for _, test := range displayWidthFormatTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+	}
+}
@@ -14,10 +14,16 @@ import (
 	"testing"
 
 	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/parse"
 )
 
 var emojiTests = []parseTest{
 
+	{"26", ":heart::skin-tone-2: :+1::skin-tone-7:\n", "<p>❤️:skin-tone-2: 👍:skin-tone-7:</p>\n"},
+	{"25", ":man_technologist::skin-tone-6: :people_holding_hands::skin-tone-4:\n", "<p>👨🏿‍💻 🧑🏽‍🤝‍🧑🏽</p>\n"},
+	{"24", ":thumbsup::skin-tone-3:\n", "<p>👍🏼</p>\n"},
+	{"23", ":melting_face: :shaking_face: :jp:\n", "<p>🫠 🫨 🇯🇵</p>\n"},
+
 	{"22", ":siyuan:", "<p><img alt=\"siyuan\" class=\"emoji\" src=\"https://unpkg.com/vditor/dist/images/emoji/siyuan.svg\" title=\"siyuan\" /></p>\n"},
 
 	// 链接文本节点内 Emoji 的解析 https://github.com/Dofingert/lute-for-ficus/issues/76
@@ -75,3 +81,75 @@ func TestCleanEmoji(t *testing.T) {
 		t.Fatalf("remove emoji failed")
 	}
 }
+
+var emojiUnicode2AliasTests = []parseTest{
+
+	{"5", "🧑🏻‍❤️‍💋‍🧑🏼 👩‍❤️‍💋‍👨\n", "🧑🏻‍❤️‍💋‍🧑🏼 :kiss_woman_man:\n"},
+	{"4", "`👍` 👍\n", "`👍` :+1:\n"},
+	{"3", "👍🏼 👨🏿‍💻\n", ":+1::skin-tone-3: :man_technologist::skin-tone-6:\n"},
+	{"2", "© ©️ 1️⃣\n", "© :copyright: :one:\n"},
+	{"1", "🫠 🇯🇵\n", ":melting_face: :jp:\n"},
+	{"0", "爱心❤️一个\n", "爱心:heart:一个\n"},
+}
+
+func TestEmojiUnicode2Alias(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetEmojiUnicode2Alias(true)
+
+	for _, test := range emojiUnicode2AliasTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+		if html, expected := luteEngine.MarkdownStr(test.name, formatted), luteEngine.MarkdownStr(test.name, test.from); expected != html {
+			t.Fatalf("test case [%s] round trip failed\nexpected\n\t%q\ngot\n\t%q", test.name, expected, html)
+		}
+	}
+}
+
+func TestSearchEmojis(t *testing.T) {
+	luteEngine := lute.New()
+
+	emojis := luteEngine.SearchEmojis("smile", 2)
+	if 2 != len(emojis) || "😄" != emojis[0].Unicode || "smile" != emojis[0].Aliases[0] {
+		t.Fatalf("unexpected search result %v", emojis)
+	}
+
+	emojis = luteEngine.SearchEmojis(":flag jap", 0)
+	if 1 != len(emojis) || "🇯🇵" != emojis[0].Unicode || "flags" != emojis[0].Category {
+		t.Fatalf("unexpected search result %v", emojis)
+	}
+
+	// 别名匹配优先于关键词匹配
+	emojis = luteEngine.SearchEmojis("thumbs", 0)
+	if 1 > len(emojis) || "👍" != emojis[0].Unicode || !emojis[0].SkinTones {
+		t.Fatalf("unexpected search result %v", emojis)
+	}
+
+	if emojis = luteEngine.SearchEmojis("  ", 0); 0 != len(emojis) {
+		t.Fatalf("unexpected search result %v", emojis)
+	}
+}
+
+func TestEmojiDataset(t *testing.T) {
+	luteEngine := lute.New()
+
+	if 9 != len(luteEngine.GetEmojiCategories()) {
+		t.Fatalf("unexpected categories %v", luteEngine.GetEmojiCategories())
+	}
+	flags := luteEngine.GetEmojisByCategory("flags")
+	if 1 > len(flags) || "🏁" != flags[0].Unicode {
+		t.Fatalf("unexpected flags %v", flags)
+	}
+
+	emoji := parse.GetEmoji("👍")
+	if nil == emoji || "thumbs up" != emoji.Name || "hand-fingers-closed" != emoji.Subcategory || "👍🏿" != emoji.WithSkinTone(6) || "👍" != emoji.WithSkinTone(1) {
+		t.Fatalf("unexpected emoji %+v", emoji)
+	}
+	if emoji = parse.GetEmoji("☺"); nil == emoji || "☺️" != emoji.Unicode || "☝🏽" != parse.GetEmoji("☝️").WithSkinTone(4) {
+		t.Fatalf("unexpected emoji %+v", emoji)
+	}
+	if "+1" != parse.EmojiUnicodeAlias["👍"] {
+		t.Fatalf("unexpected alias [%s]", parse.EmojiUnicodeAlias["👍"])
+	}
+}
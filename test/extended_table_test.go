@@ -0,0 +1,122 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/parse"
+)
+
+var extendedTableTests = []parseTest{
+
+	{"11", "| a | b | c |\n| - | - | - |\n| x || y |\n| ^^ || n |\n", "<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n<th>c</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td colspan=\"2\" rowspan=\"2\">x</td>\n<td>y</td>\n</tr>\n<tr>\n<td>n</td>\n</tr>\n</tbody>\n</table>\n"},
+	{"10", "| a | b | c |\n| - | - | - |\n| x || y |\n| ^^ | m | n |\n", "<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n<th>c</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td colspan=\"2\">x</td>\n<td>y</td>\n</tr>\n<tr>\n<td>^^</td>\n<td>m</td>\n<td>n</td>\n</tr>\n</tbody>\n</table>\n"},
+	{"9", "| h | i |\n| - | - |\n| x | y |\n| ^^ | z |\\\n| c | d |\n", "<table>\n<thead>\n<tr>\n<th>h</th>\n<th>i</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td rowspan=\"2\">x<br />\nc</td>\n<td>y</td>\n</tr>\n<tr>\n<td>z<br />\nd</td>\n</tr>\n</tbody>\n</table>\n"},
+	{"8", "| h | i |\n| - | - |\n| a || \\\n| c | d |\n", "<table>\n<thead>\n<tr>\n<th>h</th>\n<th>i</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td colspan=\"2\">a<br />\nc<br />\nd</td>\n</tr>\n</tbody>\n</table>\n"},
+	{"7", "| h | i |\n| - | - |\n| C:\\ | x\\\\ |\\\n| d | y |\n", "<table>\n<thead>\n<tr>\n<th>h</th>\n<th>i</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>C:\\<br />\nd</td>\n<td>x\\<br />\ny</td>\n</tr>\n</tbody>\n</table>\n"},
+
+	{"6", "| a | b |\n| - | - |\n| 1 | 2 |\n[not caption] x\n", "<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>1</td>\n<td>2</td>\n</tr>\n<tr>\n<td>[not caption] x</td>\n<td></td>\n</tr>\n</tbody>\n</table>\n"},
+	{"5", "| a | b |\n| - | - |\n| 1 | 2 \\|\\\n", "<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>1</td>\n<td>2 |\\</td>\n</tr>\n</tbody>\n</table>\n"},
+	{"4", "| a | b |\n| - | - |\n| ^^ | x |\n", "<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>^^</td>\n<td>x</td>\n</tr>\n</tbody>\n</table>\n"},
+	{"3", "| a | b |\n| - | - |\n| l1 | x |\\\n| l2 | |\n| 3 | 4 |\n", "<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>l1<br />\nl2</td>\n<td>x</td>\n</tr>\n<tr>\n<td>3</td>\n<td>4</td>\n</tr>\n</tbody>\n</table>\n"},
+	{"2", "| a | b |\n| - | - |\n| 1 | 2 |\n[Table <1>]\n", "<table>\n<caption>Table &lt;1&gt;</caption>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>1</td>\n<td>2</td>\n</tr>\n</tbody>\n</table>\n"},
+	{"1", "| a | b |\n| - | - |\n| x | y |\n| ^^ | z |\n| ^^ | w |\n", "<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td rowspan=\"3\">x</td>\n<td>y</td>\n</tr>\n<tr>\n<td>z</td>\n</tr>\n<tr>\n<td>w</td>\n</tr>\n</tbody>\n</table>\n"},
+	{"0", "| a | b | c |\n| - | - | - |\n| 1 || 2 |\n", "<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n<th>c</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td colspan=\"2\">1</td>\n<td>2</td>\n</tr>\n</tbody>\n</table>\n"},
+}
+
+func TestExtendedTable(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetExtendedTable(true)
+
+	for _, test := range extendedTableTests {
+		html := luteEngine.MarkdownStr(test.name, test.from)
+		if test.to != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, html, test.from)
+		}
+	}
+}
+
+func TestExtendedTableDisabled(t *testing.T) {
+	luteEngine := lute.New()
+
+	html := luteEngine.MarkdownStr("", "| a | b |\n| - | - |\n| 1 || \n[cap]\n")
+	if expected := "<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>1</td>\n<td></td>\n</tr>\n<tr>\n<td>[cap]</td>\n<td></td>\n</tr>\n</tbody>\n</table>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+var extendedTableFormatTests = []parseTest{
+
+	{"6", "| h | i |\n| - | - |\n| x | y |\n| ^^ | z |\\\n| c | d |\n", "| h  | i |\n| -- | - |\n| x  | y | \\\n| c  |   |\n| ^^ | z | \\\n|    | d |\n"},
+	{"5", "| h | i |\n| - | - |\n| a || \\\n| c | d |\n", "| h | i |\n| - | - |\n| a    || \\\n| c    || \\\n| d    ||\n"},
+	{"4", "| h |\n| - |\n| C:\\ |\\\n| d |\n", "| h    |\n| ---- |\n| C:\\\\ | \\\n| d    |\n"},
+
+	{"3", "| a | b |\n| - | - |\n| l1 | x |\\\n| l2 | |\n| 3 | 4 |\n", "| a  | b |\n| -- | - |\n| l1 | x | \\\n| l2 |   |\n| 3  | 4 |\n"},
+	{"2", "| a | b |\n| - | - |\n| 1 | 2 |\n[ Caption ]\n", "| a | b |\n| - | - |\n| 1 | 2 |\n[Caption]\n"},
+	{"1", "| a | b |\n| - | - |\n| x | y |\n| ^^ | z |\n", "| a  | b |\n| -- | - |\n| x  | y |\n| ^^ | z |\n"},
+	{"0", "| a | b | c |\n| - | - | - |\n| 1 || 2 |\n", "| a | b | c |\n| - | - | - |\n| 1    || 2 |\n"},
+}
+
+func TestExtendedTableFormat(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetExtendedTable(true)
+
+	for _, test := range extendedTableFormatTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+		if html, expected := luteEngine.MarkdownStr(test.name, formatted), luteEngine.MarkdownStr(test.name, test.from); expected != html {
+			t.Fatalf("test case [%s] round trip failed\nexpected\n\t%q\ngot\n\t%q", test.name, expected, html)
+		}
+	}
+}
+
+var extendedTableHTML2MdTests = []parseTest{
+
+	{"3", "<table><thead><tr><th>a</th><th>b</th><th>c</th></tr></thead><tbody><tr><td colspan=\"2\" rowspan=\"2\">x</td><td>y</td></tr><tr><td>n</td></tr></tbody></table>", "| a | b | c |\n| - | - | - |\n| x    || y |\n| ^^   || n |\n"},
+	{"2", "<table><thead><tr><th>a</th><th>b</th></tr></thead><tbody><tr><td>l1<br>l2</td><td>y</td></tr></tbody></table>", "| a  | b |\n| -- | - |\n| l1 | y | \\\n| l2 |   |\n"},
+	{"1", "<table><thead><tr><th>a</th><th>b</th></tr></thead><tbody><tr><td rowspan=\"2\">x</td><td>y</td></tr><tr><td>z</td></tr></tbody></table>", "| a  | b |\n| -- | - |\n| x  | y |\n| ^^ | z |\n"},
+	{"0", "<table><caption>Spec</caption><thead><tr><th>a</th><th>b</th></tr></thead><tbody><tr><td colspan=\"2\">1</td></tr></tbody></table>", "| a | b |\n| - | - |\n| 1    ||\n[Spec]\n"},
+}
+
+func TestExtendedTableHTML2Md(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetExtendedTable(true)
+
+	for _, test := range extendedTableHTML2MdTests {
+		md, err := luteEngine.HTML2Markdown(test.from)
+		if nil != err {
+			t.Fatalf("test case [%s] unexpected error: %s", test.name, err)
+		}
+		if test.to != md {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal html\n\t%q", test.name, test.to, md, test.from)
+		}
+	}
+}
+
+func TestExtendedTableSourcePos(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetExtendedTable(true)
+	luteEngine.SetSourcePos(true)
+
+	tree := parse.Parse("", []byte("| a | b |\n| - | - |\n| 1 | 2 |\\\n| 3 | 4 |\n[cap]\n"), luteEngine.ParseOptions)
+	table := tree.Root.FirstChild
+	if ast.NodeTable != table.Type || "1:1-5:5" != table.SourcePos.String() || "cap" != table.TableCaption {
+		t.Fatalf("unexpected table %s %+v", table.Type, table.SourcePos)
+	}
+	if row := table.LastChild; "3:1-4:9" != row.SourcePos.String() {
+		t.Fatalf("unexpected row %+v", row.SourcePos)
+	}
+}
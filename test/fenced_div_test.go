@@ -0,0 +1,141 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+)
+
+var fencedDivTests = []parseTest{
+
+	{"10", "- ::: a\n  x\n  :::\n- y\n", "<ul>\n<li>\n<div class=\"a\">\n<p>x</p>\n</div>\n</li>\n<li>y</li>\n</ul>\n"},
+	{"9", "::: note\n\n1. :::: {.w #i}\n::::\n::: note\n", "<div class=\"note\">\n<ol>\n<li>\n<div id=\"i\" class=\"w\">\n</div>\n</li>\n</ol>\n</div>\n<div class=\"note\">\n</div>\n"},
+	{"8", "::::: o\n::: i\nx\n:::::\ny\n", "<div class=\"o\">\n<div class=\"i\">\n<p>x</p>\n</div>\n</div>\n<p>y</p>\n"},
+
+	{"7", ":::\nnot\n:::\n", "<p>:::\nnot\n:::</p>\n"},
+	{"6", "::: a :::\nunclosed\n", "<div class=\"a\">\n<p>unclosed</p>\n</div>\n"},
+	{"5", "> ::: q\n> in\n> :::\n", "<blockquote>\n<div class=\"q\">\n<p>in</p>\n</div>\n</blockquote>\n"},
+	{"4", "::: a\n~~~\n:::\n~~~\n:::\n", "<div class=\"a\">\n<pre><code>:::\n</code></pre>\n</div>\n"},
+	{"3", "para\n::: a\nx\n:::\n", "<p>para\n::: a\nx\n:::</p>\n"},
+	{"2", "::: a\n::: b\nx\n:::\n:::\n", "<div class=\"a\">\n<div class=\"b\">\n<p>x</p>\n</div>\n</div>\n"},
+	{"1", ":::: {#outer .a key=\"v 1\"}\nouter\n\n::: inner\n- li\n:::\n\nafter\n::::\n\ntail\n", "<div id=\"outer\" class=\"a\" key=\"v 1\">\n<p>outer</p>\n<div class=\"inner\">\n<ul>\n<li>li</li>\n</ul>\n</div>\n<p>after</p>\n</div>\n<p>tail</p>\n"},
+	{"0", "::: warning\nThis is a warning.\n:::\n", "<div class=\"warning\">\n<p>This is a warning.</p>\n</div>\n"},
+}
+
+func TestFencedDiv(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetFencedDiv(true)
+	luteEngine.SetCodeSyntaxHighlight(false)
+	luteEngine.SetSoftBreak2HardBreak(false)
+
+	for _, test := range fencedDivTests {
+		html := luteEngine.MarkdownStr(test.name, test.from)
+		if test.to != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, html, test.from)
+		}
+	}
+}
+
+func TestFencedDivDisabled(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetSoftBreak2HardBreak(false)
+
+	html := luteEngine.MarkdownStr("", "::: warning\nfoo\n:::\n")
+	if expected := "<p>::: warning\nfoo\n:::</p>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+var fencedDivFormatTests = []parseTest{
+
+	{"6", "::: a\nT {k=v}\n---\n:::\n", "::: a\nT {k=v}\n-\n:::\n"},
+	{"5", "- ::: a\n  x\n  :::\n- y\n", "- ::: a\n  x\n  :::\n- y\n"},
+	{"4", "::: note\n\n1. :::: {.w #i}\n::::\n::: note\n", "::: note\n1. :::: {#i .w}\n   ::::\n:::\n\n::: note\n:::\n"},
+	{"3", "::::: o\n::: i\nx\n:::\ny\n:::::\n", "::::: o\n::: i\nx\n:::\n\ny\n:::::\n"},
+
+	{"2", ":::   {.a   #x}  ::::\n\nfoo\n\n:::\n", "::: {#x .a}\nfoo\n:::\n"},
+	{"1", "::: a\n::: b\nx\n:::\n:::\n", "::: a\n::: b\nx\n:::\n:::\n"},
+	{"0", ":::: {#outer .a key=\"v 1\"}\nouter\n\n::: inner\n- li\n:::\n::::\n\ntail\n", ":::: {#outer .a key=\"v 1\"}\nouter\n\n::: inner\n- li\n:::\n::::\n\ntail\n"},
+}
+
+func TestFencedDivFormat(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetFencedDiv(true)
+
+	for _, test := range fencedDivFormatTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+		if html, expected := luteEngine.MarkdownStr(test.name, formatted), luteEngine.MarkdownStr(test.name, test.from); expected != html {
+			t.Fatalf("test case [%s] round trip failed\nexpected\n\t%q\ngot\n\t%q", test.name, expected, html)
+		}
+		if again := luteEngine.FormatStr(test.name, formatted); formatted != again {
+			t.Fatalf("test case [%s] format is not idempotent\nexpected\n\t%q\ngot\n\t%q", test.name, formatted, again)
+		}
+	}
+}
+
+func TestFencedDivBlockDOM(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetFencedDiv(true)
+
+	dom := luteEngine.Md2BlockDOM("::: o\n::: {#x .w k=\"a b\"}\nfoo\n:::\n:::\n", false)
+	if !strings.Contains(dom, "data-type=\"NodeFencedDiv\" class=\"sb\" data-sb-layout=\"row\" data-fenced-div=\"{#x .w k=&quot;a b&quot;}\"") {
+		t.Fatalf("unexpected dom %s", dom)
+	}
+
+	md := luteEngine.BlockDOM2Md(dom)
+	// 从 DOM 生成的围栏 div 按照嵌套深度生成标记符
+	if !strings.HasPrefix(md, ":::: o\n::: {#x .w k=\"a b\"}\nfoo\n") || !strings.HasSuffix(md, ":::\n::::\n") {
+		t.Fatalf("unexpected markdown %q", md)
+	}
+
+	// 围栏 div 的 id 保存在 data-fenced-div 中，不作为节点 ID
+	luteEngine.SetProtyleWYSIWYG(true)
+	luteEngine.SetKramdownIAL(true)
+	dom = luteEngine.Md2BlockDOM("::: {#i}\nx\n:::\n", true)
+	if !strings.Contains(dom, "data-fenced-div=\"{#i}\"") || strings.Contains(dom, "data-node-id=\"i\"") {
+		t.Fatalf("unexpected dom %s", dom)
+	}
+	md = luteEngine.BlockDOM2Md(dom)
+	if !strings.HasPrefix(md, "::: {#i}\nx\n") || !strings.HasSuffix(md, ":::\n") {
+		t.Fatalf("unexpected markdown %q", md)
+	}
+}
+
+var fencedDivVditorTests = []parseTest{
+
+	{"1", "::: o\n::: {#x .w k=\"a b\"}\nfoo\n:::\n:::\n", ":::: o\n::: {#x .w k=\"a b\"}\nfoo\n:::\n::::\n"},
+	{"0", "::: {#i .w}\nx\n\n* a\n:::\n", "::: {#i .w}\nx\n\n* a\n:::\n"},
+}
+
+func TestFencedDivVditorDOM(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetFencedDiv(true)
+
+	for _, test := range fencedDivVditorTests {
+		md := luteEngine.VditorDOM2Md(luteEngine.Md2VditorDOM(test.from))
+		if test.to != md {
+			t.Fatalf("test case [%s] wysiwyg failed\nexpected\n\t%q\ngot\n\t%q", test.name, test.to, md)
+		}
+		md = luteEngine.VditorIRDOM2Md(luteEngine.Md2VditorIRDOM(test.from))
+		if test.to != md {
+			t.Fatalf("test case [%s] ir failed\nexpected\n\t%q\ngot\n\t%q", test.name, test.to, md)
+		}
+		if dom := luteEngine.Md2VditorSVDOM(test.from); strings.Contains(dom, "not found render function") {
+			t.Fatalf("test case [%s] sv failed\ngot\n\t%q", test.name, dom)
+		}
+	}
+}
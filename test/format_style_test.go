@@ -0,0 +1,78 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/render"
+)
+
+var formatStylePrettierTests = []parseTest{
+
+	{"7", "Title\n=====\n\nMulti\nline\n---\n", "# Title\n\nMulti\nline\n----\n"},
+	{"6", "    indented\n", "```\nindented\n```\n"},
+	{"5", "~~~js\ncode\n```\n~~~\n", "````js\ncode\n```\n````\n"},
+	{"4", "*a* __b__ a*b*c _*c*_\n", "_a_ **b** a*b*c _*c*_\n"},
+	{"3", "1. a\n1. b\n5. c\n", "1. a\n1. b\n1. c\n"},
+	{"2", "3. a\n7. b\n9. c\n", "3. a\n4. b\n5. c\n"},
+	{"1", "* a\n* b\n\n+ c\n", "- a\n- b\n\n* c\n"},
+	{"0", "a\n\n***\n", "a\n\n---\n"},
+}
+
+func TestFormatStylePrettier(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetFormatStyle(render.FormatPresets["prettier"])
+
+	for _, test := range formatStylePrettierTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+	}
+}
+
+var formatStyleTests = []parseTest{
+
+	{"7", "&copy\\; x &#169\\; y&#x1F\\; a\\;b\n", "&copy\\; x &#169\\; y&#x1F\\; a;b\n"},
+
+	{"6", "_a_ **b**\n", "*a* __b__\n"},
+	{"5", "a\\,b \\- c\n\\- d 1\\. e\n", "a,b - c\n\\- d 1. e\n"},
+	{"4", "| a | bbb |\n| :-: | - |\n| c | d |\n", "| a | bbb |\n| :-: | - |\n| c | d |\n"},
+	{"3", "a\n\n---\n", "a\n\n* * *\n"},
+	{"2", "```\na\n```\n", "~~~~\na\n~~~~\n"},
+	{"1", "# H1 {#id}\n## H2\n### H3\n", "H1 {#id}\n==\n\nH2\n--\n\n### H3\n"},
+	{"0", "- a\n- b\n\n3. x\n4. y\n", "* a\n* b\n\n3. x\n3. y\n"},
+}
+
+func TestFormatStyle(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetFormatStyle(&render.FormatOptions{
+		BulletChar:       '*',
+		EmphasisChar:     '*',
+		StrongChar:       '_',
+		OrderedList:      render.OrderedListOne,
+		FenceChar:        '~',
+		FenceLength:      4,
+		HeadingStyle:     render.HeadingStyleSetext,
+		ThematicBreak:    "* * *",
+		CompactTable:     true,
+		UnescapeOptional: true,
+	})
+
+	for _, test := range formatStyleTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+	}
+}
@@ -57,8 +57,8 @@ var formatTests = []formatTest{
 	{"34", "`bar`\n", "`bar`\n"},
 	{"33", "foo`bar`baz\n", "foo `bar` baz\n"},
 
-	{"32", "|foo|\n|-|\n|`\\|bar`|\n", "| foo    |\n| ------ |\n| `\\|bar` |\n"},
-	{"31", "|foo|\n|-|\n|\\|bar|\n", "| foo  |\n| ---- |\n| \\|bar |\n"},
+	{"32", "|foo|\n|-|\n|`\\|bar`|\n", "| foo     |\n| ------- |\n| `\\|bar` |\n"},
+	{"31", "|foo|\n|-|\n|\\|bar|\n", "| foo   |\n| ----- |\n| \\|bar |\n"},
 	{"30", "\\<foo>\n", "\\<foo>\n"},
 
 	{"29", "1. [X] foo\n", "1. [X] foo\n"},
@@ -0,0 +1,102 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/parse"
+	"github.com/Dofingert/lute-for-ficus/render"
+)
+
+var headingSlugGitHubTests = []parseTest{
+
+	{"3", "# 中文 Title_x 😄\n", "<h1 id=\"中文-title_x-\">中文 Title_x 😄</h1>\n"},
+	{"2", "# C++ & Go: `code`\n", "<h1 id=\"c--go-code\">C++ &amp; Go: <code>code</code></h1>\n"},
+	{"1", "# Foo {#bar}\n", "<h1 id=\"bar\">Foo</h1>\n"},
+	{"0", "# Hello World!\n## Hello World\n## Hello World-1\n", "<h1 id=\"hello-world\">Hello World!</h1>\n<h2 id=\"hello-world-1\">Hello World</h2>\n<h2 id=\"hello-world-1-1\">Hello World-1</h2>\n"},
+}
+
+func TestHeadingSlugGitHub(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetHeadingID(true)
+	luteEngine.SetSlugger(render.GitHubSlugger)
+
+	for _, test := range headingSlugGitHubTests {
+		html := luteEngine.MarkdownStr(test.name, test.from)
+		if test.to != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, html, test.from)
+		}
+	}
+}
+
+func TestHeadingSlugToC(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetToC(true)
+	luteEngine.SetSlugger(render.GitLabSlugger)
+
+	html := luteEngine.MarkdownStr("", "# A -- B\n## A - B\n[toc]\n")
+	if expected := "<h1 id=\"a-b\">A -- B</h1>\n<h2 id=\"a-b-1\">A - B</h2>\n<div class=\"vditor-toc\" data-block=\"0\" data-type=\"toc-block\" contenteditable=\"false\"><ul><li><span data-target-id=\"a-b\">A -- B</span><ul><li><span data-target-id=\"a-b-1\">A - B</span></li></ul></li></ul></div>"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+func TestHeadingSlugAnchor(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetHeadingAnchor(true)
+	luteEngine.SetSlugger(render.PinyinSlugger)
+
+	html := luteEngine.MarkdownStr("", "# 中文标题\n")
+	if expected := "<h1>中文标题<a id=\"vditorAnchor-zhong-wen-biao-ti\" class=\"vditor-anchor\" href=\"#zhong-wen-biao-ti\">"; !strings.HasPrefix(html, expected) {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+func TestSluggers(t *testing.T) {
+	cases := []struct {
+		slugger  string
+		text     string
+		expected string
+	}{
+		{"github", "Hello  World!", "hello--world"},
+		{"gitlab", "Hello  World!", "hello-world"},
+		{"gitlab", "Émile_Zola", "émile_zola"},
+		{"hugo", "Émile_Zola 2", "mile-zola-2"},
+		{"goldmark", "中文", "heading"},
+		{"pinyin", "重庆 Chongqing 2024", "zhong-qing-chongqing-2024"},
+		{"pinyin", "《标题》：副标题", "biao-ti-fu-biao-ti"},
+	}
+	for _, c := range cases {
+		if slug := render.Sluggers[c.slugger].Slug(c.text); c.expected != slug {
+			t.Fatalf("slugger [%s] text [%s]: expected [%s], got [%s]", c.slugger, c.text, c.expected, slug)
+		}
+	}
+}
+
+func TestHeadingSlugSluggers(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetHeadingID(true)
+
+	tree := parse.Parse("", []byte("# Foo!\n"), luteEngine.ParseOptions)
+	heading := tree.Root.FirstChild
+	// 不同 slugger 的锚点 id 不会互相影响
+	if id := render.HeadingID(heading); "Foo-" != id {
+		t.Fatalf("expected [Foo-], got [%s]", id)
+	}
+	if id := render.HeadingSlug(heading, render.GitHubSlugger); "foo" != id {
+		t.Fatalf("expected [foo], got [%s]", id)
+	}
+	if id := render.HeadingID(heading); "Foo-" != id {
+		t.Fatalf("expected [Foo-], got [%s]", id)
+	}
+}
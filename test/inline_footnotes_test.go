@@ -0,0 +1,122 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/parse"
+	"github.com/Dofingert/lute-for-ficus/render"
+)
+
+var inlineFootnotesTests = []parseTest{
+
+	{"5", "a^[one] b[^2] c^[two]\n\n[^2]: ref\n", "<p>a<sup class=\"footnotes-ref\" id=\"footnotes-ref-1\"><a href=\"#footnotes-def-1\">1</a></sup> b<sup class=\"footnotes-ref\" id=\"footnotes-ref-3\"><a href=\"#footnotes-def-3\">3</a></sup> c<sup class=\"footnotes-ref\" id=\"footnotes-ref-2\"><a href=\"#footnotes-def-2\">2</a></sup></p>\n<div class=\"footnotes-defs-div\"><hr class=\"footnotes-defs-hr\" />\n<ol class=\"footnotes-defs-ol\"><li id=\"footnotes-def-1\"><p>one <a href=\"#footnotes-ref-1\" class=\"vditor-footnotes__goto-ref\">↩</a></p>\n</li>\n<li id=\"footnotes-def-2\"><p>two <a href=\"#footnotes-ref-2\" class=\"vditor-footnotes__goto-ref\">↩</a></p>\n</li>\n<li id=\"footnotes-def-3\"><p>ref <a href=\"#footnotes-ref-3\" class=\"vditor-footnotes__goto-ref\">↩</a></p>\n</li>\n</ol></div>"},
+	{"4", "a^[see `]` x] b\n", "<p>a<sup class=\"footnotes-ref\" id=\"footnotes-ref-1\"><a href=\"#footnotes-def-1\">1</a></sup> b</p>\n<div class=\"footnotes-defs-div\"><hr class=\"footnotes-defs-hr\" />\n<ol class=\"footnotes-defs-ol\"><li id=\"footnotes-def-1\"><p>see <code>]</code> x <a href=\"#footnotes-ref-1\" class=\"vditor-footnotes__goto-ref\">↩</a></p>\n</li>\n</ol></div>"},
+	{"3", "a^[] b^[x\n", "<p>a^[] b^[x</p>\n"},
+	{"2", "- item ^[in list]\n\npara\n", "<ul>\n<li>item <sup class=\"footnotes-ref\" id=\"footnotes-ref-1\"><a href=\"#footnotes-def-1\">1</a></sup></li>\n</ul>\n<p>para</p>\n<div class=\"footnotes-defs-div\"><hr class=\"footnotes-defs-hr\" />\n<ol class=\"footnotes-defs-ol\"><li id=\"footnotes-def-1\"><p>in list <a href=\"#footnotes-ref-1\" class=\"vditor-footnotes__goto-ref\">↩</a></p>\n</li>\n</ol></div>"},
+	{"1", "a^[one] c[^x]\n\n[^x]: def\n", "<p>a<sup class=\"footnotes-ref\" id=\"footnotes-ref-1\"><a href=\"#footnotes-def-1\">1</a></sup> c<sup class=\"footnotes-ref\" id=\"footnotes-ref-2\"><a href=\"#footnotes-def-2\">2</a></sup></p>\n<div class=\"footnotes-defs-div\"><hr class=\"footnotes-defs-hr\" />\n<ol class=\"footnotes-defs-ol\"><li id=\"footnotes-def-1\"><p>one <a href=\"#footnotes-ref-1\" class=\"vditor-footnotes__goto-ref\">↩</a></p>\n</li>\n<li id=\"footnotes-def-2\"><p>def <a href=\"#footnotes-ref-2\" class=\"vditor-footnotes__goto-ref\">↩</a></p>\n</li>\n</ol></div>"},
+	{"0", "a^[note *em* [x]] b\n", "<p>a<sup class=\"footnotes-ref\" id=\"footnotes-ref-1\"><a href=\"#footnotes-def-1\">1</a></sup> b</p>\n<div class=\"footnotes-defs-div\"><hr class=\"footnotes-defs-hr\" />\n<ol class=\"footnotes-defs-ol\"><li id=\"footnotes-def-1\"><p>note <em>em</em> [x] <a href=\"#footnotes-ref-1\" class=\"vditor-footnotes__goto-ref\">↩</a></p>\n</li>\n</ol></div>"},
+}
+
+func TestInlineFootnotes(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetInlineFootnotes(true)
+
+	for _, test := range inlineFootnotesTests {
+		html := luteEngine.MarkdownStr(test.name, test.from)
+		if test.to != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, html, test.from)
+		}
+	}
+}
+
+func TestInlineFootnotesDisabled(t *testing.T) {
+	luteEngine := lute.New()
+
+	html := luteEngine.MarkdownStr("", "a^[note] c[^x]\n\n[^x]: def\n")
+	if expected := "<p>a^[note] c<sup class=\"footnotes-ref\" id=\"footnotes-ref-1\"><a href=\"#footnotes-def-1\">1</a></sup></p>\n<div class=\"footnotes-defs-div\"><hr class=\"footnotes-defs-hr\" />\n<ol class=\"footnotes-defs-ol\"><li id=\"footnotes-def-1\"><p>def <a href=\"#footnotes-ref-1\" class=\"vditor-footnotes__goto-ref\">↩</a></p>\n</li>\n</ol></div>"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+var inlineFootnotesFormatTests = []parseTest{
+
+	{"3", "a^[x]\n\n[^1]: taken\n\nb\n", "a^[x]\n\n[^1]: taken\n\nb\n"},
+	{"2", "a^[see `]` x] b ^[`` ` ``]\n", "a^[see `]` x] b ^[`` ` ``]\n"},
+	{"1", "a^[one] c[^x]\n\n[^x]: def\n", "a^[one] c[^x]\n\n[^x]: def\n"},
+	{"0", "a^[ *x*  y ]\n\nb\n\n> q^[z]\n", "a^[*x*  y]\n\nb\n\n> q^[z]\n"},
+}
+
+func TestInlineFootnotesFormat(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetInlineFootnotes(true)
+
+	for _, test := range inlineFootnotesFormatTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+	}
+}
+
+var footnotesTransformTests = []struct {
+	name      string
+	from      string
+	transform func(*parse.Tree)
+	to        string
+}{
+
+	{"4", "a^[see `]` x] b\n\n[^1]: taken\n\nc\n", (*parse.Tree).InlineFootnotesToRefs, "a[^2] b\n\n[^1]: taken\n\nc\n\n[^2]: see `]` x\n"},
+	{"3", "a^[x] b[^z] c^[y]\n\n[^z]: zed\n", func(tree *parse.Tree) { tree.InlineFootnotesToRefs(); tree.RenumberFootnotes() }, "a[^1] b[^2] c[^3]\n\n[^1]: x\n\n[^2]: zed\n\n[^3]: y\n"},
+	{"2", "a[^b] c[^a] d[^a]\n\n[^a]: A\n[^b]: B *x*\n", (*parse.Tree).RefFootnotesToInline, "a^[B *x*] c[^a] d[^a]\n\n[^a]: A\n"},
+	{"1", "a[^b] c[^a] d[^b]\n\n[^a]: A\n[^b]: B\n[^c]: C\n", (*parse.Tree).RenumberFootnotes, "a[^1] c[^2] d[^1]\n\n[^1]: B\n\n[^2]: A\n\n[^3]: C\n"},
+	{"0", "a^[one] b[^1]\n\nc^[two]\n\n[^1]: zed\n", (*parse.Tree).InlineFootnotesToRefs, "a[^2] b[^1]\n\nc[^3]\n\n[^1]: zed\n\n[^2]: one\n\n[^3]: two\n"},
+}
+
+func TestFootnotesTransform(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetInlineFootnotes(true)
+
+	for _, test := range footnotesTransformTests {
+		tree := parse.Parse(test.name, []byte(test.from), luteEngine.ParseOptions)
+		test.transform(tree)
+		formatted := string(render.NewFormatRenderer(tree, luteEngine.RenderOptions).Render())
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+
+		// 转换后的语法树和重新解析格式化结果得到的语法树渲染结果一致，渲染会修改语法树，所以重新解析并转换一次
+		tree = parse.Parse(test.name, []byte(test.from), luteEngine.ParseOptions)
+		test.transform(tree)
+		html := string(render.NewHtmlRenderer(tree, luteEngine.RenderOptions).Render())
+		if expected := luteEngine.MarkdownStr(test.name, formatted); expected != html {
+			t.Fatalf("test case [%s] html failed\nexpected\n\t%q\ngot\n\t%q", test.name, expected, html)
+		}
+	}
+}
+
+func TestInlineFootnotesSourcePos(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetInlineFootnotes(true)
+	luteEngine.SetSourcePos(true)
+
+	tree := parse.Parse("", []byte("para\n\na ^[*x* y]\n"), luteEngine.ParseOptions)
+	ref := tree.Root.FirstChild.Next.LastChild
+	if "3:3-3:10" != ref.SourcePos.String() {
+		t.Fatalf("unexpected ref %+v", ref.SourcePos)
+	}
+	_, def := tree.FindFootnotesDef(ref.Tokens)
+	if em := def.FirstChild.FirstChild; "3:5-3:7" != em.SourcePos.String() {
+		t.Fatalf("unexpected emphasis %+v", em.SourcePos)
+	}
+}
@@ -0,0 +1,191 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/lex"
+	"github.com/Dofingert/lute-for-ficus/parse"
+)
+
+const (
+	nodeMention ast.NodeType = ast.NodeTypeMaxVal + 100 + iota
+	nodeRuby
+	nodeIns
+	nodeInsOpenMarker
+	nodeInsCloseMarker
+)
+
+var inlineSyntaxTests = []parseTest{
+
+	{"9", "# foo {#bar}\n", "<h1>foo</h1>\n"},
+	{"8", "{漢字|かんじ}\n", "<p><ruby>漢字<rt>かんじ</rt></ruby></p>\n"},
+	{"7", "*a @b*\n", "<p><em>a <a class=\"mention emphasis\" href=\"/u/b\">@b</a></em></p>\n"},
+	{"6", "++a++b++\n", "<p><ins>a</ins>b++</p>\n"},
+	{"5", "+++a+++\n", "<p>+++a+++</p>\n"},
+	{"4", "++*foo*++\n", "<p><ins><em>foo</em></ins></p>\n"},
+	{"3", "a ++b++ c\n", "<p>a <ins>b</ins> c</p>\n"},
+	{"2", "`@foo`\n", "<p><code>@foo</code></p>\n"},
+	{"1", "foo @ bar\n", "<p>foo @ bar</p>\n"},
+	{"0", "hi @foo!\n", "<p>hi <a class=\"mention\" href=\"/u/foo\">@foo</a>!</p>\n"},
+}
+
+func TestInlineSyntax(t *testing.T) {
+	luteEngine := newInlineSyntaxLute(t)
+
+	for _, test := range inlineSyntaxTests {
+		html := luteEngine.MarkdownStr(test.name, test.from)
+		if test.to != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\nmarkdown text\n\t%q", test.name, test.to, html, test.from)
+		}
+	}
+}
+
+func TestInlineSyntaxSourcePos(t *testing.T) {
+	luteEngine := newInlineSyntaxLute(t)
+	luteEngine.SetSourcePos(true)
+
+	tree := parse.Parse("", []byte("a @foo ++b++\n"), luteEngine.ParseOptions)
+	mention := tree.Root.FirstChild.FirstChild.Next
+	if nodeMention != mention.Type || nil == mention.SourcePos || 3 != mention.SourcePos.StartColumn || 6 != mention.SourcePos.EndColumn {
+		t.Fatalf("unexpected mention %+v", mention.SourcePos)
+	}
+	ins := tree.Root.FirstChild.LastChild
+	if nodeIns != ins.Type || nil == ins.SourcePos || 8 != ins.SourcePos.StartColumn || 12 != ins.SourcePos.EndColumn {
+		t.Fatalf("unexpected ins %+v", ins.SourcePos)
+	}
+}
+
+func TestInlineSyntaxKeepsBuiltinAt(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetCrossRef(true)
+	luteEngine.SetCitation(true)
+	err := luteEngine.RegisterInlineSyntax(&parse.InlineSyntax{
+		Trigger: '%',
+		Parse: func(t *parse.Tree, block *ast.Node, ctx *parse.InlineContext) *ast.Node {
+			return nil
+		},
+	})
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	html := luteEngine.MarkdownStr("", "See @fig:a, 100% done.\n\n![A](a.png) {#fig:a}\n")
+	if expected := "<p>See <a href=\"#fig:a\" class=\"crossref\">Figure 1</a>, 100% done.</p>\n<figure id=\"fig:a\">\n<img src=\"a.png\" alt=\"A\" />\n<figcaption>Figure 1: A</figcaption>\n</figure>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+func TestRegisterDelimiterSyntaxErrors(t *testing.T) {
+	luteEngine := lute.New()
+	if err := luteEngine.RegisterDelimiterSyntax(&parse.DelimiterSyntax{Marker: '=', Num: 2, Type: nodeIns, OpenMarkerType: nodeInsOpenMarker, CloseMarkerType: nodeInsCloseMarker}); nil == err {
+		t.Fatal("registering a built-in delimiter should fail")
+	}
+	if err := luteEngine.RegisterDelimiterSyntax(&parse.DelimiterSyntax{Marker: '+', Num: 2, Type: ast.NodeMark, OpenMarkerType: nodeInsOpenMarker, CloseMarkerType: nodeInsCloseMarker}); nil == err {
+		t.Fatal("registering a built-in node type should fail")
+	}
+	if err := luteEngine.RegisterInlineSyntax(&parse.InlineSyntax{Trigger: '@'}); nil == err {
+		t.Fatal("registering an inline syntax without parse function should fail")
+	}
+}
+
+// newInlineSyntaxLute 创建一个注册了三种扩展行级语法的引擎：
+//
+//   - @提及，渲染为链接
+//   - {漢字|かんじ} 注音，不匹配时回退到内置的标题 ID 解析
+//   - ++插入++
+func newInlineSyntaxLute(t *testing.T) *lute.Lute {
+	ret := lute.New()
+	err := ret.RegisterInlineSyntax(&parse.InlineSyntax{
+		Trigger: '@',
+		Parse: func(t *parse.Tree, block *ast.Node, ctx *parse.InlineContext) *ast.Node {
+			tokens, pos := ctx.Tokens(), ctx.Pos()
+			if 0 < pos && lex.IsASCIILetterNum(tokens[pos-1]) {
+				return nil
+			}
+			end := pos + 1
+			for ; end < len(tokens) && lex.IsASCIILetterNum(tokens[end]); end++ {
+			}
+			if pos+1 == end {
+				return nil
+			}
+			ctx.SetPos(end)
+			ret := &ast.Node{Type: nodeMention, Tokens: tokens[pos:end]}
+			if ctx.InDelimiter('*') {
+				ret.SetIALAttr("class", "emphasis")
+			}
+			return ret
+		},
+	})
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	err = ret.RegisterInlineSyntax(&parse.InlineSyntax{
+		Trigger: '{',
+		Parse: func(t *parse.Tree, block *ast.Node, ctx *parse.InlineContext) *ast.Node {
+			tokens := ctx.Tokens()[ctx.Pos():]
+			end := bytes.IndexByte(tokens, '}')
+			if 0 > end {
+				return nil
+			}
+			parts := bytes.Split(tokens[1:end], []byte("|"))
+			if 2 != len(parts) || 1 > len(parts[0]) || 1 > len(parts[1]) {
+				return nil
+			}
+			ctx.SetPos(ctx.Pos() + end + 1)
+			ret := &ast.Node{Type: nodeRuby, Tokens: parts[0]}
+			ret.SetIALAttr("rt", string(parts[1]))
+			return ret
+		},
+	})
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	err = ret.RegisterDelimiterSyntax(&parse.DelimiterSyntax{Marker: '+', Num: 2, Type: nodeIns, OpenMarkerType: nodeInsOpenMarker, CloseMarkerType: nodeInsCloseMarker})
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	ret.Md2HTMLRendererFuncs[nodeMention] = func(n *ast.Node, entering bool) (string, ast.WalkStatus) {
+		if !entering {
+			return "", ast.WalkContinue
+		}
+		class := "mention"
+		if emphasis := n.IALAttr("class"); "" != emphasis {
+			class += " " + emphasis
+		}
+		return "<a class=\"" + class + "\" href=\"/u/" + string(n.Tokens[1:]) + "\">" + string(n.Tokens) + "</a>", ast.WalkSkipChildren
+	}
+	ret.Md2HTMLRendererFuncs[nodeRuby] = func(n *ast.Node, entering bool) (string, ast.WalkStatus) {
+		if !entering {
+			return "", ast.WalkContinue
+		}
+		return "<ruby>" + string(n.Tokens) + "<rt>" + n.IALAttr("rt") + "</rt></ruby>", ast.WalkSkipChildren
+	}
+	ret.Md2HTMLRendererFuncs[nodeIns] = func(n *ast.Node, entering bool) (string, ast.WalkStatus) {
+		if entering {
+			return "<ins>", ast.WalkContinue
+		}
+		return "</ins>", ast.WalkContinue
+	}
+	skip := func(n *ast.Node, entering bool) (string, ast.WalkStatus) {
+		return "", ast.WalkContinue
+	}
+	ret.Md2HTMLRendererFuncs[nodeInsOpenMarker] = skip
+	ret.Md2HTMLRendererFuncs[nodeInsCloseMarker] = skip
+	return ret
+}
@@ -0,0 +1,77 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/lint"
+	"github.com/Dofingert/lute-for-ficus/parse"
+	"github.com/Dofingert/lute-for-ficus/render"
+)
+
+func TestCheckLinks(t *testing.T) {
+	fsys := fstest.MapFS{
+		"docs/a.md":      {Data: []byte("# Intro\n\n## Setup\n\n[b](b.md#Usage) [c](c.md) [s](#Setup) [x](#nope) ![img](img/a.png) ![logo](../logo.png)\n\n[ok](https://b3log.org) [bad](https://ld246) <mailto:a@b.com> [r] [sp](sp%20ace.md)\n\n[r]: /docs/b.md#missing\n[unused]: b.md\n\nfoo[^1]\n\n[^1]: x\n[^2]: y\n")},
+		"docs/b.md":      {Data: []byte("# Usage\n\n[back](a.md#Intro) [up](../README.md)\n")},
+		"docs/img/a.png": {Data: []byte("png")},
+		"docs/sp ace.md": {Data: []byte("space")},
+		"README.md":      {Data: []byte("readme")},
+	}
+	expected := `docs/a.md:5:17 error missing-file: File docs/c.md not found
+docs/a.md:5:39 error missing-anchor: Anchor #nope not found in docs/a.md
+docs/a.md:5:68 error missing-file: File logo.png not found
+docs/a.md:7:25 warning invalid-url: Invalid URL https://ld246
+docs/a.md:9:1 error missing-anchor: Anchor #missing not found in docs/b.md
+docs/a.md:10:1 warning unused-link-ref-def: Link reference definition [unused] is unused
+docs/a.md:15:1 warning unused-footnote: Footnote [^2] is unused`
+
+	luteEngine := lute.New()
+	diagnostics, err := luteEngine.CheckLinks(fsys, "docs/a.md", "docs/b.md")
+	if nil != err {
+		t.Fatalf("check links failed: %s", err)
+	}
+	var lines []string
+	for _, diagnostic := range diagnostics {
+		lines = append(lines, diagnostic.String())
+	}
+	if got := strings.Join(lines, "\n"); expected != got {
+		t.Fatalf("check links failed\nexpected\n\t%s\ngot\n\t%s", expected, got)
+	}
+
+	if _, err = luteEngine.CheckLinks(fsys, "docs/none.md"); nil == err {
+		t.Fatalf("check links should fail on missing document")
+	}
+}
+
+func TestCheckLinksSlugger(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.ParseOptions.KramdownBlockIAL = true
+	luteEngine.ParseOptions.BlockRef = true
+	tree := parse.Parse("a.md", []byte("# Hello World\n{: id=\"20200813131152-0wk5akh\"}\n\n[a](#hello-world) [b](#Hello-World) ((20200813131152-0wk5akh \"a\")) ((20200813131152-xxxxxxx \"b\"))\n"), luteEngine.ParseOptions)
+
+	checker := &lint.LinkChecker{Slugger: render.GitHubSlugger}
+	var lines []string
+	for _, diagnostic := range checker.Check(tree) {
+		lines = append(lines, diagnostic.Message)
+	}
+	expected := "Anchor #Hello-World not found in a.md\nBlock 20200813131152-xxxxxxx not found"
+	if got := strings.Join(lines, "\n"); expected != got {
+		t.Fatalf("check links failed\nexpected\n\t%q\ngot\n\t%q", expected, got)
+	}
+
+	if links := lint.CollectLinks(tree); 4 != len(links) || lint.LinkKindBlockRef != links[3].Kind {
+		t.Fatalf("unexpected links %v", links)
+	}
+}
@@ -0,0 +1,119 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/lint"
+	"github.com/Dofingert/lute-for-ficus/render"
+)
+
+type lintTest struct {
+	name        string
+	rule        string
+	from        string
+	diagnostics string
+	fixed       string
+}
+
+var lintTests = []lintTest{
+
+	{"10", "no-bare-urls", "a\n  b https://x.y\n===\n\n> c\n>   d https://z.w\n", "2:5 warning no-bare-urls: Bare URL https://x.y\n6:7 warning no-bare-urls: Bare URL https://z.w", ""},
+	{"9", "no-bare-urls", "http://x.y/(a) end (see http://x.y/b_(c))).\n", "1:1 warning no-bare-urls: Bare URL http://x.y/(a)\n1:25 warning no-bare-urls: Bare URL http://x.y/b_(c)", "[http://x.y/(a)](http://x.y/(a)) end (see [http://x.y/b_(c)](http://x.y/b_(c)))).\n"},
+
+	{"8", "no-unclosed-git-conflict", "<<<<<<< HEAD\na\n=======\nb\n>>>>>>> dev\n\nc\n\n<<<<<<< HEAD\nd\n", "9:1 error no-unclosed-git-conflict: Unclosed git conflict marker", ""},
+	{"7", "no-trailing-spaces", "a  \nb   \n\n```\nc  \n```\n", "2:2 info no-trailing-spaces: Expected no trailing spaces, got 3", "a\nb\n\n```\nc  \n```\n"},
+	{"6", "ul-style", "* a\n* b\n\n1. c\n\n- d\n", "6:1 warning ul-style: Expected list marker *, got -", "* a\n* b\n\n1. c\n\n* d\n"},
+	{"5", "ul-style", "* a\n\n- b\n", "3:1 warning ul-style: Expected list marker *, got -", "* a\n\n- b\n"},
+	{"4", "no-bare-urls", "see https://b3log.org, [https://ld246.com](https://ld246.com) `https://a`\n", "1:5 warning no-bare-urls: Bare URL https://b3log.org", "see [https://b3log.org](https://b3log.org), [https://ld246.com](https://ld246.com) `https://a`\n"},
+	{"3", "no-alt-text", "![](a.png) ![foo](b.png)\n", "1:1 warning no-alt-text: Image has no alternate text", ""},
+	{"2", "no-empty-links", "[a]() [b](#) [c](/c)\n", "1:1 warning no-empty-links: Link has no destination\n1:7 warning no-empty-links: Link has no destination", ""},
+	{"1", "no-duplicate-heading-id", "# Foo\n\n## Foo\n\n## Bar {#Foo}\n", "3:1 warning no-duplicate-heading-id: Duplicate heading id \"Foo\"\n5:1 warning no-duplicate-heading-id: Duplicate heading id \"Foo\"", "# Foo\n\n## Foo\n\n## Bar {#Foo-1}\n"},
+	{"0", "heading-increment", "# a\n\n### b\n\n#### c\n", "3:1 warning heading-increment: Expected h2, got h3", "# a\n\n## b\n\n### c\n"},
+}
+
+func TestLint(t *testing.T) {
+	luteEngine := lute.New()
+
+	for _, test := range lintTests {
+		var lines []string
+		for _, diagnostic := range luteEngine.Lint(test.name, test.from, test.rule) {
+			lines = append(lines, diagnostic.String())
+		}
+		if got := strings.Join(lines, "\n"); test.diagnostics != got {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.diagnostics, got, test.from)
+		}
+		if "" == test.fixed {
+			continue
+		}
+		if fixed, _ := luteEngine.LintFix(test.name, test.from, test.rule); test.fixed != fixed {
+			t.Fatalf("test case [%s] fix failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.fixed, fixed, test.from)
+		}
+	}
+}
+
+func TestLintSlugger(t *testing.T) {
+	luteEngine := lute.New()
+
+	// 和渲染一样，没有设置 Slugger 时标题 id 区分大小写
+	md := "# Foo\n\n## Bar {#foo}\n"
+	if diagnostics := luteEngine.Lint("", md, "no-duplicate-heading-id"); 0 != len(diagnostics) {
+		t.Fatalf("unexpected diagnostics %v", diagnostics)
+	}
+
+	luteEngine.SetSlugger(render.GitHubSlugger)
+	diagnostics := luteEngine.Lint("", md, "no-duplicate-heading-id")
+	if 1 != len(diagnostics) || "3:1 warning no-duplicate-heading-id: Duplicate heading id \"foo\"" != diagnostics[0].String() {
+		t.Fatalf("unexpected diagnostics %v", diagnostics)
+	}
+}
+
+func TestLintFixGitConflict(t *testing.T) {
+	luteEngine := lute.New()
+
+	md := "# A\n\n### B\n\n<<<<<<< HEAD\nx\n=======\ny\n"
+	// 没有启用冲突标记规则时使用原有解析选项，和直接格式化的结果一致
+	expected := luteEngine.FormatStr("", "# A\n\n## B\n\n<<<<<<< HEAD\nx\n=======\ny\n")
+	if fixed, _ := luteEngine.LintFix("", md, "heading-increment"); expected != fixed {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, fixed)
+	}
+
+	// 未闭合的冲突标记保持原样，不能重复最后一行
+	expected = "# A\n\n## B\n\n<<<<<<< HEAD\nx\n=======\ny\n"
+	fixed, diagnostics := luteEngine.LintFix("", md)
+	if expected != fixed {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, fixed)
+	}
+	if 1 != len(diagnostics) || "5:1 error no-unclosed-git-conflict: Unclosed git conflict marker" != diagnostics[0].String() {
+		t.Fatalf("unexpected diagnostics %v", diagnostics)
+	}
+}
+
+func TestLintRegister(t *testing.T) {
+	lint.Register(&lint.Rule{ID: "no-emphasis", Severity: lint.SeverityInfo, Check: func(ctx *lint.Context) {
+		ast.Walk(ctx.Tree.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+			if entering && ast.NodeEmphasis == n.Type {
+				ctx.Report(n, "Emphasis", nil)
+			}
+			return ast.WalkContinue
+		})
+	}})
+
+	luteEngine := lute.New()
+	diagnostics := luteEngine.Lint("", "a\n\nb *c*\n", "no-emphasis")
+	if 1 != len(diagnostics) || "3:3 info no-emphasis: Emphasis" != diagnostics[0].String() {
+		t.Fatalf("unexpected diagnostics %v", diagnostics)
+	}
+}
@@ -0,0 +1,199 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/parse"
+	"github.com/Dofingert/lute-for-ficus/render"
+	"github.com/Dofingert/lute-for-ficus/util"
+)
+
+var pandocAttributesTests = []parseTest{
+
+	{"8", "[a]{b} and {#x} and [c] {.d}\n", "<p>[a]{b} and {#x} and [c] {.d}</p>\n"},
+	{"7", "[*em* text]{.c}\n", "<p><span class=\"c\"><em>em</em> text</span></p>\n"},
+	{"6", "```{.haskell .numberLines}\nmain\n```\n", "<pre class=\"numberLines\"><code class=\"language-haskell highlight-chroma\"><span class=\"highlight-line\"><span class=\"highlight-cl\"><span class=\"highlight-nf\">main</span>\n</span></span></code></pre>\n"},
+	{"5", "```text {#c1 startFrom=10}\nfoo\n```\n", "<pre id=\"c1\" startFrom=\"10\"><code class=\"language-text highlight-chroma\"><span class=\"highlight-line\"><span class=\"highlight-cl\">foo\n</span></span></code></pre>\n"},
+	{"4", "![i](i.png){#img width=50%} `x`{.c}\n", "<p><img src=\"i.png\" alt=\"i\" width=\"50%\" id=\"img\" /> <code class=\"c\">x</code></p>\n"},
+	{"3", "[link](/u){#l .ext target=_blank title='a \"b\"'}\n", "<p><a href=\"/u\" id=\"l\" class=\"ext\" target=\"_blank\" title=\"a &quot;b&quot;\">link</a></p>\n"},
+	{"2", "[text]{.smallcaps lang=fr}\n", "<p><span class=\"smallcaps\" lang=\"fr\">text</span></p>\n"},
+	{"1", "## Foo {-}\n", "<h2 class=\"unnumbered\">Foo</h2>\n"},
+	{"0", "# Intro {#sec:intro .a .b data-x=\"1 2\"}\n", "<h1 id=\"sec:intro\" class=\"a b\" data-x=\"1 2\">Intro</h1>\n"},
+}
+
+func TestPandocAttributes(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetPandocAttributes(true)
+
+	for _, test := range pandocAttributesTests {
+		html := luteEngine.MarkdownStr(test.name, test.from)
+		if test.to != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, html, test.from)
+		}
+	}
+}
+
+func TestPandocAttributesDisabled(t *testing.T) {
+	luteEngine := lute.New()
+
+	html := luteEngine.MarkdownStr("", "[link](/u){#a .b} [text]{.c}\n")
+	if expected := "<p><a href=\"/u\">link</a>{#a .b} [text]{.c}</p>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+var pandocAttributesFormatTests = []parseTest{
+
+	{"4", "```{.python}\nx\n```\n", "```python\nx\n```\n"},
+	{"3", "```{.python #c}\nx\n```\n", "```python {#c}\nx\n```\n"},
+	{"2", "Foo   {#f}\n===\n", "Foo {#f}\n===\n"},
+	{"1", "[a](/u){  .x   k='v w' }\n", "[a](/u){.x k=\"v w\"}\n"},
+	{"0", "# Intro {.b #a}\n\n[text]{.c}\n", "# Intro {#a .b}\n\n[text]{.c}\n"},
+}
+
+var pandocAttributesKramdownFormatTests = []parseTest{
+
+	{"3", "![i](i.png){width=1} `c`{.x}\n", "![i](i.png){: width=\"1\"} `c`{: class=\"x\"}\n"},
+	{"2", "```{.python #c}\nx\n```\n", "```python\nx\n```\n{: id=\"c\"}\n"},
+	{"1", "[a](/u){.x k='v w'}\n", "[a](/u){.x k=\"v w\"}\n"},
+	{"0", "# Intro {.b #a}\n\n[text]{.c}\n", "# Intro {#a .b}\n\n[text]{.c}\n"},
+}
+
+// pandocAttributesDefaultFormatTests 在没有打开 kramdown IAL 选项时使用默认的 kramdown 格式化风格，kramdown IAL 无法被解析回来，所以仍然输出 Pandoc 通用属性。
+var pandocAttributesDefaultFormatTests = []parseTest{
+
+	{"5", "```{.python}\nx\n```\n", "```python\nx\n```\n"},
+	{"4", "Foo {.c}\n===\n\npara\n", "Foo {.c}\n===\n\npara\n"},
+	{"3", "# H {.c}\n\npara\n", "# H {.c}\n\npara\n"},
+	{"2", "# H {#i .c}\n", "# H {#i .c}\n"},
+	{"1", "[l](u){k=v} ![i](i.png){width=1} `c`{.x}\n\n```{.python #c}\nx\n```\n", "[l](u){k=v} ![i](i.png){width=1} `c`{.x}\n\n```python {#c}\nx\n```\n"},
+	{"0", "[s]{.sc}\n", "[s]{.sc}\n"},
+}
+
+func TestPandocAttributesFormat(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetPandocAttributes(true)
+	luteEngine.SetFormatPandocAttributes(true)
+
+	for _, test := range pandocAttributesFormatTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+		if html, expected := luteEngine.MarkdownStr(test.name, formatted), luteEngine.MarkdownStr(test.name, test.from); expected != html {
+			t.Fatalf("test case [%s] round trip failed\nexpected\n\t%q\ngot\n\t%q", test.name, expected, html)
+		}
+		if again := luteEngine.FormatStr(test.name, formatted); formatted != again {
+			t.Fatalf("test case [%s] format is not idempotent\nexpected\n\t%q\ngot\n\t%q", test.name, formatted, again)
+		}
+	}
+
+	luteEngine.SetFormatPandocAttributes(false)
+	for _, test := range pandocAttributesDefaultFormatTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+		if html, expected := luteEngine.MarkdownStr(test.name, formatted), luteEngine.MarkdownStr(test.name, test.from); expected != html {
+			t.Fatalf("test case [%s] round trip failed\nexpected\n\t%q\ngot\n\t%q", test.name, expected, html)
+		}
+	}
+
+	luteEngine.SetKramdownIAL(true)
+	for _, test := range pandocAttributesKramdownFormatTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		// 去掉末尾随机生成的文档 IAL
+		formatted = formatted[:strings.LastIndex(formatted, "{: id=")]
+		formatted = strings.TrimRight(formatted, "\n") + "\n"
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+		if html, expected := luteEngine.MarkdownStr(test.name, formatted), luteEngine.MarkdownStr(test.name, test.from); expected != html {
+			t.Fatalf("test case [%s] round trip failed\nexpected\n\t%q\ngot\n\t%q", test.name, expected, html)
+		}
+	}
+}
+
+func TestPandocAttributesKramdownIAL(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetPandocAttributes(true)
+
+	tree := parse.Parse("", []byte("# Foo {#a .b k=v}\n"), luteEngine.ParseOptions)
+	heading := tree.Root.FirstChild
+	if "a" != heading.IALAttr("id") || "b" != heading.IALAttr("class") || "v" != heading.IALAttr("k") {
+		t.Fatalf("unexpected heading IAL %v", heading.KramdownIAL)
+	}
+}
+
+func TestPandocAttributesProtyleExportMd(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetPandocAttributes(true)
+
+	tree := parse.Parse("", []byte("# Foo {#a}\n\n[text]{.c} ![i](i.png){width=1}\n\n```go {.x}\nx\n```\n"), luteEngine.ParseOptions)
+	md := util.BytesToStr(render.NewProtyleExportMdRenderer(tree, luteEngine.RenderOptions).Render())
+	if expected := "# Foo {#a}\n\n[text]{.c} ![i](i.png){width=1}\n\n```go {.x}\nx\n```\n"; expected != md {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, md)
+	}
+}
+
+func TestPandocAttributesBlockDOM(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetPandocAttributes(true)
+	luteEngine.SetProtyleWYSIWYG(true)
+	luteEngine.SetKramdownIAL(true)
+
+	// Pandoc 通用属性设置的 id 不是节点 ID
+	for _, markdown := range []string{"# H {#h}\n", "```{#h .py}\nx\n```\n"} {
+		dom := luteEngine.Md2BlockDOM(markdown, true)
+		if !strings.Contains(dom, "custom-pandoc-id=\"h\"") || strings.Contains(dom, "data-node-id=\"h\"") {
+			t.Fatalf("unexpected block DOM %q", dom)
+		}
+	}
+
+	dom := luteEngine.Md2BlockDOM("a [sp]{.c} b\n", false)
+	if md := luteEngine.BlockDOM2Md(dom); !strings.HasPrefix(md, "a [sp]{.c} b\n") {
+		t.Fatalf("unexpected markdown %q", md)
+	}
+}
+
+var pandocAttributesVditorTests = []parseTest{
+
+	{"1", "[a](u){#x} b\n", "[a](u){#x} b\n"},
+	{"0", "a [sp *em*]{.c} b\n", "a [sp *em*]{.c} b\n"},
+}
+
+func TestPandocAttributesVditorDOM(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetPandocAttributes(true)
+
+	for _, test := range pandocAttributesVditorTests {
+		md := luteEngine.VditorDOM2Md(luteEngine.Md2VditorDOM(test.from))
+		if test.to != md {
+			t.Fatalf("test case [%s] wysiwyg failed\nexpected\n\t%q\ngot\n\t%q", test.name, test.to, md)
+		}
+		md = luteEngine.VditorIRDOM2Md(luteEngine.Md2VditorIRDOM(test.from))
+		if test.to != md {
+			t.Fatalf("test case [%s] ir failed\nexpected\n\t%q\ngot\n\t%q", test.name, test.to, md)
+		}
+	}
+
+	// 脚注定义中的 [text]{attrs}
+	markdown := "[^1]: fn\n[sp]{.c}\n"
+	for _, dom := range []string{luteEngine.Md2VditorDOM(markdown), luteEngine.Md2VditorIRDOM(markdown), luteEngine.Md2VditorSVDOM(markdown)} {
+		if !strings.Contains(dom, "{.c}") || strings.Contains(dom, "not found render function") {
+			t.Fatalf("unexpected vditor DOM %q", dom)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/render"
+)
+
+var proseWrapAlwaysTests = []parseTest{
+
+	{"11", "aaaa bbbb cccc dddd ;;; eeee\n", "aaaa bbbb cccc dddd ;;;\neeee\n"},
+	{"10", "aaaa bbbb cccc dddd [^x]: eeee\n", "aaaa bbbb cccc dddd [^x]:\neeee\n"},
+	{"9", "aaaa bbbb cccc dddd $$ eeee ffff\n", "aaaa bbbb cccc dddd $$\neeee ffff\n"},
+	{"8", "aaaa bbbb cccc dddd : eeee ffff gggg hhh ::: iiii\n", "aaaa bbbb cccc dddd :\neeee ffff gggg hhh\n::: iiii\n"},
+	{"7", "aaaa  \nbbbb cccc dddd eeee ffff\n", "aaaa\\\nbbbb cccc dddd eeee\nffff\n"},
+
+	{"6", "| a b c d e f g h i j k l m n |\n| - |\n", "| a b c d e f g h i j k l m n |\n| --------------------------- |\n"},
+	{"5", "aaaa bbbb cccc dddd eeee\\\nffff\n", "aaaa bbbb cccc dddd\neeee\\\nffff\n"},
+	{"4", "> - aaaa bbbb cccc dddd eeee\n", "> - aaaa bbbb cccc\n>   dddd eeee\n"},
+	{"3", "中文段落测试，这是一个很长的句子（括号）。\n", "中文段落测试，这是一\n个很长的句子（括\n号）。\n"},
+	{"2", "aaaa - bbbb # cccc 1. dddd\n", "aaaa - bbbb # cccc 1.\ndddd\n"},
+	{"1", "aa `b c d e f` [g h i](j) $k + l$\n", "aa `b c d e f`\n[g h i](j) $k + l$\n"},
+	{"0", "aaaa bbbb\ncccc dddd eeee ffff gggg\n", "aaaa bbbb cccc dddd\neeee ffff gggg\n"},
+}
+
+func TestProseWrapAlways(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetProseWrap(render.ProseWrapAlways)
+	luteEngine.SetProseWrapWidth(20)
+	luteEngine.SetSoftBreak2HardBreak(false)
+
+	for _, test := range proseWrapAlwaysTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+	}
+}
+
+func TestProseWrapParseOptions(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetProseWrap(render.ProseWrapAlways)
+	luteEngine.SetProseWrapWidth(20)
+	luteEngine.SetSoftBreak2HardBreak(false)
+
+	md := "aaaa bbbb cccc dddd <<<<<<< HEAD\n"
+	if expected, formatted := "aaaa bbbb cccc dddd\n<<<<<<< HEAD\n", luteEngine.FormatStr("", md); expected != formatted {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, formatted)
+	}
+
+	// 开启 Git 冲突标记解析后 <<<<<<< 可以打断段落，不能在它前面换行
+	luteEngine.SetGitConflict(true)
+	if expected, formatted := "aaaa bbbb cccc dddd <<<<<<<\nHEAD\n", luteEngine.FormatStr("", md); expected != formatted {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, formatted)
+	}
+}
+
+var proseWrapNeverTests = []parseTest{
+
+	{"2", "- aaaa\n  bbbb\n\n  cccc\n  dddd\n", "- aaaa bbbb\n\n  cccc dddd\n"},
+	{"1", "中文\n段落 and\nEnglish 한국어\n문장\n", "中文段落 and English 한국어 문장\n"},
+	{"0", "aaaa\nbbbb `c\nd` [e\nf](g)\n", "aaaa bbbb `c d` [e f](g)\n"},
+}
+
+func TestProseWrapNever(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetProseWrap(render.ProseWrapNever)
+	luteEngine.SetSoftBreak2HardBreak(false)
+
+	for _, test := range proseWrapNeverTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+	}
+}
+
+var proseWrapSentenceTests = []parseTest{
+
+	{"2", "第一句。第二句！“第三句？”结束\n", "第一句。\n第二句！\n“第三句？”\n结束\n"},
+	{"1", "Wait... what? e.g. this `a. B` stays.\n", "Wait... what? e.g. this `a. B` stays.\n"},
+	{"0", "One. Two!\nThree? (Four.) Five\n", "One.\nTwo!\nThree?\n(Four.)\nFive\n"},
+}
+
+func TestProseWrapSentence(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetProseWrap(render.ProseWrapSentence)
+	luteEngine.SetSoftBreak2HardBreak(false)
+
+	for _, test := range proseWrapSentenceTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+	}
+}
+
+func TestProseWrapSoftBreak2HardBreak(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetProseWrap(render.ProseWrapAlways)
+	luteEngine.SetProseWrapWidth(20)
+
+	// 软换行转硬换行时折行会改变渲染结果，所以保留原有换行
+	md := "aaaa bbbb cccc dddd eeee\nffff\n"
+	if formatted := luteEngine.FormatStr("", md); md != formatted {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", md, formatted)
+	}
+}
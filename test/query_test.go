@@ -0,0 +1,115 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/parse"
+	"github.com/Dofingert/lute-for-ficus/query"
+	"github.com/Dofingert/lute-for-ficus/render"
+)
+
+const queryMarkdown = "# Title\n\n## Sub ![logo](logo.png)\n{: custom-riff-decks=\"d1 d2\"}\n\n- item\n\n  ```go\n  a\n  ```\n- ```js\n  b\n  ```\n\n```go\nc\n```\n\n## Next\n\n> [link](https://b3log.org)\n"
+
+type queryTest struct {
+	name     string
+	selector string
+	to       string // 匹配节点的类型和文本，使用 | 分隔
+}
+
+var queryTests = []queryTest{
+
+	{"12", "NodeHeading + NodeKramdownBlockIAL", "NodeKramdownBlockIAL:"},
+	{"11", "NodeBlockquote :has(NodeLink[dest*=b3log])", "NodeParagraph:link"},
+	{"10", ":root > :first-child, NodeBlockquote > :last-child", "NodeHeading:Title|NodeParagraph:link"},
+	{"9", "NodeHeading:not([level=1], :has(NodeImage))", "NodeHeading:Next"},
+	{"8", "NodeListItem:has(> NodeCodeBlock[info=go])", "NodeListItem:item"},
+	{"7", ":has(NodeImage)", "NodeDocument:TitleSub logoitemNextlink|NodeHeading:Sub logo"},
+	{"6", "[custom-riff-decks~=d2]", "NodeHeading:Sub logo"},
+	{"5", "[custom-riff-decks]", "NodeHeading:Sub logo"},
+	{"4", "NodeCodeBlock[info^=j]", "NodeCodeBlock:b"},
+	{"3", "NodeCodeBlock[info=go]", "NodeCodeBlock:a|NodeCodeBlock:c"},
+	{"2", "NodeListItem NodeCodeBlock[info=go]", "NodeCodeBlock:a"},
+	{"1", "NodeListItem > NodeCodeBlock", "NodeCodeBlock:a|NodeCodeBlock:b"},
+	{"0", "NodeHeading[level=2]", "NodeHeading:Sub logo|NodeHeading:Next"},
+}
+
+func queryTree() *parse.Tree {
+	luteEngine := lute.New()
+	luteEngine.ParseOptions.KramdownBlockIAL = true
+	return parse.Parse("", []byte(queryMarkdown), luteEngine.ParseOptions)
+}
+
+func TestQuery(t *testing.T) {
+	tree := queryTree()
+	for _, test := range queryTests {
+		nodes, err := query.All(tree.Root, test.selector)
+		if nil != err {
+			t.Fatalf("test case [%s] failed: %s", test.name, err)
+		}
+		var got []string
+		for _, n := range nodes {
+			text := n.Text()
+			if ast.NodeCodeBlock == n.Type {
+				text = string(n.ChildByType(ast.NodeCodeBlockCode).Tokens)
+			}
+			got = append(got, n.Type.String()+":"+strings.ReplaceAll(text, "\n", ""))
+		}
+		if test.to != strings.Join(got, "|") {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\nselector\n\t%q", test.name, test.to, strings.Join(got, "|"), test.selector)
+		}
+	}
+}
+
+func TestQueryInvalid(t *testing.T) {
+	for _, selector := range []string{"", "NodeFoo", "NodeHeading[level", "NodeHeading[level=\"2]", ":has(NodeImage", ":nth-child(2)", "NodeHeading )"} {
+		if _, err := query.Compile(selector); nil == err {
+			t.Fatalf("selector [%s] should be invalid", selector)
+		}
+	}
+}
+
+func TestQueryHelpers(t *testing.T) {
+	tree := queryTree()
+	if first, _ := query.First(tree.Root, "NodeHeading[level=2]"); nil == first || "Sub logo" != first.Text() {
+		t.Fatalf("unexpected first node %v", first)
+	}
+
+	count := 0
+	query.Each(tree.Root, "NodeCodeBlock", func(n *ast.Node) {
+		count++
+	})
+	if 3 != count {
+		t.Fatalf("expected 3 code blocks, got %d", count)
+	}
+
+	err := query.Replace(tree.Root, "NodeHeading:has(NodeImage), NodeImage, NodeList", func(n *ast.Node) *ast.Node {
+		if ast.NodeList == n.Type {
+			return nil
+		}
+		paragraph := &ast.Node{Type: ast.NodeParagraph}
+		paragraph.AppendChild(&ast.Node{Type: ast.NodeText, Tokens: []byte("replaced")})
+		return paragraph
+	})
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	formatted := string(render.NewFormatRenderer(tree, lute.New().RenderOptions).Render())
+	expected := "# Title\n\nreplaced\n\n```go\nc\n```\n\n## Next\n\n> [link](https://b3log.org)\n"
+	if expected != formatted {
+		t.Fatalf("replace failed\nexpected\n\t%q\ngot\n\t%q", expected, formatted)
+	}
+}
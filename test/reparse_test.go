@@ -0,0 +1,130 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/parse"
+	"github.com/Dofingert/lute-for-ficus/render"
+)
+
+var reparseTests = []struct {
+	name  string
+	from  string
+	edits []parse.Edit
+}{
+
+	{"11", "a\n***\n{: id=\"x\"}\n1. $$\nq", []parse.Edit{{Offset: 2, DeletedLen: 0, Inserted: []byte("b")}}},
+	{"10", "    code\n{: id=\"x\"}\n", []parse.Edit{{Offset: 9, DeletedLen: 1, Inserted: nil}}},
+	{"9", "---\ntitle: x\n---\n\nfoo\n", []parse.Edit{{Offset: 0, DeletedLen: 3, Inserted: []byte("a")}}},
+	{"8", "a\n\n---\nc\n", []parse.Edit{{Offset: 0, DeletedLen: 3, Inserted: []byte("\n")}}},
+	{"7", "- [ ] t\n- [x] u\n", []parse.Edit{{Offset: 14, DeletedLen: 1, Inserted: []byte("---")}}},
+	{"6", "[a]: /u\n\n[foo][a]\n", []parse.Edit{{Offset: 5, DeletedLen: 2, Inserted: []byte("/v")}}},
+	{"5", "| a |\n| - |\n| 1 |\n\nb\n", []parse.Edit{{Offset: 17, DeletedLen: 0, Inserted: []byte("| 2 |\n")}}},
+	{"4", "foo\n\nbar\n", []parse.Edit{{Offset: 3, DeletedLen: 0, Inserted: []byte("\n===")}}},
+	{"3", "- a\n- b\n\nc\n", []parse.Edit{{Offset: 8, DeletedLen: 1, Inserted: nil}, {Offset: 8, DeletedLen: 0, Inserted: []byte("  ")}}},
+	{"2", "a\n\n```\nb\n\nc\n", []parse.Edit{{Offset: 3, DeletedLen: 3, Inserted: nil}, {Offset: 0, DeletedLen: 0, Inserted: []byte("```\n")}}},
+	{"1", "# foo\n\nbar\n\nbaz\n", []parse.Edit{{Offset: 7, DeletedLen: 3, Inserted: []byte("*bar*\r\n")}}},
+	{"0", "foo\n\nbar\n", []parse.Edit{{Offset: 5, DeletedLen: 0, Inserted: []byte("> ")}}},
+}
+
+func TestReparse(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetSourcePos(true)
+
+	for _, test := range reparseTests {
+		tree := parse.Parse(test.name, []byte(test.from), luteEngine.ParseOptions)
+		markdown := test.from
+		for _, edit := range test.edits {
+			if _, err := tree.Reparse(edit); nil != err {
+				t.Fatalf("test case [%s] failed: %s", test.name, err)
+			}
+			markdown = markdown[:edit.Offset] + string(edit.Inserted) + markdown[edit.Offset+edit.DeletedLen:]
+		}
+
+		// 渲染会修改语法树，所以每棵树只渲染一次
+		html := string(render.NewHtmlRenderer(tree, luteEngine.RenderOptions).Render())
+		expected := luteEngine.MarkdownStr(test.name, markdown)
+		if expected != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\nmarkdown text\n\t%q", test.name, expected, html, markdown)
+		}
+	}
+}
+
+func TestReparseKeepsUnchangedNodes(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetSourcePos(true)
+
+	tree := parse.Parse("", []byte("# foo\n\nbar\n\nbaz\n"), luteEngine.ParseOptions)
+	heading, last := tree.Root.FirstChild, tree.Root.LastChild
+	nodes, err := tree.Reparse(parse.Edit{Offset: 8, DeletedLen: 1, Inserted: []byte("oo")})
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 1 != len(nodes) || "boor" != nodes[0].Text() {
+		t.Fatalf("unexpected reparsed nodes %v", nodes)
+	}
+	if heading != tree.Root.FirstChild || last != tree.Root.LastChild {
+		t.Fatal("unchanged nodes should be kept")
+	}
+	if 5 != last.SourcePos.StartLine || 13 != last.SourcePos.StartOffset {
+		t.Fatalf("unexpected source position %+v", last.SourcePos)
+	}
+}
+
+func TestReparseWithoutSourcePos(t *testing.T) {
+	luteEngine := lute.New()
+	tree := parse.Parse("", []byte("foo\n"), luteEngine.ParseOptions)
+	if _, err := tree.Reparse(parse.Edit{Offset: 0, DeletedLen: 1}); nil == err {
+		t.Fatal("reparse should fail on a tree parsed without source positions")
+	}
+}
+
+// reparseFragments 是随机编辑时插入的内容，包含各种块级和行级标记符。
+var reparseFragments = []string{"", "\n", "\n\n", "a", "# ", "> ", "- ", "1. ", "```\n", "$$\n", "***\n", "===\n", "    ", "|", "| - |\n", "*", "`", "[", "](u)", "{: id=\"x\"}\n", "<div>\n", "[^1]"}
+
+func TestReparseRandomEdits(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetSourcePos(true)
+
+	docs := []string{
+		"# foo\n\nbar *baz*\n\n- a\n- b\n\n> c\n",
+		"a\n***\n{: id=\"x\"}\n1. $$\nq",
+		"```go\nx\n```\n\n| a | b |\n| - | - |\n| 1 | 2 |\n\nc\n",
+		"    code\n{: id=\"x\"}\npara\n===\n\n1. a\n\n   b\n",
+	}
+	r := rand.New(rand.NewSource(1))
+	for _, doc := range docs {
+		for i := 0; i < 200; i++ {
+			tree := parse.Parse("", []byte(doc), luteEngine.ParseOptions)
+			markdown := doc
+			for j := 0; j < 3; j++ {
+				offset := r.Intn(len(markdown) + 1)
+				deletedLen := r.Intn(len(markdown)-offset+1) % 4
+				inserted := reparseFragments[r.Intn(len(reparseFragments))]
+				edit := parse.Edit{Offset: offset, DeletedLen: deletedLen, Inserted: []byte(inserted)}
+				if _, err := tree.Reparse(edit); nil != err {
+					t.Fatalf("reparse [%q] failed: %s", markdown, err)
+				}
+				markdown = markdown[:offset] + inserted + markdown[offset+deletedLen:]
+			}
+
+			html := string(render.NewHtmlRenderer(tree, luteEngine.RenderOptions).Render())
+			expected := luteEngine.MarkdownStr("", markdown)
+			if expected != html {
+				t.Fatalf("reparse of [%q] differs from full parse\nexpected\n\t%q\ngot\n\t%q", markdown, expected, html)
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+)
+
+var smartPunctTests = []parseTest{
+
+	{"7", "\"'a'\" and '\"b\"' (\"'c'\")\n", "<p>“‘a’” and ‘“b”’ (“‘c’”)</p>\n"},
+	{"6", "[\"link\"](http://x--y) <https://a--b.com>\n", "<p><a href=\"http://x--y\">“link”</a> <a href=\"https://a--b.com\">https://a--b.com</a></p>\n"},
+	{"5", "# \"Title\"\n", "<h1>“Title”</h1>\n"},
+	{"4", "see http://a.b/--x... and 'a'\n", "<p>see http://a.b/--x... and ‘a’</p>\n"},
+	{"3", "\"*foo*\" and \"`a--b`\" $a--b$\n", "<p>“<em>foo</em>” and “<code>a--b</code>” <span class=\"language-math\">a--b</span></p>\n"},
+	{"2", "He said \"she said 'hi'\"\nnext \"line\"\n", "<p>He said “she said ‘hi’”\nnext “line”</p>\n"},
+	{"1", "1990--2000 --- a---b ----- c...\n", "<p>1990–2000 — a—b —– c…</p>\n"},
+	{"0", "\"Hello,\" she said, it's 'great' since the '90s.\n", "<p>“Hello,” she said, it’s ‘great’ since the ’90s.</p>\n"},
+}
+
+func TestSmartPunct(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetSmartPunct(true)
+	luteEngine.SetSoftBreak2HardBreak(false)
+
+	for _, test := range smartPunctTests {
+		html := luteEngine.MarkdownStr(test.name, test.from)
+		if test.to != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, html, test.from)
+		}
+	}
+}
+
+var smartPunctLangTests = []parseTest{
+
+	{"6", "'a *b* c'\n{: lang=\"zh\"}\n", "<p lang=\"zh\">『a <em>b</em> c』</p>\n"},
+	{"5", "'a *b* c' and 'd'\n{: lang=\"de\"}\n", "<p lang=\"de\">‚a <em>b</em> c‘ and ‚d‘</p>\n"},
+	{"4", "'a *b* c'\n", "<p>‹\u00a0a <em>b</em> c\u00a0›</p>\n"},
+	{"3", "\"a 'b' c\"\n{: lang=\"ja\"}\n", "<p lang=\"ja\">「a 『b』 c」</p>\n"},
+	{"2", "> \"Zitat\" 'b'\n> {: lang=\"de-AT\"}\n", "<blockquote>\n<p lang=\"de-AT\">„Zitat“ ‚b‘</p>\n</blockquote>\n"},
+	{"1", "\"Bonjour\" l'ami\n", "<p>«\u00a0Bonjour\u00a0» l’ami</p>\n"},
+}
+
+func TestSmartPunctLang(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetSmartPunct(true)
+	luteEngine.SetSmartPunctLang("fr")
+	luteEngine.SetKramdownBlockIAL(true)
+
+	for _, test := range smartPunctLangTests {
+		html := luteEngine.MarkdownStr(test.name, test.from)
+		if test.to != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, html, test.from)
+		}
+	}
+}
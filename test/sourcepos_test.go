@@ -0,0 +1,81 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/parse"
+)
+
+var sourcePosTests = []parseTest{
+
+	{"6", "x\n| a |\n| - |\n| 1 |\n\ntext\n", "<p data-sourcepos=\"1:1-1:1\">x</p>\n<table data-sourcepos=\"2:1-4:5\">\n<thead>\n<tr data-sourcepos=\"2:1-2:5\">\n<th data-sourcepos=\"2:3-2:3\">a</th>\n</tr>\n</thead>\n<tbody>\n<tr data-sourcepos=\"4:1-4:5\">\n<td data-sourcepos=\"4:3-4:3\">1</td>\n</tr>\n</tbody>\n</table>\n<p data-sourcepos=\"6:1-6:4\">text</p>\n"},
+	{"5", "a\r\nb\r\n\r\n***\n", "<p data-sourcepos=\"1:1-2:1\">a\nb</p>\n<hr data-sourcepos=\"4:1-4:3\" />\n"},
+	{"4", "para\n| a |\n| - |\n| c |\n", "<p data-sourcepos=\"1:1-1:4\">para</p>\n<table data-sourcepos=\"2:1-4:5\">\n<thead>\n<tr data-sourcepos=\"2:1-2:5\">\n<th data-sourcepos=\"2:3-2:3\">a</th>\n</tr>\n</thead>\n<tbody>\n<tr data-sourcepos=\"4:1-4:5\">\n<td data-sourcepos=\"4:3-4:3\">c</td>\n</tr>\n</tbody>\n</table>\n"},
+	{"3", "foo\nbar\n===\n", "<h1 data-sourcepos=\"1:1-3:3\">foo\nbar</h1>\n"},
+	{"2", "```go\nx\n```\n", "<pre data-sourcepos=\"1:1-3:3\"><code class=\"language-go\">x\n</code></pre>\n"},
+	{"1", "- a\n- b\n\n  c\n", "<ul data-sourcepos=\"1:1-4:3\">\n<li data-sourcepos=\"1:1-1:3\">\n<p data-sourcepos=\"1:3-1:3\">a</p>\n</li>\n<li data-sourcepos=\"2:1-4:3\">\n<p data-sourcepos=\"2:3-2:3\">b</p>\n<p data-sourcepos=\"4:3-4:3\">c</p>\n</li>\n</ul>\n"},
+	{"0", "# Hello *world*\n\n> quote\n> more\n", "<h1 data-sourcepos=\"1:1-1:15\">Hello <em>world</em></h1>\n<blockquote data-sourcepos=\"3:1-4:6\">\n<p data-sourcepos=\"3:3-4:6\">quote\nmore</p>\n</blockquote>\n"},
+}
+
+func TestSourcePos(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetSourcePos(true)
+	luteEngine.SetSoftBreak2HardBreak(false)
+	luteEngine.SetCodeSyntaxHighlight(false)
+
+	for _, test := range sourcePosTests {
+		html := luteEngine.MarkdownStr(test.name, test.from)
+		if test.to != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, html, test.from)
+		}
+	}
+}
+
+var inlineSourcePosTests = []struct {
+	name     string
+	from     string
+	nodeType ast.NodeType
+	pos      string
+	offsets  [2]int
+}{
+
+	{"4", "| a | `b` |\n| - | - |\n", ast.NodeCodeSpanContent, "1:8-1:8", [2]int{7, 8}},
+	{"3", "## foo **bar**\n", ast.NodeStrong, "1:8-1:14", [2]int{7, 14}},
+	{"2", "a\r\nb *c*\r\n", ast.NodeEmphasis, "2:3-2:5", [2]int{5, 8}},
+	{"1", "> foo\n> [bar](/u)\n", ast.NodeLink, "2:3-2:11", [2]int{8, 17}},
+	{"0", "foo *bar*\n", ast.NodeEmA6kOpenMarker, "1:5-1:5", [2]int{4, 5}},
+}
+
+func TestInlineSourcePos(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetSourcePos(true)
+
+	for _, test := range inlineSourcePosTests {
+		tree := parse.Parse("", []byte(test.from), luteEngine.ParseOptions)
+		var node *ast.Node
+		ast.Walk(tree.Root, func(n *ast.Node, entering bool) ast.WalkStatus {
+			if entering && test.nodeType == n.Type && nil == node {
+				node = n
+			}
+			return ast.WalkContinue
+		})
+		if nil == node || nil == node.SourcePos {
+			t.Fatalf("test case [%s] failed: node [%s] has no source position", test.name, test.nodeType)
+		}
+		if pos := node.SourcePos.String(); test.pos != pos || test.offsets[0] != node.SourcePos.StartOffset || test.offsets[1] != node.SourcePos.EndOffset {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%s %v\ngot\n\t%s [%d %d]", test.name, test.pos, test.offsets, pos, node.SourcePos.StartOffset, node.SourcePos.EndOffset)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+)
+
+var streamTests = []string{
+	"[^1]\n[^1]: note [foo]\n\ntext\n\n[foo]: /u\n",
+	"x [bar][] y\n\n- a [foo]\n  - b [^1]\n\n> [foo]: /q\n\n[^1]: two\n    more [^1]\n\n[Bar]: /b 'title'\n",
+	"array[0]\n\n| a | b |\n| - | - |\n| [foo] | 2 |\n\ntext\n",
+	"---\ntitle: x\n---\n\npara\r\nline\r\n\r\n<div>\nhi\n</div>\n",
+	"# Heading *em*\n\n```go\ncode [foo]\n```\n\n* x\n* y\n\n  z\n",
+	"",
+	"```\nx\n```\n{: id=\"x\"}\n",
+	"# h\n{: id=\"x\"}\n",
+	"# h\n{: id=\"x\"}\nfoo\n\n{: id=\"y\"}\n",
+	"[a]# 1. \r\n| a | b |\n| - | - |\n",
+	"para\n\n[r]: /u\n{: id=\"x\"}\n",
+	"para\n\n[r]: /u\n[s]: /v\n{: id=\"x\"}\n\n[r] [s]\n",
+	"para\n\n[r]\n{: id=\"x\"}\n\n[r]: /u\n",
+}
+
+func TestMarkdownTo(t *testing.T) {
+	luteEngine := lute.New()
+
+	for _, sourcePos := range []bool{false, true} {
+		luteEngine.SetSourcePos(sourcePos)
+		for i, markdown := range streamTests {
+			buf := &bytes.Buffer{}
+			if err := luteEngine.MarkdownTo(buf, strings.NewReader(markdown)); nil != err {
+				t.Fatalf("test case [%d] failed: %s", i, err)
+			}
+			expected := luteEngine.MarkdownStr("", markdown)
+			if html := buf.String(); expected != html {
+				t.Fatalf("test case [%d] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", i, expected, html, markdown)
+			}
+		}
+	}
+}
+
+var streamInlineFootnotesTests = []string{
+	"[^1]\n\n^[inline]\n\n[^1]: fn\n",
+	"[^1] [^2]\n\n^[a] ^[b]\n\n[^2]: two\n\n^[c]\n\n[^1]: one\n",
+}
+
+func TestMarkdownToInlineFootnotes(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetInlineFootnotes(true)
+
+	for i, markdown := range streamInlineFootnotesTests {
+		buf := &bytes.Buffer{}
+		if err := luteEngine.MarkdownTo(buf, strings.NewReader(markdown)); nil != err {
+			t.Fatalf("test case [%d] failed: %s", i, err)
+		}
+		expected := luteEngine.MarkdownStr("", markdown)
+		if html := buf.String(); expected != html {
+			t.Fatalf("test case [%d] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", i, expected, html, markdown)
+		}
+	}
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestMarkdownToReadError(t *testing.T) {
+	luteEngine := lute.New()
+	reader := io.MultiReader(strings.NewReader("foo\n\nbar\n"), errReader{})
+	if err := luteEngine.MarkdownTo(&bytes.Buffer{}, reader); nil == err || "read failed" != err.Error() {
+		t.Fatalf("expected read error, got %v", err)
+	}
+}
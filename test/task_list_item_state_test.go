@@ -0,0 +1,105 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+)
+
+var taskListItemStateTests = []parseTest{
+
+	{"3", "- [~] a\n", "<ul>\n<li>[~] a</li>\n</ul>\n"},
+	{"2", "- [x] a\n  - [?] b\n", "<ul>\n<li class=\"vditor-task vditor-task--done\"><input checked=\"\" disabled=\"\" type=\"checkbox\" /> a\n<ul>\n<li class=\"vditor-task vditor-task--question\"><input data-task=\"?\" disabled=\"\" type=\"checkbox\" /> b</li>\n</ul>\n</li>\n</ul>\n"},
+	{"1", "- [>] a\n- [ ] b\n", "<ul>\n<li class=\"vditor-task vditor-task--deferred\"><input data-task=\">\" disabled=\"\" type=\"checkbox\" /> a</li>\n<li class=\"vditor-task\"><input disabled=\"\" type=\"checkbox\" /> b</li>\n</ul>\n"},
+	{"0", "- [-] a\n", "<ul>\n<li class=\"vditor-task vditor-task--cancelled\"><input data-task=\"-\" disabled=\"\" type=\"checkbox\" /> a</li>\n</ul>\n"},
+}
+
+func TestTaskListItemState(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetTaskListItemStates("-?!>")
+	luteEngine.SetGFMTaskListItemClass("vditor-task")
+
+	for _, test := range taskListItemStateTests {
+		html := luteEngine.MarkdownStr(test.name, test.from)
+		if test.to != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, html, test.from)
+		}
+	}
+}
+
+func TestTaskListItemStateDisabled(t *testing.T) {
+	luteEngine := lute.New()
+
+	html := luteEngine.MarkdownStr("", "- [-] a\n")
+	if expected := "<ul>\n<li>[-] a</li>\n</ul>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+func TestTaskListItemStateFormat(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetTaskListItemStates("-?!>")
+
+	md := "- [-] a\n- [X] b\n- [ ] c\n  - [!] d\n"
+	if formatted := luteEngine.FormatStr("", md); md != formatted {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", md, formatted)
+	}
+}
+
+func TestTaskListItemStateVditor(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetTaskListItemStates("-?!>")
+
+	vditorDOM := luteEngine.Md2VditorDOM("- [?] a\n")
+	if expected := "<ul data-tight=\"true\" data-marker=\"-\" data-block=\"0\"><li data-marker=\"-\" class=\"vditor-task\"><input data-task=\"?\" type=\"checkbox\" /> a</li></ul>"; expected != vditorDOM {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, vditorDOM)
+	}
+	if md := luteEngine.VditorDOM2Md(vditorDOM); "- [?] a\n" != md {
+		t.Fatalf("unexpected markdown %q", md)
+	}
+}
+
+func TestTaskListItemStateProtyle(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetProtyleWYSIWYG(true)
+	luteEngine.SetKramdownIAL(true)
+	luteEngine.SetKramdownBlockIAL(true)
+	luteEngine.SetTaskListItemStates("-?!>")
+
+	ivHTML := luteEngine.Md2BlockDOM("- [-] a\n", false)
+	md := luteEngine.BlockDOM2Md(ivHTML)
+	tasks := luteEngine.Tasks("", md)
+	if 1 != len(tasks) || '-' != tasks[0].State || tasks[0].Checked || "a" != tasks[0].Text {
+		t.Fatalf("unexpected markdown %q", md)
+	}
+}
+
+func TestTasks(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetTaskListItemStates("-?!>")
+
+	tasks := luteEngine.Tasks("", "- [-] a\n- [x] **b**\n  - [ ] c\n- d\n\n1. [!] e\n")
+	expected := []struct {
+		state   byte
+		checked bool
+		text    string
+	}{{'-', false, "a"}, {'x', true, "b"}, {' ', false, "c"}, {'!', false, "e"}}
+	if len(expected) != len(tasks) {
+		t.Fatalf("unexpected tasks count [%d]", len(tasks))
+	}
+	for i, task := range tasks {
+		if expected[i].state != task.State || expected[i].checked != task.Checked || expected[i].text != task.Text || "NodeListItem" != task.ListItem.Type.String() {
+			t.Fatalf("unexpected task [%d] %+v", i, task)
+		}
+	}
+}
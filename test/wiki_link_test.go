@@ -0,0 +1,133 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dofingert/lute-for-ficus"
+	"github.com/Dofingert/lute-for-ficus/ast"
+	"github.com/Dofingert/lute-for-ficus/render"
+)
+
+var wikiLinkTests = []parseTest{
+
+	{"8", "[[<script>|a&b]]\n", "<p><a href=\"%3Cscript%3E\" class=\"wikilink\">a&amp;b</a></p>\n"},
+	{"7", "`[[code]]` *[[P]]*\n", "<p><code>[[code]]</code> <em><a href=\"P\" class=\"wikilink\">P</a></em></p>\n"},
+	{"6", "| a | b |\n| - | - |\n| [[P\\|alias]] | x |\n", "<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td><a href=\"P\" class=\"wikilink\">alias</a></td>\n<td>x</td>\n</tr>\n</tbody>\n</table>\n"},
+	{"5", "[[]] [[ | x]] [[a[b]]\n", "<p>[[]] [[ | x]] [[a[b]]</p>\n"},
+	{"4", "[[foo]]\n\n[foo]: /url\n", "<p><a href=\"foo\" class=\"wikilink\">foo</a></p>\n"},
+	{"3", "[[#Heading]]\n", "<p><a href=\"#Heading\" class=\"wikilink\">Heading</a></p>\n"},
+	{"2", "[[Page^abc]] [[Page#^abc]]\n", "<p><a href=\"Page#%5Eabc\" class=\"wikilink\">Page &gt; ^abc</a> <a href=\"Page#%5Eabc\" class=\"wikilink\">Page &gt; ^abc</a></p>\n"},
+	{"1", "[[Page#Heading|Alias]]\n", "<p><a href=\"Page#Heading\" class=\"wikilink\">Alias</a></p>\n"},
+	{"0", "[[Page]]\n", "<p><a href=\"Page\" class=\"wikilink\">Page</a></p>\n"},
+}
+
+func TestWikiLink(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetWikiLink(true)
+
+	for _, test := range wikiLinkTests {
+		html := luteEngine.MarkdownStr(test.name, test.from)
+		if test.to != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, html, test.from)
+		}
+	}
+}
+
+func TestWikiLinkDisabled(t *testing.T) {
+	luteEngine := lute.New()
+
+	html := luteEngine.MarkdownStr("", "[[Page]]\n")
+	if expected := "<p>[[Page]]</p>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+func TestWikiLinkResolver(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetWikiLink(true)
+	luteEngine.SetWikiLinkResolver(render.WikiLinkResolverFunc(func(node *ast.Node) (href string, exists bool) {
+		if "Missing" == node.WikiLinkTarget {
+			return "/new?title=Missing", false
+		}
+		return "/wiki/" + node.WikiLinkTarget + ".html", true
+	}))
+
+	html := luteEngine.MarkdownStr("", "[[Page]] [[Missing]]\n")
+	if expected := "<p><a href=\"/wiki/Page.html\" class=\"wikilink\">Page</a> <a href=\"/new?title=Missing\" class=\"wikilink wikilink-missing\">Missing</a></p>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+var wikiLinkFormatTests = []parseTest{
+
+	{"2", "| a | b |\n| - | - |\n| [[P\\|alias]] | x |\n", "| a            | b |\n| ------------ | - |\n| [[P\\|alias]] | x |\n"},
+	{"1", "[[ Page # Heading | Alias ]]\n", "[[Page#Heading|Alias]]\n"},
+	{"0", "[[Page#^abc]]\n", "[[Page^abc]]\n"},
+}
+
+func TestWikiLinkFormat(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetWikiLink(true)
+
+	for _, test := range wikiLinkFormatTests {
+		formatted := luteEngine.FormatStr(test.name, test.from)
+		if test.to != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.to, formatted, test.from)
+		}
+	}
+}
+
+func TestWikiLinkBlockDOM(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetWikiLink(true)
+
+	dom := luteEngine.Md2BlockDOM("foo [[Page#Heading|Alias]] *[[P]]*\n", false)
+	if !strings.Contains(dom, "<span data-type=\"wiki-link\" data-href=\"Page#Heading\" data-target=\"Page\" data-heading=\"Heading\" data-alias=\"Alias\">Alias</span>") {
+		t.Fatalf("unexpected block DOM %q", dom)
+	}
+	md := luteEngine.BlockDOM2Md(dom)
+	if expected := "foo [[Page#Heading|Alias]] [[P]]\n"; !strings.HasPrefix(md, expected) {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, md)
+	}
+}
+
+var wikiLinkVditorTests = []parseTest{
+
+	{"1", "| a |\n| - |\n| [[P\\|x]] |\n", "| a        |\n| -------- |\n| [[P\\|x]] |\n"},
+	{"0", "a [[Page#h|alias]] b\n", "a [[Page#h|alias]] b\n"},
+}
+
+func TestWikiLinkVditorDOM(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetWikiLink(true)
+
+	for _, test := range wikiLinkVditorTests {
+		md := luteEngine.VditorDOM2Md(luteEngine.Md2VditorDOM(test.from))
+		if test.to != md {
+			t.Fatalf("test case [%s] wysiwyg failed\nexpected\n\t%q\ngot\n\t%q", test.name, test.to, md)
+		}
+		md = luteEngine.VditorIRDOM2Md(luteEngine.Md2VditorIRDOM(test.from))
+		if test.to != md {
+			t.Fatalf("test case [%s] ir failed\nexpected\n\t%q\ngot\n\t%q", test.name, test.to, md)
+		}
+	}
+
+	// 脚注定义中的 Wiki 链接
+	markdown := "[^1]: fn\n[[Page|alias]]\n"
+	for _, dom := range []string{luteEngine.Md2VditorDOM(markdown), luteEngine.Md2VditorIRDOM(markdown), luteEngine.Md2VditorSVDOM(markdown)} {
+		if !strings.Contains(dom, "[[Page|alias]]") || strings.Contains(dom, "not found render function") {
+			t.Fatalf("unexpected vditor DOM %q", dom)
+		}
+	}
+}
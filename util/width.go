@@ -0,0 +1,71 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+package util
+
+import "unicode"
+
+//go:generate go run width_gen.go
+
+// RuneWidth 返回字符 r 在等宽字体下的显示宽度：东亚宽字符和全角字符占两列，控制字符、组合字符和格式字符占零列，其他字符占一列。
+func RuneWidth(r rune) int {
+	switch {
+	case 0x20 > r || (0x7F <= r && 0xA0 > r):
+		return 0
+	case 0x1160 <= r && 0x11FF >= r: // 韩文字母的中声和终声与初声组合为一个音节
+		return 0
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case unicode.Is(eastAsianWide, r):
+		return 2
+	}
+	return 1
+}
+
+// DisplayWidth 返回字符串 s 在等宽字体下的显示宽度，按字素簇计算：零宽连接符连接的表情符号序列、肤色修饰符、国旗（两个区域指示符）都只占一个表情符号的宽度。
+func DisplayWidth(s string) (ret int) {
+	var last int          // 当前字素簇的宽度
+	var joined, flag bool // 前一个字符是否是零宽连接符，是否有未配对的区域指示符
+	for _, r := range s {
+		switch {
+		case 0x200D == r: // 零宽连接符
+			joined = 0 < last
+			continue
+		case joined:
+			joined = false
+			continue
+		case 0xFE0F == r: // 表情符号样式选择符
+			if 1 == last {
+				ret++
+				last = 2
+			}
+			continue
+		case 0x1F3FB <= r && 0x1F3FF >= r && 0 < last: // 肤色修饰符
+			continue
+		case 0x1F1E6 <= r && 0x1F1FF >= r: // 区域指示符
+			if flag {
+				flag = false
+				continue
+			}
+			flag = true
+			ret += 2
+			last = 2
+			continue
+		}
+
+		flag = false
+		w := RuneWidth(r)
+		if 0 < w {
+			last = w
+		}
+		ret += w
+	}
+	return
+}
@@ -0,0 +1,79 @@
+// Lute - 一款结构化的 Markdown 引擎，支持 Go 和 JavaScript
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//         http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+// See the Mulan PSL v2 for more details.
+
+//go:build ignore
+
+// 使用 golang.org/x/text/width 生成 width_table.go，运行时不直接依赖 golang.org/x/text 以免 JS 版打包体积太大：
+//
+//	go run width_gen.go
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"unicode"
+
+	"golang.org/x/text/width"
+)
+
+func main() {
+	var r16, r32 []string
+	appendRange := func(lo, hi rune) {
+		if 0xFFFF >= hi {
+			r16 = append(r16, fmt.Sprintf("{0x%04X, 0x%04X, 1},", lo, hi))
+		} else {
+			r32 = append(r32, fmt.Sprintf("{0x%X, 0x%X, 1},", lo, hi))
+		}
+	}
+
+	lo := rune(-1)
+	for r := rune(0); r <= unicode.MaxRune+1; r++ {
+		wide := false
+		if unicode.MaxRune >= r {
+			kind := width.LookupRune(r).Kind()
+			wide = width.EastAsianWide == kind || width.EastAsianFullwidth == kind
+		}
+		if wide && 0 > lo {
+			lo = r
+		} else if !wide && 0 <= lo {
+			if 0xFFFF >= lo && 0xFFFF < r-1 {
+				appendRange(lo, 0xFFFF)
+				lo = 0x10000
+			}
+			appendRange(lo, r-1)
+			lo = -1
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteString("// Code generated by go run width_gen.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "// 数据来源 golang.org/x/text/width Unicode %s\n\n", width.UnicodeVersion)
+	buf.WriteString("package util\n\nimport \"unicode\"\n\n")
+	buf.WriteString("// eastAsianWide 定义了东亚宽度属性为宽（W）和全角（F）的字符。\n")
+	buf.WriteString("var eastAsianWide = &unicode.RangeTable{\n\tR16: []unicode.Range16{\n")
+	for _, r := range r16 {
+		buf.WriteString("\t\t" + r + "\n")
+	}
+	buf.WriteString("\t},\n\tR32: []unicode.Range32{\n")
+	for _, r := range r32 {
+		buf.WriteString("\t\t" + r + "\n")
+	}
+	buf.WriteString("\t},\n}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if nil != err {
+		panic(err)
+	}
+	if err = os.WriteFile("width_table.go", src, 0644); nil != err {
+		panic(err)
+	}
+}
@@ -192,6 +192,14 @@ func (lute *Lute) genASTByVditorIRDOM(n *html.Node, tree *parse.Tree) {
 					tree.Context.Tip.AppendChild(node)
 				}
 			}
+		} else if "fenced-div" == dataType {
+			node := &ast.Node{Type: ast.NodeFencedDiv, KramdownIAL: parse.FencedDivAttributes(util.DomAttrValue(n, "data-fenced-div"))}
+			tree.Context.Tip.AppendChild(node)
+			tree.Context.Tip = node
+			for c := n.FirstChild; c != nil; c = c.NextSibling {
+				lute.genASTByVditorIRDOM(c, tree)
+			}
+			tree.Context.Tip = node.Parent
 		} else if "toc-block" == dataType {
 			node := &ast.Node{Type: ast.NodeToC}
 			tree.Context.Tip.AppendChild(node)
@@ -295,6 +303,15 @@ func (lute *Lute) genASTByVditorIRDOM(n *html.Node, tree *parse.Tree) {
 			tree.Context.Tip.AppendChild(node)
 		}
 
+		if "" != util.DomAttrValue(n, "data-callout") {
+			node.Type = ast.NodeCallout
+			lute.setCallout(n, node)
+			tree.Context.Tip.AppendChild(node)
+			tree.Context.Tip = node
+			defer tree.Context.ParentTip()
+			break
+		}
+
 		node.Type = ast.NodeBlockquote
 		node.AppendChild(&ast.Node{Type: ast.NodeBlockquoteMarker, Tokens: []byte(">")})
 		tree.Context.Tip.AppendChild(node)
@@ -582,6 +599,7 @@ func (lute *Lute) genASTByVditorIRDOM(n *html.Node, tree *parse.Tree) {
 		}
 		node.Type = ast.NodeTaskListItemMarker
 		node.TaskListItemChecked = lute.hasAttr(n, "checked")
+		node.TaskListItemState = lute.taskListItemState(n)
 		tree.Context.Tip.AppendChild(node)
 		if nil != node.Parent.Parent.Parent && nil != node.Parent.Parent.Parent.ListData { // ul.li.p.input
 			node.Parent.Parent.Parent.ListData.Typ = 3
@@ -610,6 +610,14 @@ func (lute *Lute) genASTByVditorDOM(n *html.Node, tree *parse.Tree) {
 					panic(err)
 				}
 			}
+		} else if "fenced-div" == dataType {
+			node := &ast.Node{Type: ast.NodeFencedDiv, KramdownIAL: parse.FencedDivAttributes(util.DomAttrValue(n, "data-fenced-div"))}
+			tree.Context.Tip.AppendChild(node)
+			tree.Context.Tip = node
+			for c := n.FirstChild; c != nil; c = c.NextSibling {
+				lute.genASTByVditorDOM(c, tree)
+			}
+			tree.Context.Tip = node.Parent
 		} else if "toc-block" == dataType {
 			node := &ast.Node{Type: ast.NodeToC}
 			tree.Context.Tip.AppendChild(node)
@@ -684,6 +692,15 @@ func (lute *Lute) genASTByVditorDOM(n *html.Node, tree *parse.Tree) {
 			return
 		}
 
+		if "" != util.DomAttrValue(n, "data-callout") {
+			node.Type = ast.NodeCallout
+			lute.setCallout(n, node)
+			tree.Context.Tip.AppendChild(node)
+			tree.Context.Tip = node
+			defer tree.Context.ParentTip()
+			break
+		}
+
 		node.Type = ast.NodeBlockquote
 		node.AppendChild(&ast.Node{Type: ast.NodeBlockquoteMarker, Tokens: []byte(">")})
 		tree.Context.Tip.AppendChild(node)
@@ -1181,6 +1198,7 @@ func (lute *Lute) genASTByVditorDOM(n *html.Node, tree *parse.Tree) {
 		}
 		node.Type = ast.NodeTaskListItemMarker
 		node.TaskListItemChecked = lute.hasAttr(n, "checked")
+		node.TaskListItemState = lute.taskListItemState(n)
 		tree.Context.Tip.AppendChild(node)
 		if nil != node.Parent.Parent && nil != node.Parent.Parent.ListData { // ul.li.input
 			node.Parent.Parent.ListData.Typ = 3
@@ -1423,6 +1441,14 @@ func (lute *Lute) hasAttr(n *html.Node, attrName string) bool {
 	return false
 }
 
+// taskListItemState 返回任务列表项 DOM 节点 n 上 data-task 属性记录的扩展状态字符，没有时返回 0。
+func (lute *Lute) taskListItemState(n *html.Node) byte {
+	if state := util.DomAttrValue(n, "data-task"); 1 == len(state) {
+		return state[0]
+	}
+	return 0
+}
+
 func (lute *Lute) domCustomAttrs(n *html.Node) (ret map[string]string) {
 	ret = map[string]string{}
 	for _, attr := range n.Attr {
@@ -1436,6 +1462,16 @@ func (lute *Lute) domCustomAttrs(n *html.Node) (ret map[string]string) {
 	return
 }
 
+// setCallout 从 DOM 节点 n 的属性中读取提示块的类型、标题和折叠标记。
+func (lute *Lute) setCallout(n *html.Node, node *ast.Node) {
+	node.CalloutType = util.DomAttrValue(n, "data-callout")
+	if "" == node.CalloutType {
+		node.CalloutType = "NOTE"
+	}
+	node.CalloutTitle = util.DomAttrValue(n, "data-callout-title")
+	node.CalloutFold = util.DomAttrValue(n, "data-callout-fold")
+}
+
 func (lute *Lute) domChild(n *html.Node, dataAtom atom.Atom) *html.Node {
 	if nil == n {
 		return nil